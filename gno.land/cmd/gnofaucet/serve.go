@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -17,6 +18,7 @@ import (
 	"github.com/gnolang/gno/tm2/pkg/crypto"
 	"github.com/gnolang/gno/tm2/pkg/crypto/keys"
 	"github.com/gnolang/gno/tm2/pkg/crypto/keys/client"
+	dbm "github.com/gnolang/gno/tm2/pkg/db"
 	"github.com/gnolang/gno/tm2/pkg/errors"
 	"github.com/gnolang/gno/tm2/pkg/sdk/bank"
 	"github.com/gnolang/gno/tm2/pkg/std"
@@ -45,7 +47,11 @@ type config struct {
 	Send                  string
 	CaptchaSecret         string
 	IsBehindProxy         bool
+	TrustedProxies        int
 	InsecurePasswordStdin bool
+	ThrottleTiers         string
+	DBDir                 string
+	AdminListen           string
 }
 
 func newServeCmd() *commands.Command {
@@ -127,7 +133,7 @@ func (c *config) RegisterFlags(fs *flag.FlagSet) {
 		&c.Send,
 		"send",
 		"1000000ugnot",
-		"send coins",
+		"coins sent per request, unless a throttle tier sets the amount",
 	)
 
 	fs.StringVar(
@@ -144,6 +150,34 @@ func (c *config) RegisterFlags(fs *flag.FlagSet) {
 		"use X-Forwarded-For IP for throttling",
 	)
 
+	fs.IntVar(
+		&c.TrustedProxies,
+		"trusted-proxies",
+		1,
+		"number of proxies appending to X-Forwarded-For in front of the faucet, with -is-behind-proxy",
+	)
+
+	fs.StringVar(
+		&c.ThrottleTiers,
+		"throttle",
+		DefaultThrottleTiers,
+		"comma-separated request limits, as <bucket>:<max>/<window>[=<amount>] where bucket is ip4/24, ip6/48, ip6/64 or addr, and amount replaces -send for the requests of the tier",
+	)
+
+	fs.StringVar(
+		&c.DBDir,
+		"db-dir",
+		"",
+		"directory of the db persisting the throttle counters (default <home>/gnofaucet)",
+	)
+
+	fs.StringVar(
+		&c.AdminListen,
+		"admin-listen",
+		"",
+		"address of the admin server, serving the throttle counters at /status (if empty, it is disabled)",
+	)
+
 	fs.BoolVar(
 		&c.InsecurePasswordStdin,
 		"insecure-password-stdin",
@@ -152,6 +186,31 @@ func (c *config) RegisterFlags(fs *flag.FlagSet) {
 	)
 }
 
+// forwardedFor returns the client address of the X-Forwarded-For headers
+// xff, behind the given number of trusted proxies. Each proxy appends the
+// address it received the request from, so the client address is the
+// one appended by the outermost trusted proxy; addresses before it are
+// set by the client and can't be trusted.
+func forwardedFor(xff []string, proxies int) string {
+	var addrs []string
+	for _, header := range xff {
+		for _, addr := range strings.Split(header, ",") {
+			if addr = strings.TrimSpace(addr); addr != "" {
+				addrs = append(addrs, addr)
+			}
+		}
+	}
+	if len(addrs) == 0 || proxies < 1 {
+		return ""
+	}
+	i := len(addrs) - proxies
+	if i < 0 {
+		// all the addresses were appended by the proxies.
+		i = 0
+	}
+	return addrs[i]
+}
+
 func execServe(cfg *config, args []string, io *commands.IO) error {
 	if len(args) != 1 {
 		return flag.ErrHelp
@@ -169,6 +228,11 @@ func execServe(cfg *config, args []string, io *commands.IO) error {
 		return errors.New("gas-fee not specified")
 	}
 
+	tiers, err := ParseThrottleTiers(cfg.ThrottleTiers)
+	if err != nil {
+		return err
+	}
+
 	remote := cfg.Remote
 	if remote == "" || remote == "y" {
 		return errors.New("missing remote url")
@@ -244,8 +308,19 @@ func execServe(cfg *config, args []string, io *commands.IO) error {
 	}
 
 	// Start throttled faucet.
-	st := NewSubnetThrottler()
+	dbDir := cfg.DBDir
+	if dbDir == "" {
+		dbDir = filepath.Join(cfg.Home, "gnofaucet")
+	}
+	db := dbm.NewDB("throttle", dbm.GoLevelDBBackend, dbDir)
+	defer db.Close()
+	st := NewDBThrottler(db, tiers)
 	st.Start()
+	defer st.Stop()
+
+	if cfg.AdminListen != "" {
+		go serveAdmin(cfg.AdminListen, st)
+	}
 
 	// handle route using handler function
 	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
@@ -257,8 +332,8 @@ func execServe(cfg *config, args []string, io *commands.IO) error {
 				return
 			}
 			host = host_
-		} else if xff, found := r.Header["X-Forwarded-For"]; found && len(xff) > 0 {
-			host = xff[0]
+		} else {
+			host = forwardedFor(r.Header.Values("X-Forwarded-For"), cfg.TrustedProxies)
 		}
 
 		// if can't identify the IP, everyone is in the same pool.
@@ -273,15 +348,23 @@ func execServe(cfg *config, args []string, io *commands.IO) error {
 			return
 		}
 
-		allowed, reason := st.Request(ip)
-		if !allowed {
-			msg := fmt.Sprintf("abuse protection system (%s)", reason)
-			fmt.Println(ip, msg)
-			w.Write([]byte(msg))
+		r.ParseForm()
+
+		passedAddr := r.Form["toaddr"]
+		if passedAddr == nil {
+			fmt.Println(ip, "no address found")
+			w.Write([]byte("no address found"))
 			return
 		}
 
-		r.ParseForm()
+		toAddrStr := strings.TrimSpace(passedAddr[0])
+
+		toAddr, err := crypto.AddressFromBech32(toAddrStr)
+		if err != nil {
+			fmt.Println(ip, "invalid address format", err)
+			w.Write([]byte("invalid address format"))
+			return
+		}
 
 		// only when command line argument 'captcha-secret' has entered > captcha are enabled.
 		// verify captcha
@@ -302,24 +385,21 @@ func execServe(cfg *config, args []string, io *commands.IO) error {
 			}
 		}
 
-		passedAddr := r.Form["toaddr"]
-		if passedAddr == nil {
-			fmt.Println(ip, "no address found")
-			w.Write([]byte("no address found"))
+		// count the request only once the captcha is verified, and forget
+		// it if the coins can't be sent.
+		allowed, reason := st.Request(ip, toAddr)
+		if !allowed {
+			msg := fmt.Sprintf("abuse protection system (%s)", reason)
+			fmt.Println(ip, msg)
+			w.Write([]byte(msg))
 			return
 		}
 
-		toAddrStr := strings.TrimSpace(passedAddr[0])
-
 		// OK.
-		toAddr, err := crypto.AddressFromBech32(toAddrStr)
-		if err != nil {
-			fmt.Println(ip, "invalid address format", err)
-			w.Write([]byte("invalid address format"))
-			return
-		}
-		err = sendAmountTo(cfg, cli, io, name, pass, toAddr, accountNumber, sequence, send)
+		amount := TierAmount(tiers, ip, toAddr, send)
+		err = sendAmountTo(cfg, cli, io, name, pass, toAddr, accountNumber, sequence, amount)
 		if err != nil {
+			st.Cancel(ip, toAddr)
 			fmt.Println(ip, "faucet failed", err)
 			w.Write([]byte("faucet failed"))
 			return
@@ -342,6 +422,26 @@ func execServe(cfg *config, args []string, io *commands.IO) error {
 	return nil
 }
 
+// serveAdmin serves the status of the throttler st, as JSON.
+func serveAdmin(addr string, st Throttler) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/status", func(w http.ResponseWriter, r *http.Request) {
+		out, _ := json.MarshalIndent(st.Status(), "", "  ")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(out)
+	})
+
+	fmt.Println("Starting admin server at", addr)
+	server := &http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 60 * time.Second,
+	}
+	if err := server.ListenAndServe(); err != nil {
+		fmt.Println("admin server stopped:", err)
+	}
+}
+
 func sendAmountTo(
 	cfg *config,
 	cli rpcclient.Client,
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForwardedFor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		xff     []string
+		proxies int
+		want    string
+	}{
+		{"no header", nil, 1, ""},
+		{"one proxy", []string{"1.1.1.1"}, 1, "1.1.1.1"},
+		{"spoofed by the client", []string{"6.6.6.6, 1.1.1.1"}, 1, "1.1.1.1"},
+		{"several headers", []string{"6.6.6.6", "1.1.1.1"}, 1, "1.1.1.1"},
+		{"two proxies", []string{"6.6.6.6, 1.1.1.1, 10.0.0.1"}, 2, "1.1.1.1"},
+		{"fewer addresses than proxies", []string{"1.1.1.1"}, 2, "1.1.1.1"},
+		{"no trusted proxy", []string{"1.1.1.1"}, 0, ""},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, forwardedFor(tt.xff, tt.proxies))
+		})
+	}
+}
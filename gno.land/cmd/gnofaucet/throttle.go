@@ -1,60 +1,353 @@
 package main
 
 import (
+	"encoding/binary"
+	"fmt"
 	"net"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/gnolang/gno/tm2/pkg/crypto"
+	dbm "github.com/gnolang/gno/tm2/pkg/db"
 	"github.com/gnolang/gno/tm2/pkg/service"
+	"github.com/gnolang/gno/tm2/pkg/std"
 )
 
-type SubnetThrottler struct {
+// Throttler limits the requests made to the faucet.
+type Throttler interface {
+	// Request records a request from ip to send coins to the address to,
+	// and returns whether it is allowed, or why not.
+	Request(ip net.IP, to crypto.Address) (allowed bool, reason string)
+	// Cancel forgets an allowed request which couldn't be served, so that
+	// only served requests count towards the limits.
+	Cancel(ip net.IP, to crypto.Address)
+	// Status returns the counters of the current windows.
+	Status() ThrottlerStatus
+}
+
+// Kinds of buckets, which group the requests counted by a tier.
+const (
+	bucketIPv4Subnet24 = "ip4/24"
+	bucketIPv6Subnet48 = "ip6/48"
+	bucketIPv6Subnet64 = "ip6/64"
+	bucketAddress      = "addr"
+)
+
+// DefaultThrottleTiers are the tiers of the faucet, unless configured.
+const DefaultThrottleTiers = "ip4/24:5/1m,ip6/48:20/1m,ip6/64:5/1m,addr:5/24h"
+
+// ThrottleTier allows at most Max requests per Window in each bucket of
+// the kind Bucket: an IPv4 /24 or IPv6 /48 or /64 subnet of the client,
+// or the recipient address. If Amount is set, it is sent instead of the
+// default amount to the requests of the tier.
+type ThrottleTier struct {
+	Bucket string
+	Max    int64
+	Window time.Duration
+	Amount std.Coins
+}
+
+func (t ThrottleTier) String() string {
+	s := fmt.Sprintf("%s:%d/%s", t.Bucket, t.Max, t.Window)
+	if !t.Amount.IsZero() {
+		s += "=" + t.Amount.String()
+	}
+	return s
+}
+
+// key identifies the counters of the tier in the db. It doesn't depend on
+// Max, so that limits can be changed without resetting the counters.
+func (t ThrottleTier) key() string {
+	return t.Bucket + "@" + t.Window.String()
+}
+
+// bucket returns the bucket of a request in the tier, or "" if the tier
+// doesn't apply to it, such as IPv6 tiers to IPv4 clients.
+func (t ThrottleTier) bucket(ip net.IP, to crypto.Address) string {
+	ip4 := ip.To4()
+	switch t.Bucket {
+	case bucketIPv4Subnet24:
+		if ip4 != nil {
+			return ip4.Mask(net.CIDRMask(24, 32)).String()
+		}
+	case bucketIPv6Subnet48:
+		if ip4 == nil {
+			return ip.Mask(net.CIDRMask(48, 128)).String()
+		}
+	case bucketIPv6Subnet64:
+		if ip4 == nil {
+			return ip.Mask(net.CIDRMask(64, 128)).String()
+		}
+	case bucketAddress:
+		return to.String()
+	}
+	return ""
+}
+
+// TierAmount returns the amount to send for a request from ip to the
+// address to: the amount of the first tier applying to the request which
+// sets one, or def.
+func TierAmount(tiers []ThrottleTier, ip net.IP, to crypto.Address, def std.Coins) std.Coins {
+	for _, tier := range tiers {
+		if !tier.Amount.IsZero() && tier.bucket(ip, to) != "" {
+			return tier.Amount
+		}
+	}
+	return def
+}
+
+// ParseThrottleTiers parses comma-separated tiers of the form
+// <bucket>:<max>/<window>[=<amount>], like
+// "ip4/24:5/1m=1000000ugnot,addr:1/24h".
+func ParseThrottleTiers(s string) ([]ThrottleTier, error) {
+	var tiers []ThrottleTier
+	for _, spec := range strings.Split(s, ",") {
+		spec = strings.TrimSpace(spec)
+		if spec == "" {
+			continue
+		}
+		var amount std.Coins
+		tier, amountStr, hasAmount := strings.Cut(spec, "=")
+		if hasAmount {
+			coin, err := std.ParseCoin(amountStr)
+			if err != nil || !coin.IsPositive() {
+				return nil, fmt.Errorf("invalid throttle tier %q, invalid amount %q", spec, amountStr)
+			}
+			amount = std.Coins{coin}
+		}
+		bucket, limit, ok := strings.Cut(tier, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid throttle tier %q, expected <bucket>:<max>/<window>", spec)
+		}
+		switch bucket {
+		case bucketIPv4Subnet24, bucketIPv6Subnet48, bucketIPv6Subnet64, bucketAddress:
+		default:
+			return nil, fmt.Errorf("invalid throttle tier %q, unknown bucket %q", spec, bucket)
+		}
+		maxStr, windowStr, ok := strings.Cut(limit, "/")
+		if !ok {
+			return nil, fmt.Errorf("invalid throttle tier %q, expected <bucket>:<max>/<window>", spec)
+		}
+		max, err := strconv.ParseInt(maxStr, 10, 64)
+		if err != nil || max < 0 {
+			return nil, fmt.Errorf("invalid throttle tier %q, invalid max %q", spec, maxStr)
+		}
+		window, err := time.ParseDuration(windowStr)
+		if err != nil || window <= 0 {
+			return nil, fmt.Errorf("invalid throttle tier %q, invalid window %q", spec, windowStr)
+		}
+		tiers = append(tiers, ThrottleTier{Bucket: bucket, Max: max, Window: window, Amount: amount})
+	}
+	return tiers, nil
+}
+
+// ThrottlerStatus is the state of the counters of a Throttler.
+type ThrottlerStatus struct {
+	Tiers []TierStatus `json:"tiers"`
+}
+
+type TierStatus struct {
+	Tier    string         `json:"tier"`
+	Buckets []BucketStatus `json:"buckets"`
+}
+
+type BucketStatus struct {
+	Bucket string    `json:"bucket"`
+	Count  int64     `json:"count"`
+	Reset  time.Time `json:"reset"`
+}
+
+// DBThrottler is a Throttler counting requests in fixed windows, which
+// persists its counters in a db so that they survive restarts.
+type DBThrottler struct {
 	service.BaseService
-	ticker   *time.Ticker
-	subnets3 [2 << (8 * 3)]uint8
-	// subnets2 [2 << (8 * 2)]uint8
-	// subnets1 [2 << (8 * 1)]uint8
+
+	mtx    sync.Mutex
+	db     dbm.DB
+	tiers  []ThrottleTier
+	ticker *time.Ticker
+	now    func() time.Time
 }
 
-func NewSubnetThrottler() *SubnetThrottler {
-	st := &SubnetThrottler{}
-	// st.ticker = time.NewTicker(time.Second)
-	st.ticker = time.NewTicker(time.Minute)
-	st.BaseService = *service.NewBaseService(nil, "SubnetThrottler", st)
-	return st
+var _ Throttler = (*DBThrottler)(nil)
+
+func NewDBThrottler(db dbm.DB, tiers []ThrottleTier) *DBThrottler {
+	dt := &DBThrottler{
+		db:    db,
+		tiers: tiers,
+		now:   time.Now,
+	}
+	dt.BaseService = *service.NewBaseService(nil, "DBThrottler", dt)
+	return dt
 }
 
-func (st *SubnetThrottler) OnStart() error {
-	st.BaseService.OnStart()
-	go st.routineTimer()
+func (dt *DBThrottler) OnStart() error {
+	dt.BaseService.OnStart()
+	dt.ticker = time.NewTicker(time.Minute)
+	go dt.routineTimer()
 	return nil
 }
 
-func (st *SubnetThrottler) routineTimer() {
+func (dt *DBThrottler) OnStop() {
+	dt.BaseService.OnStop()
+	dt.ticker.Stop()
+}
+
+func (dt *DBThrottler) routineTimer() {
 	for {
 		select {
-		case <-st.Quit():
+		case <-dt.Quit():
 			return
-		case <-st.ticker.C:
-			// run something every time interval.
-			for i := range st.subnets3 {
-				st.subnets3[i] /= 2
+		case <-dt.ticker.C:
+			dt.prune()
+		}
+	}
+}
+
+func (dt *DBThrottler) Request(ip net.IP, to crypto.Address) (allowed bool, reason string) {
+	dt.mtx.Lock()
+	defer dt.mtx.Unlock()
+
+	now := dt.now()
+	batch := dt.db.NewBatch()
+	defer batch.Close()
+	for _, tier := range dt.tiers {
+		bucket := tier.bucket(ip, to)
+		if bucket == "" {
+			continue
+		}
+		key := counterKey(tier, bucket)
+		ctr := dt.getCounter(key, tier, now)
+		if ctr.count >= tier.Max {
+			return false, fmt.Sprintf("%s limit of %d per %s", tier.Bucket, tier.Max, tier.Window)
+		}
+		ctr.count++
+		batch.Set(key, ctr.bytes())
+	}
+	batch.WriteSync()
+	return true, ""
+}
+
+func (dt *DBThrottler) Cancel(ip net.IP, to crypto.Address) {
+	dt.mtx.Lock()
+	defer dt.mtx.Unlock()
+
+	now := dt.now()
+	batch := dt.db.NewBatch()
+	defer batch.Close()
+	for _, tier := range dt.tiers {
+		bucket := tier.bucket(ip, to)
+		if bucket == "" {
+			continue
+		}
+		key := counterKey(tier, bucket)
+		// the counter is zero if its window ended since the request.
+		ctr := dt.getCounter(key, tier, now)
+		if ctr.count == 0 {
+			continue
+		}
+		ctr.count--
+		batch.Set(key, ctr.bytes())
+	}
+	batch.WriteSync()
+}
+
+func (dt *DBThrottler) Status() ThrottlerStatus {
+	dt.mtx.Lock()
+	defer dt.mtx.Unlock()
+
+	now := dt.now()
+	status := ThrottlerStatus{Tiers: []TierStatus{}}
+	for _, tier := range dt.tiers {
+		ts := TierStatus{Tier: tier.String(), Buckets: []BucketStatus{}}
+		prefix := []byte(counterPrefix + tier.key() + ":")
+		itr := dbm.IteratePrefix(dt.db, prefix)
+		for ; itr.Valid(); itr.Next() {
+			ctr := parseCounter(itr.Value())
+			if !ctr.start.Equal(now.Truncate(tier.Window)) {
+				continue // expired.
 			}
+			ts.Buckets = append(ts.Buckets, BucketStatus{
+				Bucket: string(itr.Key()[len(prefix):]),
+				Count:  ctr.count,
+				Reset:  ctr.start.Add(tier.Window),
+			})
 		}
+		itr.Close()
+		status.Tiers = append(status.Tiers, ts)
 	}
+	return status
 }
 
-func (st *SubnetThrottler) Request(ip net.IP) (allowed bool, reason string) {
-	ip = ip.To4()
-	if len(ip) != 4 {
-		return false, "invalid ip format"
+// prune deletes the counters of past windows, and of tiers which are no
+// longer configured.
+func (dt *DBThrottler) prune() {
+	dt.mtx.Lock()
+	defer dt.mtx.Unlock()
+
+	now := dt.now()
+	windows := map[string]time.Duration{}
+	for _, tier := range dt.tiers {
+		windows[tier.key()] = tier.Window
 	}
-	bucket3 := int(ip[0])*256*256 +
-		int(ip[1])*256 +
-		int(ip[2])
-	v := st.subnets3[bucket3]
-	if v > 5 {
-		return false, ">5"
+	var expired [][]byte
+	itr := dbm.IteratePrefix(dt.db, []byte(counterPrefix))
+	for ; itr.Valid(); itr.Next() {
+		tierKey, _, _ := strings.Cut(string(itr.Key()[len(counterPrefix):]), ":")
+		window, ok := windows[tierKey]
+		if !ok || !parseCounter(itr.Value()).start.Equal(now.Truncate(window)) {
+			expired = append(expired, itr.Key())
+		}
+	}
+	itr.Close()
+
+	batch := dt.db.NewBatch()
+	defer batch.Close()
+	for _, key := range expired {
+		batch.Delete(key)
+	}
+	batch.WriteSync()
+}
+
+// getCounter returns the counter at key for the window of now, which is
+// zero if the counter is of a past window.
+func (dt *DBThrottler) getCounter(key []byte, tier ThrottleTier, now time.Time) counter {
+	start := now.Truncate(tier.Window)
+	if bz := dt.db.Get(key); bz != nil {
+		if ctr := parseCounter(bz); ctr.start.Equal(start) {
+			return ctr
+		}
+	}
+	return counter{start: start}
+}
+
+const counterPrefix = "throttle:"
+
+func counterKey(tier ThrottleTier, bucket string) []byte {
+	return []byte(counterPrefix + tier.key() + ":" + bucket)
+}
+
+// counter is the number of requests in a bucket since start.
+type counter struct {
+	start time.Time
+	count int64
+}
+
+func (c counter) bytes() []byte {
+	bz := make([]byte, 16)
+	binary.BigEndian.PutUint64(bz[:8], uint64(c.start.UnixNano()))
+	binary.BigEndian.PutUint64(bz[8:], uint64(c.count))
+	return bz
+}
+
+func parseCounter(bz []byte) counter {
+	if len(bz) != 16 {
+		panic(fmt.Sprintf("invalid throttle counter %X", bz))
+	}
+	return counter{
+		start: time.Unix(0, int64(binary.BigEndian.Uint64(bz[:8]))).UTC(),
+		count: int64(binary.BigEndian.Uint64(bz[8:])),
 	}
-	st.subnets3[bucket3] += 1
-	return true, ""
 }
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/gnolang/gno/tm2/pkg/crypto"
+	dbm "github.com/gnolang/gno/tm2/pkg/db"
+	"github.com/gnolang/gno/tm2/pkg/std"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestThrottler(t *testing.T, db dbm.DB, tiers string, now *time.Time) *DBThrottler {
+	t.Helper()
+
+	parsed, err := ParseThrottleTiers(tiers)
+	require.NoError(t, err)
+	dt := NewDBThrottler(db, parsed)
+	dt.now = func() time.Time { return *now }
+	return dt
+}
+
+func TestDBThrottler(t *testing.T) {
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	db := dbm.NewMemDB()
+	dt := newTestThrottler(t, db, "ip4/24:2/1m,ip6/64:1/1m,ip6/48:2/1m,addr:3/1h", &now)
+
+	addr1 := crypto.AddressFromPreimage([]byte("addr1"))
+	addr2 := crypto.AddressFromPreimage([]byte("addr2"))
+	request := func(ip string, to crypto.Address) bool {
+		allowed, _ := dt.Request(net.ParseIP(ip), to)
+		return allowed
+	}
+
+	// IPv4 clients are limited per /24 subnet.
+	assert.True(t, request("1.2.3.4", addr1))
+	assert.True(t, request("1.2.3.5", addr2))
+	assert.False(t, request("1.2.3.6", addr2))
+	assert.True(t, request("1.2.4.6", addr2))
+
+	// IPv6 clients are limited per /64 and /48 subnets.
+	assert.True(t, request("2001:db8:1:1::1", addr1))
+	assert.False(t, request("2001:db8:1:1::2", addr1))
+	assert.True(t, request("2001:db8:1:2::1", addr1)) // third request to addr1.
+	assert.False(t, request("2001:db8:1:3::1", addr2))
+
+	// Recipient addresses are limited in any subnet.
+	assert.False(t, request("5.6.7.8", addr1))
+	allowed, reason := dt.Request(net.ParseIP("5.6.7.8"), addr1)
+	assert.False(t, allowed)
+	assert.Equal(t, "addr limit of 3 per 1h0m0s", reason)
+
+	status := dt.Status()
+	require.Len(t, status.Tiers, 4)
+	assert.Equal(t, "ip4/24:2/1m0s", status.Tiers[0].Tier)
+	assert.Equal(t, []BucketStatus{
+		{Bucket: "1.2.3.0", Count: 2, Reset: now.Add(time.Minute)},
+		{Bucket: "1.2.4.0", Count: 1, Reset: now.Add(time.Minute)},
+	}, status.Tiers[0].Buckets)
+
+	// The counters are persisted.
+	dt = newTestThrottler(t, db, "ip4/24:2/1m,ip6/64:1/1m,ip6/48:2/1m,addr:3/1h", &now)
+	assert.False(t, request("1.2.3.7", addr2))
+
+	// And reset in the next window.
+	now = now.Add(time.Minute)
+	assert.True(t, request("1.2.3.7", addr2))
+	assert.False(t, request("1.2.3.7", addr1))
+
+	// Past windows are pruned.
+	dt.prune()
+	status = dt.Status()
+	assert.Equal(t, []BucketStatus{
+		{Bucket: "1.2.3.0", Count: 1, Reset: now.Add(time.Minute)},
+	}, status.Tiers[0].Buckets)
+	itr := dbm.IteratePrefix(db, []byte(counterPrefix+"ip6/64@1m0s:"))
+	assert.False(t, itr.Valid())
+	itr.Close()
+}
+
+func TestDBThrottlerCancel(t *testing.T) {
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	dt := newTestThrottler(t, dbm.NewMemDB(), "ip4/24:1/1m,addr:2/1h", &now)
+
+	addr1 := crypto.AddressFromPreimage([]byte("addr1"))
+	ip := net.ParseIP("1.2.3.4")
+
+	// A cancelled request doesn't count.
+	allowed, _ := dt.Request(ip, addr1)
+	require.True(t, allowed)
+	dt.Cancel(ip, addr1)
+	allowed, _ = dt.Request(ip, addr1)
+	assert.True(t, allowed)
+	allowed, _ = dt.Request(ip, addr1)
+	assert.False(t, allowed)
+
+	// After the end of a window, cancelling only applies to the tiers
+	// whose window didn't end.
+	now = now.Add(time.Minute)
+	dt.Cancel(ip, addr1)
+	status := dt.Status()
+	assert.Empty(t, status.Tiers[0].Buckets)
+	assert.Equal(t, int64(0), status.Tiers[1].Buckets[0].Count)
+}
+
+func TestTierAmount(t *testing.T) {
+	tiers, err := ParseThrottleTiers("ip4/24:5/1m=1000ugnot,ip6/64:5/1m=2000ugnot,addr:1/24h=3000ugnot")
+	require.NoError(t, err)
+
+	addr1 := crypto.AddressFromPreimage([]byte("addr1"))
+	def := std.NewCoins(std.NewCoin("ugnot", 10))
+	assert.Equal(t, "1000ugnot", TierAmount(tiers, net.ParseIP("1.2.3.4"), addr1, def).String())
+	assert.Equal(t, "2000ugnot", TierAmount(tiers, net.ParseIP("2001:db8::1"), addr1, def).String())
+	// The first tier applying to the request which sets an amount is used.
+	ip6 := net.ParseIP("2001:db8::1")
+	assert.Equal(t, "3000ugnot", TierAmount([]ThrottleTier{tiers[0], tiers[2]}, ip6, addr1, def).String())
+	assert.Equal(t, "10ugnot", TierAmount(tiers[:1], ip6, addr1, def).String())
+}
+
+func TestParseThrottleTiers(t *testing.T) {
+	tiers, err := ParseThrottleTiers(DefaultThrottleTiers)
+	require.NoError(t, err)
+	assert.Equal(t, ThrottleTier{Bucket: "addr", Max: 5, Window: 24 * time.Hour}, tiers[3])
+
+	tiers, err = ParseThrottleTiers("ip4/24:5/1m=1000ugnot")
+	require.NoError(t, err)
+	assert.Equal(t, std.NewCoins(std.NewCoin("ugnot", 1000)), tiers[0].Amount)
+	assert.Equal(t, "ip4/24:5/1m0s=1000ugnot", tiers[0].String())
+
+	for _, invalid := range []string{
+		"ip4/16:5/1m",
+		"ip4/24",
+		"ip4/24:5",
+		"ip4/24:x/1m",
+		"ip4/24:5/0s",
+		"ip4/24:5/1m=",
+		"ip4/24:5/1m=0ugnot",
+		"ip4/24:5/1m=x",
+	} {
+		_, err := ParseThrottleTiers(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
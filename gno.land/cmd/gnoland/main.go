@@ -40,7 +40,7 @@ func main() {
 
 	cmd := commands.NewCommand(
 		commands.Metadata{
-			ShortUsage: "[flags] [<arg>...]",
+			ShortUsage: "[flags] [<subcommand>] [<arg>...]",
 			LongHelp:   "Starts the gnoland blockchain node",
 		},
 		cfg,
@@ -49,6 +49,10 @@ func main() {
 		},
 	)
 
+	cmd.AddSubCommands(
+		newTestnetCmd(cfg),
+	)
+
 	if err := cmd.ParseAndRun(context.Background(), os.Args[1:]); err != nil {
 		_, _ = fmt.Fprintf(os.Stderr, "%+v", err)
 
@@ -111,10 +115,7 @@ func exec(c *gnolandCfg) error {
 	logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout))
 	rootDir := c.rootDir
 
-	cfg := config.LoadOrMakeConfigWithOptions(rootDir, func(cfg *config.Config) {
-		cfg.Consensus.CreateEmptyBlocks = false
-		cfg.Consensus.CreateEmptyBlocksInterval = 60 * time.Second
-	})
+	cfg := config.LoadOrMakeConfigWithOptions(rootDir, setDefaultConfig)
 
 	// create priv validator first.
 	// need it to generate genesis.json
@@ -125,8 +126,16 @@ func exec(c *gnolandCfg) error {
 	// write genesis file if missing.
 	genesisFilePath := filepath.Join(rootDir, cfg.Genesis)
 	if !osm.FileExists(genesisFilePath) {
+		pvPub := priv.GetPubKey()
 		genDoc := makeGenesisDoc(
-			priv.GetPubKey(),
+			[]bft.GenesisValidator{
+				{
+					Address: pvPub.Address(),
+					PubKey:  pvPub,
+					Power:   10,
+					Name:    "testvalidator",
+				},
+			},
 			c.chainID,
 			c.genesisBalancesFile,
 			loadGenesisTxs(c.genesisTxsFile, c.chainID, c.genesisRemote),
@@ -169,9 +178,15 @@ func exec(c *gnolandCfg) error {
 	select {} // run forever
 }
 
-// Makes a local test genesis doc with local privValidator.
+// setDefaultConfig sets the defaults of the config of new nodes.
+func setDefaultConfig(cfg *config.Config) {
+	cfg.Consensus.CreateEmptyBlocks = false
+	cfg.Consensus.CreateEmptyBlocksInterval = 60 * time.Second
+}
+
+// Makes a local test genesis doc with the given validators.
 func makeGenesisDoc(
-	pvPub crypto.PubKey,
+	validators []bft.GenesisValidator,
 	chainID string,
 	genesisBalancesFile string,
 	genesisTxs []std.Tx,
@@ -189,14 +204,7 @@ func makeGenesisDoc(
 			TimeIotaMS:   100,      // 100ms
 		},
 	}
-	gen.Validators = []bft.GenesisValidator{
-		{
-			Address: pvPub.Address(),
-			PubKey:  pvPub,
-			Power:   10,
-			Name:    "testvalidator",
-		},
-	}
+	gen.Validators = validators
 
 	// Load distribution.
 	balances := loadGenesisBalances(genesisBalancesFile)
@@ -225,6 +233,7 @@ func makeGenesisDoc(
 		"r/gnoland/faucet",
 		"r/system/validators",
 		"r/system/names",
+		"r/system/params",
 		"r/system/rewards",
 		"r/demo/deep/very/deep",
 	} {
@@ -289,7 +298,9 @@ func loadGenesisTxs(
 }
 
 func loadGenesisBalances(path string) []string {
-	// each balance is in the form: g1xxxxxxxxxxxxxxxx=100000ugnot
+	// each balance is in the form: g1xxxxxxxxxxxxxxxx=100000ugnot, optionally
+	// locked by a vesting schedule: g1xxx=100ugnot@delayed:<end> or
+	// g1xxx=100ugnot@continuous:<start>:<end>, in unix seconds.
 	balances := []string{}
 	content := osm.MustReadFile(path)
 	lines := strings.Split(string(content), "\n")
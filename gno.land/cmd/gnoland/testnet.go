@@ -0,0 +1,147 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/gnolang/gno/tm2/pkg/bft/config"
+	"github.com/gnolang/gno/tm2/pkg/bft/privval"
+	bft "github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/gnolang/gno/tm2/pkg/commands"
+	osm "github.com/gnolang/gno/tm2/pkg/os"
+	"github.com/gnolang/gno/tm2/pkg/p2p"
+)
+
+type testnetCfg struct {
+	rootCfg *gnolandCfg
+
+	numValidators int
+	outputDir     string
+	hostname      string
+	basePort      int
+}
+
+func newTestnetCmd(rootCfg *gnolandCfg) *commands.Command {
+	cfg := &testnetCfg{
+		rootCfg: rootCfg,
+	}
+
+	return commands.NewCommand(
+		commands.Metadata{
+			Name:       "testnet",
+			ShortUsage: "testnet [flags]",
+			ShortHelp:  "Initializes the nodes of a local multi-validator network",
+			LongHelp: "Creates the homes of -v validator nodes in the -o directory, each with its own " +
+				"node key, validator key and config.toml. The nodes share a genesis with all the " +
+				"validators, and the balances and txs of the genesis files. They listen on distinct " +
+				"ports of -hostname and are persistent peers of each other. " +
+				"Start each node with `gnoland -root-dir <dir>/node<i>`.",
+		},
+		cfg,
+		func(_ context.Context, _ []string) error {
+			return execTestnet(cfg, commands.NewDefaultIO())
+		},
+	)
+}
+
+func (c *testnetCfg) RegisterFlags(fs *flag.FlagSet) {
+	fs.IntVar(
+		&c.numValidators,
+		"v",
+		4,
+		"number of validator nodes",
+	)
+
+	fs.StringVar(
+		&c.outputDir,
+		"o",
+		"./mytestnet",
+		"directory for the homes of the nodes",
+	)
+
+	fs.StringVar(
+		&c.hostname,
+		"hostname",
+		"127.0.0.1",
+		"host the nodes listen on and dial each other at",
+	)
+
+	fs.IntVar(
+		&c.basePort,
+		"base-port",
+		26656,
+		"p2p port of node0; node<i> uses base-port+10*i for p2p and the next port for rpc",
+	)
+}
+
+func execTestnet(c *testnetCfg, io *commands.IO) error {
+	if c.numValidators < 1 {
+		return fmt.Errorf("invalid number of validators: %d", c.numValidators)
+	}
+
+	// create the keys of the nodes, to make the genesis and peers.
+	var (
+		homes      = make([]string, c.numValidators)
+		validators = make([]bft.GenesisValidator, c.numValidators)
+		peers      = make([]string, c.numValidators)
+	)
+	for i := range homes {
+		home := filepath.Join(c.outputDir, fmt.Sprintf("node%d", i))
+		if osm.FileExists(home) {
+			return fmt.Errorf("node home %s already exists", home)
+		}
+		paths := config.DefaultConfig().SetRootDir(home)
+		paths.EnsureDirs()
+
+		nodeKey, err := p2p.LoadOrGenNodeKey(paths.NodeKeyFile())
+		if err != nil {
+			return fmt.Errorf("error in generating node key: %w", err)
+		}
+		priv := privval.LoadOrGenFilePV(paths.PrivValidatorKeyFile(), paths.PrivValidatorStateFile())
+		pvPub := priv.GetPubKey()
+
+		homes[i] = home
+		validators[i] = bft.GenesisValidator{
+			Address: pvPub.Address(),
+			PubKey:  pvPub,
+			Power:   10,
+			Name:    fmt.Sprintf("node%d", i),
+		}
+		peers[i] = fmt.Sprintf("%s@%s:%d", nodeKey.ID(), c.hostname, c.p2pPort(i))
+	}
+
+	genDoc := makeGenesisDoc(
+		validators,
+		c.rootCfg.chainID,
+		c.rootCfg.genesisBalancesFile,
+		loadGenesisTxs(c.rootCfg.genesisTxsFile, c.rootCfg.chainID, c.rootCfg.genesisRemote),
+	)
+
+	// write the shared genesis, and the configs peering with the other nodes.
+	for i, home := range homes {
+		cfg := config.LoadOrMakeConfigWithOptions(home, func(cfg *config.Config) {
+			setDefaultConfig(cfg)
+			cfg.Moniker = validators[i].Name
+			cfg.P2P.ListenAddress = fmt.Sprintf("tcp://%s:%d", c.hostname, c.p2pPort(i))
+			cfg.RPC.ListenAddress = fmt.Sprintf("tcp://%s:%d", c.hostname, c.p2pPort(i)+1)
+			cfg.P2P.PersistentPeers = strings.Join(append(peers[:i:i], peers[i+1:]...), ",")
+			// all the nodes share the same IP.
+			cfg.P2P.AllowDuplicateIP = true
+		})
+		writeGenesisFile(genDoc, cfg.GenesisFile())
+
+		io.Printfln("%s: validator %s, p2p %s, rpc %s",
+			home, validators[i].Address, peers[i], cfg.RPC.ListenAddress)
+	}
+
+	io.Printfln("Genesis and configs of %d nodes written to %s", c.numValidators, c.outputDir)
+	return nil
+}
+
+// p2pPort returns the p2p port of the i-th node, followed by its rpc port.
+func (c *testnetCfg) p2pPort(i int) int {
+	return c.basePort + 10*i
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnolang/gno/tm2/pkg/bft/config"
+	bft "github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/gnolang/gno/tm2/pkg/commands"
+	"github.com/gnolang/gno/tm2/pkg/p2p"
+)
+
+func TestTestnet(t *testing.T) {
+	// the example packages of the genesis are read from the gno.land dir.
+	_, file, _, _ := runtime.Caller(0)
+	gnolandDir := filepath.Join(filepath.Dir(file), "..", "..")
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(gnolandDir))
+	defer os.Chdir(wd)
+
+	outputDir := filepath.Join(t.TempDir(), "mytestnet")
+	cfg := &testnetCfg{
+		rootCfg: &gnolandCfg{
+			genesisBalancesFile: "./genesis/genesis_balances.txt",
+			genesisTxsFile:      "./genesis/genesis_txs.txt",
+			chainID:             "testnet",
+			genesisRemote:       "localhost:26657",
+		},
+		numValidators: 2,
+		outputDir:     outputDir,
+		hostname:      "127.0.0.1",
+		basePort:      26656,
+	}
+	io := commands.NewTestIO()
+	io.SetOut(commands.WriteNopCloser(new(bytes.Buffer)))
+	require.NoError(t, execTestnet(cfg, io))
+
+	var (
+		genesis  []byte
+		nodeIDs  []p2p.ID
+		nodeCfgs []*config.Config
+	)
+	for _, node := range []string{"node0", "node1"} {
+		nodeCfg := config.LoadOrMakeConfigWithOptions(filepath.Join(outputDir, node), nil)
+		nodeKey, err := p2p.LoadNodeKey(nodeCfg.NodeKeyFile())
+		require.NoError(t, err)
+		nodeIDs = append(nodeIDs, nodeKey.ID())
+		nodeCfgs = append(nodeCfgs, nodeCfg)
+
+		// the nodes share the same genesis, with both validators.
+		bz, err := os.ReadFile(nodeCfg.GenesisFile())
+		require.NoError(t, err)
+		if genesis != nil {
+			assert.Equal(t, genesis, bz)
+		}
+		genesis = bz
+		genDoc, err := bft.GenesisDocFromJSON(bz)
+		require.NoError(t, err)
+		assert.Equal(t, "testnet", genDoc.ChainID)
+		require.Len(t, genDoc.Validators, 2)
+		assert.Equal(t, node, genDoc.Validators[len(nodeCfgs)-1].Name)
+	}
+
+	// the nodes listen on distinct ports and peer with each other.
+	assert.Equal(t, "tcp://127.0.0.1:26656", nodeCfgs[0].P2P.ListenAddress)
+	assert.Equal(t, "tcp://127.0.0.1:26657", nodeCfgs[0].RPC.ListenAddress)
+	assert.Equal(t, "tcp://127.0.0.1:26666", nodeCfgs[1].P2P.ListenAddress)
+	assert.Equal(t, "tcp://127.0.0.1:26667", nodeCfgs[1].RPC.ListenAddress)
+	assert.Equal(t, string(nodeIDs[1])+"@127.0.0.1:26666", nodeCfgs[0].P2P.PersistentPeers)
+	assert.Equal(t, string(nodeIDs[0])+"@127.0.0.1:26656", nodeCfgs[1].P2P.PersistentPeers)
+
+	// existing node homes are not overwritten.
+	require.Error(t, execTestnet(cfg, io))
+}
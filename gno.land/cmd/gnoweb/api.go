@@ -0,0 +1,144 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gnolang/gno/tm2/pkg/amino"
+	"github.com/gnolang/gno/tm2/pkg/std"
+)
+
+// The JSON API mirrors the vm queries, for clients which don't speak
+// ABCI. Every endpoint takes its arguments as URL query parameters, and
+// returns either its result or {"error": "..."}.
+
+// apiError is an API error with its HTTP status.
+type apiError struct {
+	status int
+	msg    string
+}
+
+func (e apiError) Error() string {
+	return e.msg
+}
+
+// handlerAPI serves the result of fn as JSON.
+func handlerAPI(fn func(r *http.Request) (interface{}, error)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		ret, err := fn(r)
+		if err != nil {
+			status := http.StatusInternalServerError
+			if aerr, ok := err.(apiError); ok {
+				status = aerr.status
+			}
+			ret = struct {
+				Error string `json:"error"`
+			}{err.Error()}
+			w.WriteHeader(status)
+		}
+		out, _ := json.MarshalIndent(ret, "", "  ")
+		w.Write(out)
+	})
+}
+
+// apiParam returns the query parameter name of r, which is required.
+func apiParam(r *http.Request, name string) (string, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return "", apiError{http.StatusBadRequest, fmt.Sprintf("missing parameter %q", name)}
+	}
+	return value, nil
+}
+
+// apiRender returns Render(path) of the realm pkgpath.
+func apiRender(r *http.Request) (interface{}, error) {
+	pkgPath, err := apiParam(r, "pkgpath")
+	if err != nil {
+		return nil, err
+	}
+	path := r.URL.Query().Get("path")
+	res, err := makeRequest("vm/qrender", []byte(pkgPath+"\n"+path))
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{"result": string(res.Data)}, nil
+}
+
+// apiFuncs returns the signatures of the exported functions of the realm
+// pkgpath.
+func apiFuncs(r *http.Request) (interface{}, error) {
+	pkgPath, err := apiParam(r, "pkgpath")
+	if err != nil {
+		return nil, err
+	}
+	res, err := makeRequest("vm/qfuncs", []byte(pkgPath))
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(res.Data), nil
+}
+
+// apiFile returns the body of the file at path, or the file names if path
+// is a package.
+func apiFile(r *http.Request) (interface{}, error) {
+	path, err := apiParam(r, "path")
+	if err != nil {
+		return nil, err
+	}
+	res, err := makeRequest(qFileStr, []byte(path))
+	if err != nil {
+		return nil, err
+	}
+	if _, filename := std.SplitFilepath(path); filename == "" {
+		return map[string][]string{"files": strings.Split(string(res.Data), "\n")}, nil
+	}
+	return map[string]string{"body": string(res.Data)}, nil
+}
+
+// apiEval evaluates expr in the package pkgpath, in readonly mode.
+func apiEval(r *http.Request) (interface{}, error) {
+	pkgPath, err := apiParam(r, "pkgpath")
+	if err != nil {
+		return nil, err
+	}
+	expr, err := apiParam(r, "expr")
+	if err != nil {
+		return nil, err
+	}
+	res, err := makeRequest("vm/qeval", []byte(pkgPath+"\n"+expr))
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{"result": string(res.Data)}, nil
+}
+
+// apiBalance returns the coins of the account address.
+func apiBalance(r *http.Request) (interface{}, error) {
+	address, err := apiParam(r, "address")
+	if err != nil {
+		return nil, err
+	}
+	res, err := makeRequest("bank/balances/"+address, nil)
+	if err != nil {
+		return nil, err
+	}
+	var coins std.Coins
+	if err := amino.UnmarshalJSON(res.Data, &coins); err != nil {
+		return nil, err
+	}
+	return map[string]string{"address": address, "coins": coins.String()}, nil
+}
+
+// apiTxs returns the most recent transactions which added or called the
+// package pkgpath, newest first.
+func apiTxs(r *http.Request) (interface{}, error) {
+	pkgPath, err := apiParam(r, "pkgpath")
+	if err != nil {
+		return nil, err
+	}
+	txs := realmTxIndex.realmTxs(pkgPath)
+	return json.RawMessage(amino.MustMarshalJSON(txs)), nil
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"html/template"
 	"io"
 	"net/http"
 	"os"
@@ -15,6 +16,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/gnolang/gno/gnovm/pkg/doc"
 	"github.com/gnolang/gno/tm2/pkg/amino"
 	abci "github.com/gnolang/gno/tm2/pkg/bft/abci/types"
 	"github.com/gnolang/gno/tm2/pkg/bft/rpc/client"
@@ -41,10 +43,14 @@ var flags struct {
 	pagesDir    string
 	helpChainID string
 	helpRemote  string
+	txsBlocks   int
 }
 
 var startedAt time.Time
 
+// realmTxIndex is filled in the background by main.
+var realmTxIndex = newTxIndex()
+
 func init() {
 	flag.StringVar(&flags.remoteAddr, "remote", "127.0.0.1:26657", "remote gnoland node address")
 	flag.StringVar(&flags.bindAddr, "bind", "127.0.0.1:8888", "server listening address")
@@ -54,6 +60,7 @@ func init() {
 	flag.StringVar(&flags.pagesDir, "pages-dir", "./cmd/gnoweb/pages", "pages directory location")
 	flag.StringVar(&flags.helpChainID, "help-chainid", "dev", "help page's chainid")
 	flag.StringVar(&flags.helpRemote, "help-remote", "127.0.0.1:26657", "help page's remote addr")
+	flag.IntVar(&flags.txsBlocks, "txs-blocks", 1000, "number of recent blocks indexed at startup for the transactions of realms")
 	startedAt = time.Now()
 }
 
@@ -63,6 +70,7 @@ func makeApp() gotuna.App {
 		Router:    gotuna.NewMuxRouter(),
 		Static:    static.EmbeddedStatic,
 		// StaticPrefix: "static/",
+		ViewHelpers: []gotuna.ViewHelperFunc{helperDocHTML},
 	}
 	app.Router.Handle("/", handlerHome(app))
 	app.Router.Handle("/about", handlerAbout(app))
@@ -77,11 +85,18 @@ func makeApp() gotuna.App {
 	app.Router.Handle("/static/{path:.+}", handlerStaticFile(app))
 	app.Router.Handle("/favicon.ico", handlerFavicon(app))
 	app.Router.Handle("/status.json", handlerStatusJSON(app))
+	app.Router.Handle("/api/v1/render", handlerAPI(apiRender))
+	app.Router.Handle("/api/v1/funcs", handlerAPI(apiFuncs))
+	app.Router.Handle("/api/v1/file", handlerAPI(apiFile))
+	app.Router.Handle("/api/v1/eval", handlerAPI(apiEval))
+	app.Router.Handle("/api/v1/balance", handlerAPI(apiBalance))
+	app.Router.Handle("/api/v1/txs", handlerAPI(apiTxs))
 	return app
 }
 
 func main() {
 	flag.Parse()
+	go realmTxIndex.run(client.NewHTTP(flags.remoteAddr, "/websocket"))
 	fmt.Printf("Running on http://%s\n", flags.bindAddr)
 	server := &http.Server{
 		Addr:              flags.bindAddr,
@@ -233,6 +248,10 @@ func handlerRealmMain(app gotuna.App) http.Handler {
 			tmpl.Set("DirPath", pathOf(rlmpath))
 			tmpl.Set("FunctionSignatures", fsigs)
 			tmpl.Render(w, r, "realm_help.html", "funcs.html")
+		} else if query.Has("doc") {
+			renderPackageDoc(app, w, r, rlmpath)
+		} else if query.Has("txs") {
+			renderRealmTxs(app, w, r, rlmpath)
 		} else {
 			// Ensure realm exists. TODO optimize.
 			qpath := qFileStr
@@ -307,6 +326,10 @@ func handlerRealmFile(app gotuna.App) http.Handler {
 		vars := mux.Vars(r)
 		diruri := "gno.land/r/" + vars["rlmname"]
 		filename := vars["filename"]
+		if filename == "" && r.URL.Query().Has("doc") {
+			renderPackageDoc(app, w, r, diruri)
+			return
+		}
 		renderPackageFile(app, w, r, diruri, filename)
 	})
 }
@@ -315,6 +338,10 @@ func handlerPackageFile(app gotuna.App) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
 		pkgpath := "gno.land/p/" + vars["filepath"]
+		if r.URL.Query().Has("doc") {
+			renderPackageDoc(app, w, r, strings.TrimSuffix(pkgpath, "/"))
+			return
+		}
 		diruri, filename := std.SplitFilepath(pkgpath)
 		if filename == "" && diruri == pkgpath {
 			// redirect to diruri + "/"
@@ -362,6 +389,62 @@ func renderPackageFile(app gotuna.App, w http.ResponseWriter, r *http.Request, d
 	}
 }
 
+// renderPackageDoc renders the documentation of the package at diruri,
+// extracted from its files.
+func renderPackageDoc(app gotuna.App, w http.ResponseWriter, r *http.Request, diruri string) {
+	res, err := makeRequest(qFileStr, []byte(diruri))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	memPkg := &std.MemPackage{Path: diruri}
+	for _, filename := range strings.Split(string(res.Data), "\n") {
+		if !strings.HasSuffix(filename, ".gno") {
+			continue
+		}
+		res, err := makeRequest(qFileStr, []byte(diruri+"/"+filename))
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		memPkg.Files = append(memPkg.Files, &std.MemFile{
+			Name: filename,
+			Body: string(res.Data),
+		})
+	}
+	pkgDoc, err := doc.New(memPkg)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	// Render template.
+	tmpl := app.NewTemplatingEngine()
+	tmpl.Set("DirURI", diruri)
+	tmpl.Set("DirPath", pathOf(diruri))
+	tmpl.Set("Package", pkgDoc)
+	tmpl.Render(w, r, "package_doc.html", "funcs.html")
+}
+
+// renderRealmTxs renders the most recent transactions which added or
+// called the realm at rlmpath.
+func renderRealmTxs(app gotuna.App, w http.ResponseWriter, r *http.Request, rlmpath string) {
+	txs := realmTxIndex.realmTxs(rlmpath)
+	// Render template.
+	tmpl := app.NewTemplatingEngine()
+	tmpl.Set("RealmPath", rlmpath)
+	tmpl.Set("DirPath", pathOf(rlmpath))
+	tmpl.Set("Txs", txs)
+	tmpl.Render(w, r, "realm_txs.html", "funcs.html")
+}
+
+// helperDocHTML defines docHTML for templates, formatting doc comments.
+func helperDocHTML(w http.ResponseWriter, r *http.Request) (string, interface{}) {
+	return "docHTML", func(text string) template.HTML {
+		// doc.HTML escapes the comment text.
+		return template.HTML(doc.HTML(text)) //nolint:gosec
+	}
+}
+
 func makeRequest(qpath string, data []byte) (res *abci.ResponseQuery, err error) {
 	opts2 := client.ABCIQueryOptions{
 		// Height: height, XXX
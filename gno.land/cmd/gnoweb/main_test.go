@@ -32,6 +32,18 @@ func TestRoutes(t *testing.T) {
 		{"/r/demo/deep/very/deep?help", ok, "exposed"},
 		{"/r/demo/deep/very/deep/", ok, "render.gno"},
 		{"/r/demo/deep/very/deep/render.gno", ok, "func Render("},
+		{"/r/demo/users?doc", ok, "func GetUserByName(name string) *User"},
+		{"/p/demo/avl?doc", ok, "func (tree *Tree) Get(key string)"},
+		{"/p/demo/avl/?doc", ok, "type Tree struct"},
+		{"/r/demo/users?txs", ok, "No transactions."}, // added by the genesis.
+		{"/api/v1/render?pkgpath=gno.land/r/demo/deep/very/deep&path=bob", ok, "hi bob"},
+		{"/api/v1/funcs?pkgpath=gno.land/r/demo/users", ok, "GetUserByName"},
+		{"/api/v1/file?path=gno.land/r/demo/users", ok, "types.gno"},
+		{"/api/v1/file?path=gno.land/r/demo/users/types.gno", ok, "type "},
+		{"/api/v1/eval?pkgpath=gno.land/r/demo/deep/very/deep&expr=Render(%22x%22)", ok, "hi x"},
+		{"/api/v1/balance?address=g1jg8mtutu9khhfwc4nxmuhcpftf0pajdhfvsqf5", ok, "coins"},
+		{"/api/v1/txs?pkgpath=gno.land/r/demo/users", ok, "[]"},
+		{"/api/v1/render", http.StatusBadRequest, "missing parameter"},
 	}
 	if wd, err := os.Getwd(); err == nil {
 		if strings.HasSuffix(wd, "cmd/gnoweb") {
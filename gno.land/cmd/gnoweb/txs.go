@@ -0,0 +1,192 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/gnolang/gno/tm2/pkg/amino"
+	abci "github.com/gnolang/gno/tm2/pkg/bft/abci/types"
+	"github.com/gnolang/gno/tm2/pkg/bft/rpc/client"
+	bft "github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/gnolang/gno/tm2/pkg/crypto"
+	"github.com/gnolang/gno/tm2/pkg/sdk/vm"
+	"github.com/gnolang/gno/tm2/pkg/std"
+)
+
+// maxRealmTxs is the number of transactions kept for each realm.
+const maxRealmTxs = 50
+
+// txsPollInterval is how often the remote node is polled for new blocks.
+const txsPollInterval = 2 * time.Second
+
+// realmTx is a transaction message which added or called a realm.
+type realmTx struct {
+	Height  int64
+	Time    time.Time
+	Hash    string // hex-encoded hash of the transaction.
+	Type    string // "add_package" or "exec".
+	PkgPath string
+	Caller  crypto.Address
+	Func    string   // only for "exec".
+	Args    []string // only for "exec".
+	Send    std.Coins
+}
+
+// txIndex indexes the transactions which added or called realms by
+// package path, as they are committed by the remote node. The history
+// isn't indexed by the chain, so the index is built from the blocks of
+// the remote node: it starts flags.txsBlocks blocks before the latest
+// one, then follows the new blocks.
+type txIndex struct {
+	mtx    sync.RWMutex
+	height int64                // last indexed block.
+	txs    map[string][]realmTx // by package path, oldest first.
+}
+
+func newTxIndex() *txIndex {
+	return &txIndex{txs: make(map[string][]realmTx)}
+}
+
+// realmTxs returns the most recent indexed transactions which added or
+// called the realm at rlmpath, newest first.
+func (idx *txIndex) realmTxs(rlmpath string) []realmTx {
+	idx.mtx.RLock()
+	defer idx.mtx.RUnlock()
+	rtxs := idx.txs[rlmpath]
+	txs := make([]realmTx, len(rtxs))
+	for i, rtx := range rtxs {
+		txs[len(rtxs)-1-i] = rtx
+	}
+	return txs
+}
+
+// run indexes the blocks of the remote node until the process exits.
+func (idx *txIndex) run(cli client.Client) {
+	for {
+		if err := idx.sync(cli); err != nil {
+			fmt.Fprintf(os.Stderr, "indexing transactions: %v\n", err)
+		}
+		time.Sleep(txsPollInterval)
+	}
+}
+
+// sync indexes the blocks committed since the last call.
+func (idx *txIndex) sync(cli client.Client) error {
+	status, err := cli.Status()
+	if err != nil {
+		return err
+	}
+	latest := status.SyncInfo.LatestBlockHeight
+	idx.mtx.RLock()
+	next := idx.height + 1
+	idx.mtx.RUnlock()
+	if next == 1 && latest > int64(flags.txsBlocks) {
+		next = latest - int64(flags.txsBlocks) + 1
+	}
+	for next <= latest {
+		// The metas are returned newest first, 20 at most.
+		last := next + 19
+		if last > latest {
+			last = latest
+		}
+		info, err := cli.BlockchainInfo(next, last)
+		if err != nil {
+			return err
+		}
+		start := next
+		for i := len(info.BlockMetas) - 1; i >= 0; i-- {
+			height := info.BlockMetas[i].Header.Height
+			if height < next {
+				continue
+			}
+			var txs []realmTx
+			if info.BlockMetas[i].Header.NumTxs > 0 {
+				txs, err = blockRealmTxs(cli, height)
+				if err != nil {
+					return err
+				}
+			}
+			idx.add(height, txs)
+			next = height + 1
+		}
+		if next == start {
+			// The blocks are pruned, or not available yet.
+			break
+		}
+	}
+	return nil
+}
+
+// add indexes txs, the transactions of the block at height, newest first.
+func (idx *txIndex) add(height int64, txs []realmTx) {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+	for i := len(txs) - 1; i >= 0; i-- {
+		rtxs := append(idx.txs[txs[i].PkgPath], txs[i])
+		if len(rtxs) > maxRealmTxs {
+			rtxs = rtxs[len(rtxs)-maxRealmTxs:]
+		}
+		idx.txs[txs[i].PkgPath] = rtxs
+	}
+	idx.height = height
+}
+
+// blockRealmTxs returns the successful transactions of the block at height
+// which added or called a realm, newest first.
+func blockRealmTxs(cli client.Client, height int64) ([]realmTx, error) {
+	block, err := cli.Block(&height)
+	if err != nil {
+		return nil, err
+	}
+	results, err := cli.BlockResults(&height)
+	if err != nil {
+		return nil, err
+	}
+	return filterRealmTxs(block.Block, results.Results.DeliverTxs), nil
+}
+
+// filterRealmTxs returns the transactions of block which added or called
+// a realm, and which deliverTxs report as successful, newest first.
+func filterRealmTxs(block *bft.Block, deliverTxs []abci.ResponseDeliverTx) []realmTx {
+	var txs []realmTx
+	for i := len(block.Txs) - 1; i >= 0; i-- {
+		if i >= len(deliverTxs) || deliverTxs[i].IsErr() {
+			continue
+		}
+		txBytes := block.Txs[i]
+		var tx std.Tx
+		if err := amino.Unmarshal(txBytes, &tx); err != nil {
+			// Not a tx of gno.land.
+			continue
+		}
+		for j := len(tx.Msgs) - 1; j >= 0; j-- {
+			rtx := realmTx{
+				Height: block.Height,
+				Time:   block.Time,
+				Hash:   fmt.Sprintf("%X", txBytes.Hash()),
+				Type:   tx.Msgs[j].Type(),
+			}
+			switch msg := tx.Msgs[j].(type) {
+			case vm.MsgAddPackage:
+				if msg.Package == nil {
+					continue
+				}
+				rtx.PkgPath = msg.Package.Path
+				rtx.Caller = msg.Creator
+				rtx.Send = msg.Deposit
+			case vm.MsgCall:
+				rtx.PkgPath = msg.PkgPath
+				rtx.Caller = msg.Caller
+				rtx.Func = msg.Func
+				rtx.Args = msg.Args
+				rtx.Send = msg.Send
+			default:
+				continue
+			}
+			txs = append(txs, rtx)
+		}
+	}
+	return txs
+}
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gnolang/gno/tm2/pkg/amino"
+	abci "github.com/gnolang/gno/tm2/pkg/bft/abci/types"
+	"github.com/gnolang/gno/tm2/pkg/bft/rpc/client"
+	ctypes "github.com/gnolang/gno/tm2/pkg/bft/rpc/core/types"
+	"github.com/gnolang/gno/tm2/pkg/bft/state"
+	bft "github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/gnolang/gno/tm2/pkg/crypto"
+	"github.com/gnolang/gno/tm2/pkg/sdk/bank"
+	"github.com/gnolang/gno/tm2/pkg/sdk/vm"
+	"github.com/gnolang/gno/tm2/pkg/std"
+)
+
+func TestFilterRealmTxs(t *testing.T) {
+	addr := crypto.AddressFromPreimage([]byte("addr1"))
+	const rlmpath = "gno.land/r/test"
+	txBytes := func(msgs ...std.Msg) bft.Tx {
+		return amino.MustMarshal(std.Tx{Msgs: msgs})
+	}
+
+	block := &bft.Block{
+		Header: bft.Header{Height: 7, Time: time.Unix(100, 0)},
+		Data: bft.Data{Txs: bft.Txs{
+			txBytes(vm.NewMsgAddPackage(addr, rlmpath, []*std.MemFile{{Name: "a.gno", Body: "package test"}})),
+			txBytes(vm.NewMsgCall(addr, nil, rlmpath, "Inc", []string{"3"})),
+			// failed.
+			txBytes(vm.NewMsgCall(addr, nil, rlmpath, "Fail", nil)),
+			// other realms and messages.
+			txBytes(vm.NewMsgCall(addr, nil, "gno.land/r/other", "Inc", nil),
+				bank.NewMsgSend(addr, addr, std.MustParseCoins("1ugnot"))),
+			[]byte("not a tx"),
+		}},
+	}
+	deliverTxs := []abci.ResponseDeliverTx{
+		{},
+		{},
+		{ResponseBase: abci.ResponseBase{Error: abci.StringError("fail")}},
+		{},
+		{},
+	}
+
+	txs := filterRealmTxs(block, deliverTxs)
+	assert.Len(t, txs, 3)
+	assert.Equal(t, "gno.land/r/other", txs[0].PkgPath)
+	assert.Equal(t, "exec", txs[1].Type)
+	assert.Equal(t, rlmpath, txs[1].PkgPath)
+	assert.Equal(t, int64(7), txs[1].Height)
+	assert.Equal(t, time.Unix(100, 0), txs[1].Time)
+	assert.Equal(t, "Inc", txs[1].Func)
+	assert.Equal(t, []string{"3"}, txs[1].Args)
+	assert.Equal(t, addr, txs[1].Caller)
+	assert.Equal(t, fmt.Sprintf("%X", block.Txs[1].Hash()), txs[1].Hash)
+	assert.Equal(t, "add_package", txs[2].Type)
+	assert.Equal(t, rlmpath, txs[2].PkgPath)
+	assert.Equal(t, addr, txs[2].Caller)
+}
+
+// mockClient serves the blocks of a chain with a call of
+// gno.land/r/test in every block.
+type mockClient struct {
+	client.Client
+	latest int64
+	calls  int // number of Block calls.
+}
+
+func (mc *mockClient) Status() (*ctypes.ResultStatus, error) {
+	return &ctypes.ResultStatus{SyncInfo: ctypes.SyncInfo{LatestBlockHeight: mc.latest}}, nil
+}
+
+func (mc *mockClient) BlockchainInfo(minHeight, maxHeight int64) (*ctypes.ResultBlockchainInfo, error) {
+	res := &ctypes.ResultBlockchainInfo{LastHeight: mc.latest}
+	for h := maxHeight; h >= minHeight && h > maxHeight-20; h-- {
+		res.BlockMetas = append(res.BlockMetas, &bft.BlockMeta{
+			Header: bft.Header{Height: h, NumTxs: 1},
+		})
+	}
+	return res, nil
+}
+
+func (mc *mockClient) Block(height *int64) (*ctypes.ResultBlock, error) {
+	mc.calls++
+	addr := crypto.AddressFromPreimage([]byte("addr1"))
+	tx := std.Tx{Msgs: []std.Msg{vm.NewMsgCall(addr, nil, "gno.land/r/test", "Inc", nil)}}
+	return &ctypes.ResultBlock{Block: &bft.Block{
+		Header: bft.Header{Height: *height},
+		Data:   bft.Data{Txs: bft.Txs{amino.MustMarshal(tx)}},
+	}}, nil
+}
+
+func (mc *mockClient) BlockResults(height *int64) (*ctypes.ResultBlockResults, error) {
+	return &ctypes.ResultBlockResults{
+		Height:  *height,
+		Results: &state.ABCIResponses{DeliverTxs: []abci.ResponseDeliverTx{{}}},
+	}, nil
+}
+
+func TestTxIndex(t *testing.T) {
+	flags.txsBlocks = 30
+	mc := &mockClient{latest: 100}
+	idx := newTxIndex()
+
+	// Only the last blocks are indexed at first.
+	assert.NoError(t, idx.sync(mc))
+	assert.Equal(t, 30, mc.calls)
+	txs := idx.realmTxs("gno.land/r/test")
+	assert.Len(t, txs, 30)
+	assert.Equal(t, int64(100), txs[0].Height)
+	assert.Equal(t, int64(71), txs[29].Height)
+	assert.Empty(t, idx.realmTxs("gno.land/r/other"))
+
+	// Then only the new blocks are read, and the oldest
+	// transactions are dropped.
+	mc.latest = 145
+	assert.NoError(t, idx.sync(mc))
+	assert.Equal(t, 75, mc.calls)
+	assert.NoError(t, idx.sync(mc))
+	assert.Equal(t, 75, mc.calls)
+	txs = idx.realmTxs("gno.land/r/test")
+	assert.Len(t, txs, maxRealmTxs)
+	assert.Equal(t, int64(145), txs[0].Height)
+	assert.Equal(t, int64(96), txs[maxRealmTxs-1].Height)
+}
@@ -3,16 +3,20 @@ package gnoland
 import (
 	"fmt"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/gnolang/gno/tm2/pkg/amino"
 	abci "github.com/gnolang/gno/tm2/pkg/bft/abci/types"
 	"github.com/gnolang/gno/tm2/pkg/crypto"
 	dbm "github.com/gnolang/gno/tm2/pkg/db"
+	"github.com/gnolang/gno/tm2/pkg/errors"
 	"github.com/gnolang/gno/tm2/pkg/log"
 	"github.com/gnolang/gno/tm2/pkg/sdk"
 	"github.com/gnolang/gno/tm2/pkg/sdk/auth"
 	"github.com/gnolang/gno/tm2/pkg/sdk/bank"
+	"github.com/gnolang/gno/tm2/pkg/sdk/params"
+	"github.com/gnolang/gno/tm2/pkg/sdk/upgrade"
 	"github.com/gnolang/gno/tm2/pkg/sdk/vm"
 	"github.com/gnolang/gno/tm2/pkg/std"
 	"github.com/gnolang/gno/tm2/pkg/store"
@@ -40,8 +44,15 @@ func NewApp(rootDir string, skipFailingGenesisTxs bool, logger log.Logger) (abci
 	// Construct keepers.
 	acctKpr := auth.NewAccountKeeper(mainKey, ProtoGnoAccount)
 	bankKpr := bank.NewBankKeeper(acctKpr)
+	paramsKpr := params.NewParamsKeeper(mainKey)
+	paramsKpr.Register(auth.ModuleName, auth.DefaultParams())
+	paramsKpr.Register(vm.ModuleName, vm.DefaultParams())
+	paramsKpr.Register(upgrade.ModuleName, upgrade.DefaultParams())
 	stdlibsDir := filepath.Join("..", "gnovm", "stdlibs")
-	vmKpr := vm.NewVMKeeper(baseKey, mainKey, acctKpr, bankKpr, stdlibsDir)
+	vmKpr := vm.NewVMKeeper(baseKey, mainKey, acctKpr, bankKpr, paramsKpr, stdlibsDir)
+	upgradeKpr := upgrade.NewUpgradeKeeper(mainKey, paramsKpr)
+	vmKpr.SetUpgradeKeeper(upgradeKpr)
+	setUpgradeHandlers(upgradeKpr, vmKpr)
 
 	// Set InitChainer
 	baseApp.SetInitChainer(InitChainer(baseApp, acctKpr, bankKpr, skipFailingGenesisTxs))
@@ -57,15 +68,20 @@ func NewApp(rootDir string, skipFailingGenesisTxs bool, logger log.Logger) (abci
 		func(ctx sdk.Context, tx std.Tx, simulate bool) (
 			newCtx sdk.Context, res sdk.Result, abort bool,
 		) {
-			// Override auth params.
+			// Override auth params with the on-chain ones.
+			var authParams auth.Params
+			paramsKpr.GetParams(ctx, auth.ModuleName, &authParams)
 			ctx = ctx.WithValue(
-				auth.AuthParamsContextKey{}, auth.DefaultParams())
+				auth.AuthParamsContextKey{}, authParams)
 			// Continue on with default auth ante handler.
 			newCtx, res, abort = authAnteHandler(ctx, tx, simulate)
 			return
 		},
 	)
 
+	// Set BeginBlocker
+	baseApp.SetBeginBlocker(BeginBlocker(upgradeKpr))
+
 	// Set EndBlocker
 	baseApp.SetEndBlocker(EndBlocker(vmKpr))
 
@@ -73,6 +89,8 @@ func NewApp(rootDir string, skipFailingGenesisTxs bool, logger log.Logger) (abci
 	baseApp.Router().AddRoute("auth", auth.NewHandler(acctKpr))
 	baseApp.Router().AddRoute("bank", bank.NewHandler(bankKpr))
 	baseApp.Router().AddRoute("vm", vm.NewHandler(vmKpr))
+	baseApp.Router().AddRoute("params", params.NewHandler(paramsKpr))
+	baseApp.Router().AddRoute("upgrade", upgrade.NewHandler(upgradeKpr))
 
 	// Load latest version.
 	if err := baseApp.LoadLatestVersion(); err != nil {
@@ -92,8 +110,11 @@ func InitChainer(baseApp *sdk.BaseApp, acctKpr auth.AccountKeeperI, bankKpr bank
 		genState := req.AppState.(GnoGenesisState)
 		// Parse and set genesis state balances.
 		for _, bal := range genState.Balances {
-			addr, coins := parseBalance(bal)
+			addr, coins, vesting := parseBalance(bal)
 			acc := acctKpr.NewAccountWithAddress(ctx, addr)
+			if vesting != nil {
+				acc = vesting(acc.(*GnoAccount).BaseAccount, coins)
+			}
 			acctKpr.SetAccount(ctx, acc)
 			err := bankKpr.SetCoins(ctx, addr, coins)
 			if err != nil {
@@ -121,7 +142,13 @@ func InitChainer(baseApp *sdk.BaseApp, acctKpr auth.AccountKeeperI, bankKpr bank
 	}
 }
 
-func parseBalance(bal string) (crypto.Address, std.Coins) {
+// vestingFn makes a vesting account locking coins from a base account.
+type vestingFn func(base std.BaseAccount, coins std.Coins) std.Account
+
+// parseBalance parses a balance in the form g1xxx=100ugnot, optionally
+// followed by a vesting schedule for the whole balance, in unix seconds:
+// g1xxx=100ugnot@delayed:<end> or g1xxx=100ugnot@continuous:<start>:<end>.
+func parseBalance(bal string) (crypto.Address, std.Coins, vestingFn) {
 	parts := strings.Split(bal, "=")
 	if len(parts) != 2 {
 		panic(fmt.Sprintf("invalid balance string %s", bal))
@@ -130,11 +157,64 @@ func parseBalance(bal string) (crypto.Address, std.Coins) {
 	if err != nil {
 		panic(fmt.Sprintf("invalid balance addr %s (%v)", bal, err))
 	}
-	coins, err := std.ParseCoins(parts[1])
+	coinsStr, vestingStr, hasVesting := strings.Cut(parts[1], "@")
+	coins, err := std.ParseCoins(coinsStr)
 	if err != nil {
 		panic(fmt.Sprintf("invalid balance coins %s (%v)", bal, err))
 	}
-	return addr, coins
+	if !hasVesting {
+		return addr, coins, nil
+	}
+	vesting, err := parseVesting(vestingStr)
+	if err != nil {
+		panic(fmt.Sprintf("invalid balance vesting %s (%v)", bal, err))
+	}
+	return addr, coins, vesting
+}
+
+func parseVesting(vesting string) (vestingFn, error) {
+	parts := strings.Split(vesting, ":")
+	times := make([]int64, len(parts)-1)
+	for i, part := range parts[1:] {
+		t, err := strconv.ParseInt(part, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		times[i] = t
+	}
+	switch {
+	case parts[0] == "delayed" && len(times) == 1:
+		return func(base std.BaseAccount, coins std.Coins) std.Account {
+			return &GnoDelayedVestingAccount{
+				BaseAccount:     base,
+				OriginalVesting: coins,
+				EndTime:         times[0],
+			}
+		}, nil
+	case parts[0] == "continuous" && len(times) == 2:
+		if times[0] >= times[1] {
+			return nil, errors.New("start time must be before end time")
+		}
+		return func(base std.BaseAccount, coins std.Coins) std.Account {
+			return &GnoContinuousVestingAccount{
+				BaseAccount:     base,
+				OriginalVesting: coins,
+				StartTime:       times[0],
+				EndTime:         times[1],
+			}
+		}, nil
+	default:
+		return nil, errors.New("expected delayed:<end> or continuous:<start>:<end>")
+	}
+}
+
+// BeginBlocker applies the scheduled upgrade once its height is reached,
+// or halts the chain if this binary has no handler for it.
+func BeginBlocker(upk upgrade.UpgradeKeeper) func(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	return func(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+		upk.BeginBlock(ctx)
+		return abci.ResponseBeginBlock{}
+	}
 }
 
 // XXX not used yet.
@@ -0,0 +1,477 @@
+// Code generated by gengo. DO NOT EDIT.
+
+package gnoland
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/gnolang/gno/tm2/pkg/amino"
+	"github.com/gnolang/gno/tm2/pkg/std"
+)
+
+func (goo GnoAccount) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field BaseAccount (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.BaseAccount.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	return nil
+}
+
+func (goo *GnoAccount) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field BaseAccount (#1)
+	if len(bz) == 0 {
+		goo.BaseAccount = std.BaseAccount{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.BaseAccount = std.BaseAccount{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnoland.GnoAccount, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnoland.GnoAccount, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.BaseAccount.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo GnoContinuousVestingAccount) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field BaseAccount (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.BaseAccount.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field OriginalVesting (#2)
+	if len(goo.OriginalVesting) != 0 {
+		var repr string
+		if repr, err = goo.OriginalVesting.MarshalAmino(); err != nil {
+			return
+		}
+		if repr != "" {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeString(buf, repr); err != nil {
+				return
+			}
+		}
+	}
+	// Field StartTime (#3)
+	if goo.StartTime != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.StartTime)); err != nil {
+			return
+		}
+	}
+	// Field EndTime (#4)
+	if goo.EndTime != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 4, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.EndTime)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *GnoContinuousVestingAccount) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field BaseAccount (#1)
+	if len(bz) == 0 {
+		goo.BaseAccount = std.BaseAccount{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.BaseAccount = std.BaseAccount{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnoland.GnoContinuousVestingAccount, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnoland.GnoContinuousVestingAccount, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.BaseAccount.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field OriginalVesting (#2)
+	if len(bz) == 0 {
+		goo.OriginalVesting = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.OriginalVesting = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of gnoland.GnoContinuousVestingAccount, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of gnoland.GnoContinuousVestingAccount, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.OriginalVesting.UnmarshalAmino(v); err != nil {
+			return err
+		}
+	}
+	// Field StartTime (#3)
+	if len(bz) == 0 {
+		goo.StartTime = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.StartTime = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of gnoland.GnoContinuousVestingAccount, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 3 of gnoland.GnoContinuousVestingAccount, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.StartTime = int64(v)
+	}
+	// Field EndTime (#4)
+	if len(bz) == 0 {
+		goo.EndTime = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 4 {
+		goo.EndTime = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 4 {
+			return fmt.Errorf("expected field # 4 of gnoland.GnoContinuousVestingAccount, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 4 of gnoland.GnoContinuousVestingAccount, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.EndTime = int64(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo GnoDelayedVestingAccount) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field BaseAccount (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.BaseAccount.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field OriginalVesting (#2)
+	if len(goo.OriginalVesting) != 0 {
+		var repr string
+		if repr, err = goo.OriginalVesting.MarshalAmino(); err != nil {
+			return
+		}
+		if repr != "" {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeString(buf, repr); err != nil {
+				return
+			}
+		}
+	}
+	// Field EndTime (#3)
+	if goo.EndTime != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.EndTime)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *GnoDelayedVestingAccount) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field BaseAccount (#1)
+	if len(bz) == 0 {
+		goo.BaseAccount = std.BaseAccount{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.BaseAccount = std.BaseAccount{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnoland.GnoDelayedVestingAccount, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnoland.GnoDelayedVestingAccount, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.BaseAccount.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field OriginalVesting (#2)
+	if len(bz) == 0 {
+		goo.OriginalVesting = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.OriginalVesting = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of gnoland.GnoDelayedVestingAccount, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of gnoland.GnoDelayedVestingAccount, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.OriginalVesting.UnmarshalAmino(v); err != nil {
+			return err
+		}
+	}
+	// Field EndTime (#3)
+	if len(bz) == 0 {
+		goo.EndTime = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.EndTime = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of gnoland.GnoDelayedVestingAccount, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 3 of gnoland.GnoDelayedVestingAccount, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.EndTime = int64(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo GnoGenesisState) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field Balances (#1)
+	for _, e := range goo.Balances {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(e)); err != nil {
+			return
+		}
+	}
+	// Field Txs (#2)
+	for _, e := range goo.Txs {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		buf2 := new(bytes.Buffer)
+		if err = e.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *GnoGenesisState) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field Balances (#1)
+	if len(bz) == 0 {
+		goo.Balances = nil
+	} else if fnum, _, _, err := amino.DecodeFieldNumberAndTyp3(bz); err != nil {
+		return err
+	} else if fnum <= 1 {
+		var list []string
+		for len(bz) > 0 {
+			fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz)
+			if fnum > 1 {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			if fnum < 1 {
+				return fmt.Errorf("expected repeated field number 1 or greater, got %v", fnum)
+			}
+			if typ != amino.Typ3ByteLength {
+				return fmt.Errorf("expected repeated field type %v, got %v", amino.Typ3ByteLength, typ)
+			}
+			if len(bz) > 0 && bz[0] == 0x00 {
+				bz = bz[1:]
+				list = append(list, "")
+				continue
+			}
+			v, n, err := amino.DecodeString(bz)
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			list = append(list, string(v))
+		}
+		goo.Balances = list
+	}
+	// Field Txs (#2)
+	if len(bz) == 0 {
+		goo.Txs = nil
+	} else if fnum, _, _, err := amino.DecodeFieldNumberAndTyp3(bz); err != nil {
+		return err
+	} else if fnum <= 2 {
+		var list []std.Tx
+		for len(bz) > 0 {
+			fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz)
+			if fnum > 2 {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			if fnum < 2 {
+				return fmt.Errorf("expected repeated field number 2 or greater, got %v", fnum)
+			}
+			if typ != amino.Typ3ByteLength {
+				return fmt.Errorf("expected repeated field type %v, got %v", amino.Typ3ByteLength, typ)
+			}
+			if len(bz) > 0 && bz[0] == 0x00 {
+				bz = bz[1:]
+				list = append(list, std.Tx{})
+				continue
+			}
+			v, n, err := amino.DecodeByteSlice(bz)
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			var e std.Tx
+			if err := e.UnmarshalBinary2(cdc, v); err != nil {
+				return err
+			}
+			list = append(list, e)
+		}
+		goo.Txs = list
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
@@ -2,13 +2,18 @@ package gnoland
 
 import (
 	"github.com/gnolang/gno/tm2/pkg/amino"
+	"github.com/gnolang/gno/tm2/pkg/std"
 )
 
 var Package = amino.RegisterPackage(amino.NewPackage(
 	"github.com/gnolang/gno/gno.land/pkg/gnoland",
 	"gno",
 	amino.GetCallersDirname(),
-).WithDependencies().WithTypes(
+).WithDependencies(
+	std.Package,
+).WithTypes(
 	&GnoAccount{}, "Account",
+	&GnoContinuousVestingAccount{}, "ContinuousVestingAccount",
+	&GnoDelayedVestingAccount{}, "DelayedVestingAccount",
 	GnoGenesisState{}, "GenesisState",
 ))
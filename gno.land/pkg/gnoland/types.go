@@ -1,6 +1,9 @@
 package gnoland
 
 import (
+	"math/big"
+	"time"
+
 	"github.com/gnolang/gno/tm2/pkg/std"
 )
 
@@ -12,6 +15,58 @@ func ProtoGnoAccount() std.Account {
 	return &GnoAccount{}
 }
 
+// GnoContinuousVestingAccount is a GnoAccount whose OriginalVesting coins
+// vest linearly from StartTime to EndTime, in unix seconds.
+type GnoContinuousVestingAccount struct {
+	std.BaseAccount
+	OriginalVesting std.Coins `json:"original_vesting" yaml:"original_vesting"`
+	StartTime       int64     `json:"start_time" yaml:"start_time"`
+	EndTime         int64     `json:"end_time" yaml:"end_time"`
+}
+
+var _ std.VestingAccount = &GnoContinuousVestingAccount{}
+
+// LockedCoins implements std.VestingAccount.
+func (acc *GnoContinuousVestingAccount) LockedCoins(blockTime time.Time) std.Coins {
+	now := blockTime.Unix()
+	if now <= acc.StartTime {
+		return acc.OriginalVesting
+	}
+	if now >= acc.EndTime {
+		return nil
+	}
+	// locked = original * (end - now) / (end - start)
+	left := big.NewInt(acc.EndTime - now)
+	duration := big.NewInt(acc.EndTime - acc.StartTime)
+	locked := std.Coins{}
+	for _, coin := range acc.OriginalVesting {
+		amt := new(big.Int).Mul(big.NewInt(coin.Amount), left)
+		amt.Quo(amt, duration)
+		if amt.Sign() > 0 {
+			locked = append(locked, std.NewCoin(coin.Denom, amt.Int64()))
+		}
+	}
+	return locked
+}
+
+// GnoDelayedVestingAccount is a GnoAccount whose OriginalVesting coins all
+// vest at EndTime, in unix seconds.
+type GnoDelayedVestingAccount struct {
+	std.BaseAccount
+	OriginalVesting std.Coins `json:"original_vesting" yaml:"original_vesting"`
+	EndTime         int64     `json:"end_time" yaml:"end_time"`
+}
+
+var _ std.VestingAccount = &GnoDelayedVestingAccount{}
+
+// LockedCoins implements std.VestingAccount.
+func (acc *GnoDelayedVestingAccount) LockedCoins(blockTime time.Time) std.Coins {
+	if blockTime.Unix() < acc.EndTime {
+		return acc.OriginalVesting
+	}
+	return nil
+}
+
 type GnoGenesisState struct {
 	Balances []string `json:"balances"`
 	Txs      []std.Tx `json:"txs"`
@@ -0,0 +1,119 @@
+package gnoland
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	bft "github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/gnolang/gno/tm2/pkg/crypto"
+	dbm "github.com/gnolang/gno/tm2/pkg/db"
+	"github.com/gnolang/gno/tm2/pkg/log"
+	"github.com/gnolang/gno/tm2/pkg/sdk"
+	"github.com/gnolang/gno/tm2/pkg/sdk/auth"
+	"github.com/gnolang/gno/tm2/pkg/sdk/bank"
+	"github.com/gnolang/gno/tm2/pkg/std"
+	"github.com/gnolang/gno/tm2/pkg/store"
+	"github.com/gnolang/gno/tm2/pkg/store/iavl"
+)
+
+func TestContinuousVestingLockedCoins(t *testing.T) {
+	t.Parallel()
+
+	acc := &GnoContinuousVestingAccount{
+		OriginalVesting: std.NewCoins(std.NewCoin("ugnot", 1000)),
+		StartTime:       100,
+		EndTime:         200,
+	}
+	assert.Equal(t, "1000ugnot", acc.LockedCoins(time.Unix(50, 0)).String())
+	assert.Equal(t, "1000ugnot", acc.LockedCoins(time.Unix(100, 0)).String())
+	assert.Equal(t, "750ugnot", acc.LockedCoins(time.Unix(125, 0)).String())
+	assert.Equal(t, "", acc.LockedCoins(time.Unix(200, 0)).String())
+}
+
+func TestDelayedVestingLockedCoins(t *testing.T) {
+	t.Parallel()
+
+	acc := &GnoDelayedVestingAccount{
+		OriginalVesting: std.NewCoins(std.NewCoin("ugnot", 1000)),
+		EndTime:         200,
+	}
+	assert.Equal(t, "1000ugnot", acc.LockedCoins(time.Unix(199, 0)).String())
+	assert.Equal(t, "", acc.LockedCoins(time.Unix(200, 0)).String())
+}
+
+func TestParseBalance(t *testing.T) {
+	t.Parallel()
+
+	addr := crypto.AddressFromPreimage([]byte("addr"))
+	base := std.BaseAccount{Address: addr}
+
+	_, coins, vesting := parseBalance(addr.String() + "=10ugnot")
+	assert.Equal(t, "10ugnot", coins.String())
+	assert.Nil(t, vesting)
+
+	_, _, vesting = parseBalance(addr.String() + "=10ugnot@delayed:200")
+	require.NotNil(t, vesting)
+	assert.Equal(t, &GnoDelayedVestingAccount{
+		BaseAccount:     base,
+		OriginalVesting: coins,
+		EndTime:         200,
+	}, vesting(base, coins))
+
+	_, _, vesting = parseBalance(addr.String() + "=10ugnot@continuous:100:200")
+	require.NotNil(t, vesting)
+	assert.Equal(t, &GnoContinuousVestingAccount{
+		BaseAccount:     base,
+		OriginalVesting: coins,
+		StartTime:       100,
+		EndTime:         200,
+	}, vesting(base, coins))
+
+	for _, bal := range []string{
+		addr.String() + "=10ugnot@delayed",
+		addr.String() + "=10ugnot@continuous:200:100",
+		addr.String() + "=10ugnot@linear:100:200",
+	} {
+		assert.Panics(t, func() { parseBalance(bal) }, bal)
+	}
+}
+
+func TestVestingAccountSendCoins(t *testing.T) {
+	t.Parallel()
+
+	db := dbm.NewMemDB()
+	key := store.NewStoreKey("main")
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(key, iavl.StoreConstructor, db)
+	ms.LoadLatestVersion()
+	ctx := sdk.NewContext(sdk.RunTxModeDeliver, ms, &bft.Header{ChainID: "test-chain-id"}, log.NewNopLogger())
+
+	acck := auth.NewAccountKeeper(key, ProtoGnoAccount)
+	bankk := bank.NewBankKeeper(acck)
+
+	from := crypto.AddressFromPreimage([]byte("from"))
+	to := crypto.AddressFromPreimage([]byte("to"))
+	coins := std.NewCoins(std.NewCoin("ugnot", 1000))
+	acc := acck.NewAccountWithAddress(ctx, from)
+	acck.SetAccount(ctx, &GnoDelayedVestingAccount{
+		BaseAccount:     acc.(*GnoAccount).BaseAccount,
+		OriginalVesting: coins,
+		EndTime:         200,
+	})
+	require.NoError(t, bankk.SetCoins(ctx, from, coins.Add(std.NewCoins(std.NewCoin("ugnot", 10)))))
+
+	// only the unlocked coins can be sent before the end time.
+	ctx = ctx.WithBlockHeader(&bft.Header{ChainID: "test-chain-id", Time: time.Unix(199, 0)})
+	assert.Equal(t, "1010ugnot", bankk.GetCoins(ctx, from).String())
+	assert.Equal(t, "10ugnot", bankk.GetSpendableCoins(ctx, from).String())
+	require.Error(t, bankk.SendCoins(ctx, from, to, std.NewCoins(std.NewCoin("ugnot", 11))))
+	require.NoError(t, bankk.SendCoins(ctx, from, to, std.NewCoins(std.NewCoin("ugnot", 10))))
+
+	// all the coins can be sent after the end time.
+	ctx = ctx.WithBlockHeader(&bft.Header{ChainID: "test-chain-id", Time: time.Unix(200, 0)})
+	assert.Equal(t, "1000ugnot", bankk.GetSpendableCoins(ctx, from).String())
+	require.NoError(t, bankk.SendCoins(ctx, from, to, coins))
+	assert.Equal(t, "1010ugnot", bankk.GetCoins(ctx, to).String())
+}
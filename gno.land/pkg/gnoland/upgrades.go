@@ -0,0 +1,30 @@
+package gnoland
+
+import (
+	"github.com/gnolang/gno/tm2/pkg/sdk"
+	"github.com/gnolang/gno/tm2/pkg/sdk/upgrade"
+	"github.com/gnolang/gno/tm2/pkg/sdk/vm"
+)
+
+// upgradeHandlers are the handlers of the upgrades this binary can apply,
+// by plan name. When a plan height is reached, the chain halts until the
+// nodes run a binary with a handler for it.
+//
+// For instance, an upgrade to a gnolang version which preprocesses
+// packages differently would be:
+//
+//	"v2": func(ctx sdk.Context, plan upgrade.Plan, vmKpr *vm.VMKeeper) error {
+//		vmKpr.PreprocessAllPackages(ctx)
+//		return nil
+//	},
+var upgradeHandlers = map[string]func(ctx sdk.Context, plan upgrade.Plan, vmKpr *vm.VMKeeper) error{}
+
+// setUpgradeHandlers registers the upgradeHandlers in upk.
+func setUpgradeHandlers(upk upgrade.UpgradeKeeper, vmKpr *vm.VMKeeper) {
+	for name, handler := range upgradeHandlers {
+		handler := handler
+		upk.SetUpgradeHandler(name, func(ctx sdk.Context, plan upgrade.Plan) error {
+			return handler(ctx, plan, vmKpr)
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/gnolang/gno/gnovm/pkg/doc"
+	gno "github.com/gnolang/gno/gnovm/pkg/gnolang"
+	"github.com/gnolang/gno/tm2/pkg/commands"
+	osm "github.com/gnolang/gno/tm2/pkg/os"
+	"github.com/gnolang/gno/tm2/pkg/std"
+)
+
+type docCfg struct {
+	rootDir string
+	remote  string
+}
+
+func newDocCmd(io *commands.IO) *commands.Command {
+	cfg := &docCfg{}
+
+	return commands.NewCommand(
+		commands.Metadata{
+			Name:       "doc",
+			ShortUsage: "doc [flags] <package>[.<symbol>[.<method>]]",
+			ShortHelp:  "Shows the documentation of a package or symbol",
+			LongHelp: "Shows the documentation of a package, or of one of its exported symbols. " +
+				"The package is either a local directory, a package path of the standard " +
+				"libraries or examples, or a package deployed on the -remote node.",
+		},
+		cfg,
+		func(_ context.Context, args []string) error {
+			return execDoc(cfg, args, io)
+		},
+	)
+}
+
+func (c *docCfg) RegisterFlags(fs *flag.FlagSet) {
+	fs.StringVar(
+		&c.rootDir,
+		"root-dir",
+		"",
+		"clone location of github.com/gnolang/gno (gno tries to guess it)",
+	)
+
+	fs.StringVar(
+		&c.remote,
+		"remote",
+		"",
+		"remote node to fetch packages from, if not found locally",
+	)
+}
+
+func execDoc(cfg *docCfg, args []string, io *commands.IO) error {
+	if len(args) != 1 {
+		return flag.ErrHelp
+	}
+
+	if cfg.rootDir == "" {
+		cfg.rootDir = guessRootDir()
+	}
+
+	pkg, symbol := splitDocArg(args[0])
+	memPkg, err := findDocPackage(cfg, pkg)
+	if err != nil {
+		return err
+	}
+	pkgDoc, err := doc.New(memPkg)
+	if err != nil {
+		return fmt.Errorf("%s: %w", pkg, err)
+	}
+
+	if symbol == "" {
+		return pkgDoc.WriteText(io.Out)
+	}
+	return pkgDoc.WriteSymbolText(io.Out, symbol)
+}
+
+// splitDocArg splits a `gno doc` argument into a package and a symbol,
+// which is after the first dot of the last path element. Directories are
+// never split, so that local paths may contain dots.
+func splitDocArg(arg string) (pkg, symbol string) {
+	if osm.DirExists(arg) {
+		return arg, ""
+	}
+	slash := strings.LastIndex(arg, "/")
+	if dot := strings.Index(arg[slash+1:], "."); dot >= 0 {
+		i := slash + 1 + dot
+		return arg[:i], arg[i+1:]
+	}
+	return arg, ""
+}
+
+// findDocPackage reads pkg from a local directory, else from the standard
+// libraries or examples in the root dir, else from the remote node.
+func findDocPackage(cfg *docCfg, pkg string) (*std.MemPackage, error) {
+	if osm.DirExists(pkg) {
+		return readDocPackage(pkg, guessPkgPath(pkg))
+	}
+	for _, dir := range []string{
+		filepath.Join(cfg.rootDir, "gnovm", "stdlibs", pkg),
+		filepath.Join(cfg.rootDir, "examples", pkg),
+	} {
+		if osm.DirExists(dir) {
+			return readDocPackage(dir, pkg)
+		}
+	}
+	if cfg.remote != "" {
+		return fetchDocPackage(newRemoteQuerier(cfg.remote), pkg)
+	}
+	return nil, fmt.Errorf("package %s not found", pkg)
+}
+
+func readDocPackage(dir, pkgPath string) (memPkg *std.MemPackage, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%s: %v", dir, r)
+		}
+	}()
+	return gno.ReadMemPackage(dir, pkgPath), nil
+}
+
+// fetchDocPackage fetches the files of the package pkgPath with vm/qfile.
+func fetchDocPackage(query remoteQuerier, pkgPath string) (*std.MemPackage, error) {
+	res, err := query("vm/qfile", []byte(pkgPath))
+	if err != nil {
+		return nil, fmt.Errorf("fetch %s: %w", pkgPath, err)
+	}
+	memPkg := &std.MemPackage{Path: pkgPath}
+	for _, filename := range strings.Split(string(res), "\n") {
+		if !strings.HasSuffix(filename, ".gno") {
+			continue
+		}
+		body, err := query("vm/qfile", []byte(pkgPath+"/"+filename))
+		if err != nil {
+			return nil, fmt.Errorf("fetch %s/%s: %w", pkgPath, filename, err)
+		}
+		memPkg.Files = append(memPkg.Files, &std.MemFile{
+			Name: filename,
+			Body: string(body),
+		})
+	}
+	return memPkg, nil
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDocApp(t *testing.T) {
+	tc := []testMainCase{
+		{
+			args:        []string{"doc"},
+			errShouldBe: "flag: help requested",
+		},
+		{
+			args:                []string{"doc", "gno.land/p/demo/avl"},
+			stdoutShouldContain: "package avl // import \"gno.land/p/demo/avl\"",
+		},
+		{
+			args:           []string{"doc", "gno.land/p/demo/avl.Tree.Size"},
+			stdoutShouldBe: "func (tree *Tree) Size() int\n",
+		},
+		{
+			args:                []string{"doc", "strings.ToUpper"},
+			stdoutShouldContain: "ToUpper returns s with all Unicode letters mapped to their upper case.",
+		},
+		{
+			args:                []string{"doc", "../../../examples/gno.land/r/demo/users.GetUserByName"},
+			stdoutShouldContain: "func GetUserByName(name string) *User",
+		},
+		{
+			args:        []string{"doc", "gno.land/p/demo/avl.Nope"},
+			errShouldBe: "no symbol Nope in package gno.land/p/demo/avl",
+		},
+		{
+			args:        []string{"doc", "gno.land/p/demo/notexists"},
+			errShouldBe: "package gno.land/p/demo/notexists not found",
+		},
+	}
+	testMainCaseRun(t, tc)
+}
+
+func TestSplitDocArg(t *testing.T) {
+	cases := []struct {
+		arg, pkg, symbol string
+	}{
+		{"gno.land/p/demo/avl", "gno.land/p/demo/avl", ""},
+		{"gno.land/p/demo/avl.Tree", "gno.land/p/demo/avl", "Tree"},
+		{"gno.land/p/demo/avl.Tree.Get", "gno.land/p/demo/avl", "Tree.Get"},
+		{"strings.ToUpper", "strings", "ToUpper"},
+		{"strings", "strings", ""},
+		{".", ".", ""},
+		{"./foo.Bar", "./foo", "Bar"},
+	}
+	for _, c := range cases {
+		pkg, symbol := splitDocArg(c.arg)
+		require.Equal(t, c.pkg, pkg, c.arg)
+		require.Equal(t, c.symbol, symbol, c.arg)
+	}
+}
+
+func TestFetchDocPackage(t *testing.T) {
+	files := map[string]string{
+		"gno.land/p/demo/foo":           "foo.gno\nREADME.md",
+		"gno.land/p/demo/foo/foo.gno":   "package foo\n",
+		"gno.land/p/demo/foo/README.md": "# foo",
+	}
+	query := func(path string, data []byte) ([]byte, error) {
+		require.Equal(t, "vm/qfile", path)
+		if body, ok := files[string(data)]; ok {
+			return []byte(body), nil
+		}
+		return nil, errors.New("not found")
+	}
+
+	memPkg, err := fetchDocPackage(query, "gno.land/p/demo/foo")
+	require.NoError(t, err)
+	require.Equal(t, "gno.land/p/demo/foo", memPkg.Path)
+	require.Len(t, memPkg.Files, 1)
+	require.Equal(t, "foo.gno", memPkg.Files[0].Name)
+
+	_, err = fetchDocPackage(query, "gno.land/p/demo/bar")
+	require.EqualError(t, err, "fetch gno.land/p/demo/bar: not found")
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+
+	"github.com/gnolang/gno/tm2/pkg/commands"
+)
+
+type fmtCfg struct {
+	list bool
+}
+
+func newFmtCmd(io *commands.IO) *commands.Command {
+	cfg := &fmtCfg{}
+
+	return commands.NewCommand(
+		commands.Metadata{
+			Name:       "fmt",
+			ShortUsage: "fmt [flags] <file or package> [<file or package>...]",
+			ShortHelp:  "Formats .gno files in place",
+		},
+		cfg,
+		func(_ context.Context, args []string) error {
+			return execFmt(cfg, args, io)
+		},
+	)
+}
+
+func (c *fmtCfg) RegisterFlags(fs *flag.FlagSet) {
+	fs.BoolVar(
+		&c.list,
+		"l",
+		false,
+		"list files whose formatting differs, without writing them",
+	)
+}
+
+func execFmt(cfg *fmtCfg, args []string, io *commands.IO) error {
+	if len(args) < 1 {
+		return flag.ErrHelp
+	}
+
+	paths, err := gnoFilesFromArgs(args)
+	if err != nil {
+		return fmt.Errorf("list paths: %w", err)
+	}
+
+	errCount := 0
+	for _, path := range paths {
+		changed, err := fmtFile(path, !cfg.list)
+		if err != nil {
+			io.ErrPrintfln("%v", err)
+
+			errCount++
+			continue
+		}
+		if changed && cfg.list {
+			io.Printfln("%s", path)
+		}
+	}
+
+	if errCount > 0 {
+		return fmt.Errorf("%d fmt errors", errCount)
+	}
+
+	return nil
+}
+
+// fmtFile formats the .gno file at path with gofmt rules, and returns
+// whether its formatting changed. The file is rewritten if write is set.
+// Syntax errors are returned with their file:line:column positions.
+func fmtFile(path string, write bool) (bool, error) {
+	source, err := os.ReadFile(path)
+	if err != nil {
+		return false, fmt.Errorf("%s: read: %w", path, err)
+	}
+
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, path, source, parser.ParseComments)
+	if err != nil {
+		return false, err
+	}
+	ast.SortImports(fset, f)
+	var formatted bytes.Buffer
+	if err := format.Node(&formatted, fset, f); err != nil {
+		return false, fmt.Errorf("%s: format: %w", path, err)
+	}
+	if bytes.Equal(source, formatted.Bytes()) {
+		return false, nil
+	}
+
+	if write {
+		info, err := os.Stat(path)
+		if err != nil {
+			return false, err
+		}
+		if err := os.WriteFile(path, formatted.Bytes(), info.Mode().Perm()); err != nil {
+			return false, fmt.Errorf("%s: write: %w", path, err)
+		}
+	}
+	return true, nil
+}
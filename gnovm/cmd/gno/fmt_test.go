@@ -0,0 +1,67 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFmtApp(t *testing.T) {
+	tc := []testMainCase{
+		{
+			args:        []string{"fmt"},
+			errShouldBe: "flag: help requested",
+		},
+		{
+			args:           []string{"fmt", "-l", "../../tests/integ/unformatted-gno"},
+			stdoutShouldBe: "../../tests/integ/unformatted-gno/unformatted.gno\n",
+		},
+		{
+			args: []string{"fmt", "-l", "../../tests/integ/valid1"},
+		},
+		{
+			args:                 []string{"fmt", "unformatted.gno"},
+			testDir:              "../../tests/integ/unformatted-gno",
+			simulateExternalRepo: true,
+		},
+	}
+	testMainCaseRun(t, tc)
+}
+
+func TestFmtFile(t *testing.T) {
+	dir := t.TempDir()
+
+	unformatted := filepath.Join(dir, "unformatted.gno")
+	require.NoError(t, os.WriteFile(unformatted, []byte("package foo\nimport (\n\"strings\"\n\"std\"\n)\nfunc  Foo( ) {\nx:=1\n_ = x\n}\n"), 0o644))
+
+	// list only.
+	changed, err := fmtFile(unformatted, false)
+	require.NoError(t, err)
+	require.True(t, changed)
+	bz, err := os.ReadFile(unformatted)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(string(bz), "package foo\nimport"), "file should not be written")
+
+	// write, sorting imports.
+	changed, err = fmtFile(unformatted, true)
+	require.NoError(t, err)
+	require.True(t, changed)
+	bz, err = os.ReadFile(unformatted)
+	require.NoError(t, err)
+	require.Equal(t, "package foo\n\nimport (\n\t\"std\"\n\t\"strings\"\n)\n\nfunc Foo() {\n\tx := 1\n\t_ = x\n}\n", string(bz))
+
+	// already formatted.
+	changed, err = fmtFile(unformatted, true)
+	require.NoError(t, err)
+	require.False(t, changed)
+
+	// syntax errors are reported with their position.
+	invalid := filepath.Join(dir, "invalid.gno")
+	require.NoError(t, os.WriteFile(invalid, []byte("package foo\n\nfunc Foo() {\n\tx :=\n}\n"), 0o644))
+	_, err = fmtFile(invalid, true)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), invalid+":5:1:")
+}
@@ -0,0 +1,299 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	gno "github.com/gnolang/gno/gnovm/pkg/gnolang"
+	"github.com/gnolang/gno/gnovm/tests"
+	"github.com/gnolang/gno/tm2/pkg/commands"
+	osm "github.com/gnolang/gno/tm2/pkg/os"
+	"github.com/gnolang/gno/tm2/pkg/std"
+)
+
+type lintCfg struct {
+	rootDir string
+}
+
+func newLintCmd(io *commands.IO) *commands.Command {
+	cfg := &lintCfg{}
+
+	return commands.NewCommand(
+		commands.Metadata{
+			Name:       "lint",
+			ShortUsage: "lint [flags] <package> [<package>...]",
+			ShortHelp:  "Reports Gno-specific problems in packages",
+		},
+		cfg,
+		func(_ context.Context, args []string) error {
+			return execLint(cfg, args, io)
+		},
+	)
+}
+
+func (c *lintCfg) RegisterFlags(fs *flag.FlagSet) {
+	fs.StringVar(
+		&c.rootDir,
+		"root-dir",
+		"",
+		"clone location of github.com/gnolang/gno (gno tries to guess it)",
+	)
+}
+
+func execLint(cfg *lintCfg, args []string, io *commands.IO) error {
+	if len(args) < 1 {
+		return flag.ErrHelp
+	}
+
+	if cfg.rootDir == "" {
+		cfg.rootDir = guessRootDir()
+	}
+
+	paths, err := gnoPackagesFromArgs(args)
+	if err != nil {
+		return fmt.Errorf("list packages: %w", err)
+	}
+
+	issueCount := 0
+	linted := map[string]bool{}
+	for _, pkgDir := range paths {
+		if info, err := os.Stat(pkgDir); err == nil && !info.IsDir() {
+			pkgDir = filepath.Dir(pkgDir)
+		}
+		if linted[pkgDir] {
+			continue
+		}
+		linted[pkgDir] = true
+
+		issues, err := lintPkg(pkgDir, cfg.rootDir)
+		if err != nil {
+			return fmt.Errorf("%s: lint: %w", pkgDir, err)
+		}
+		for _, issue := range issues {
+			io.ErrPrintfln("%s", issue)
+		}
+		issueCount += len(issues)
+	}
+
+	if issueCount > 0 {
+		return fmt.Errorf("%d lint issues", issueCount)
+	}
+
+	return nil
+}
+
+// lintIssue is a problem found by gno lint, at a file:line[:column]
+// position.
+type lintIssue struct {
+	pos string
+	msg string
+}
+
+func (i lintIssue) String() string {
+	return i.pos + ": " + i.msg
+}
+
+// lintPkg checks the package in pkgDir. Test files are parsed, but only
+// checked for syntax errors.
+func lintPkg(pkgDir string, rootDir string) ([]lintIssue, error) {
+	pkgPath := guessPkgPath(pkgDir)
+
+	files, err := filepath.Glob(filepath.Join(pkgDir, "*.gno"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+
+	var (
+		issues   []lintIssue
+		parsed   []*ast.File
+		memPkg   = &std.MemPackage{Path: pkgPath}
+		fset     = token.NewFileSet()
+		parseErr bool
+	)
+	for _, file := range files {
+		if strings.HasPrefix(filepath.Base(file), ".") {
+			continue
+		}
+		body, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		f, err := parser.ParseFile(fset, file, body, parser.ParseComments)
+		if err != nil {
+			if list, ok := err.(scanner.ErrorList); ok {
+				for _, e := range list {
+					issues = append(issues, lintIssue{e.Pos.String(), e.Msg})
+				}
+			} else {
+				issues = append(issues, lintIssue{file, err.Error()})
+			}
+			parseErr = true
+			continue
+		}
+		if strings.HasSuffix(file, "_test.gno") || strings.HasSuffix(file, "_filetest.gno") {
+			continue
+		}
+		parsed = append(parsed, f)
+		memPkg.Name = f.Name.Name
+		memPkg.Files = append(memPkg.Files, &std.MemFile{
+			Name: filepath.Base(file),
+			Body: string(body),
+		})
+	}
+	if len(parsed) == 0 {
+		return issues, nil
+	}
+
+	issues = append(issues, lintImports(fset, parsed, pkgPath, rootDir)...)
+	if gno.IsRealmPath(pkgPath) {
+		issues = append(issues, lintRender(fset, parsed)...)
+	}
+	// preprocessing needs a parsable package.
+	if !parseErr {
+		issues = append(issues, lintPreprocess(memPkg, pkgDir, rootDir)...)
+	}
+	return issues, nil
+}
+
+// lintImports reports imports which are neither standard libraries nor
+// gno.land/ packages, and realms imported by pure packages.
+func lintImports(fset *token.FileSet, files []*ast.File, pkgPath string, rootDir string) (issues []lintIssue) {
+	isPure := strings.HasPrefix(pkgPath, "gno.land/p/")
+	for _, f := range files {
+		for _, spec := range f.Imports {
+			importPath := strings.Trim(spec.Path.Value, "`\"")
+			pos := fset.Position(spec.Pos()).String()
+			switch {
+			case gno.IsRealmPath(importPath) && isPure:
+				issues = append(issues, lintIssue{pos, fmt.Sprintf("pure package %s imports realm %q", pkgPath, importPath)})
+			case strings.HasPrefix(importPath, "gno.land/"):
+			case gno.IsStdlibWhitelisted(importPath):
+			case osm.DirExists(filepath.Join(rootDir, "gnovm", "stdlibs", importPath)):
+			default:
+				issues = append(issues, lintIssue{pos, fmt.Sprintf("import %q is not in the stdlib or gno.land/ allowlist", importPath)})
+			}
+		}
+	}
+	return issues
+}
+
+// lintRender reports realms without a Render function, or with one not
+// of the form `func Render(path string) string`, as expected by gnoweb.
+func lintRender(fset *token.FileSet, files []*ast.File) []lintIssue {
+	for _, f := range files {
+		for _, decl := range f.Decls {
+			fd, ok := decl.(*ast.FuncDecl)
+			if !ok || fd.Recv != nil || fd.Name.Name != "Render" {
+				continue
+			}
+			if !isStringType(fd.Type.Params) || !isStringType(fd.Type.Results) {
+				pos := fset.Position(fd.Pos()).String()
+				return []lintIssue{{pos, "Render should have signature func(path string) string"}}
+			}
+			return nil
+		}
+	}
+	pos := fset.Position(files[0].Package).String()
+	return []lintIssue{{pos, "realm has no Render function"}}
+}
+
+// isStringType returns whether fields is a single string.
+func isStringType(fields *ast.FieldList) bool {
+	if fields == nil || fields.NumFields() != 1 {
+		return false
+	}
+	ident, ok := fields.List[0].Type.(*ast.Ident)
+	return ok && ident.Name == "string"
+}
+
+// lintPreprocess preprocesses memPkg, reporting the preprocess error if
+// any, and realm globals which can't be persisted.
+func lintPreprocess(memPkg *std.MemPackage, pkgDir string, rootDir string) (issues []lintIssue) {
+	store := tests.TestStore(rootDir, "", nil, io.Discard, io.Discard, tests.ImportModeStdlibsOnly)
+	m := gno.NewMachineWithOptions(gno.MachineOptions{
+		Output: io.Discard,
+		Store:  store,
+	})
+	defer m.Release()
+
+	defer func() {
+		if r := recover(); r != nil {
+			// locations are <pkgpath>/<file>:<line>, make them relative
+			// to the package directory instead.
+			msg := strings.ReplaceAll(fmt.Sprint(r), memPkg.Path+"/", "")
+			pos := pkgDir
+			if i := strings.Index(msg, ": "); i > 0 && strings.Contains(msg[:i], ".gno:") {
+				pos, msg = filepath.Join(pkgDir, msg[:i]), msg[i+2:]
+			}
+			issues = append(issues, lintIssue{pos, msg})
+		}
+	}()
+	m.PreprocessFilesAndSaveBlockNodes(memPkg)
+
+	if !gno.IsRealmPath(memPkg.Path) {
+		return nil
+	}
+	pn := store.GetBlockNode(gno.PackageNodeLocation(memPkg.Path)).(*gno.PackageNode)
+	for _, fn := range pn.FileSet.Files {
+		for _, decl := range fn.Decls {
+			vd, ok := decl.(*gno.ValueDecl)
+			if !ok || vd.Const {
+				continue
+			}
+			for _, nx := range vd.NameExprs {
+				if nx.Name == "_" {
+					continue
+				}
+				t := pn.GetStaticTypeOf(store, nx.Name)
+				if hasNativeType(t, map[gno.Type]bool{}) {
+					pos := fmt.Sprintf("%s:%d", filepath.Join(pkgDir, string(fn.Name)), vd.GetLine())
+					issues = append(issues, lintIssue{pos, fmt.Sprintf("global %s of native type %s can never be persisted", nx.Name, t.String())})
+				}
+			}
+		}
+	}
+	return issues
+}
+
+// hasNativeType returns whether values of type t may hold native Go
+// values, which realms can't persist.
+func hasNativeType(t gno.Type, seen map[gno.Type]bool) bool {
+	if t == nil || seen[t] {
+		return false
+	}
+	seen[t] = true
+	switch ct := t.(type) {
+	case *gno.NativeType:
+		return true
+	case *gno.DeclaredType:
+		return hasNativeType(ct.Base, seen)
+	case *gno.PointerType:
+		return hasNativeType(ct.Elt, seen)
+	case *gno.SliceType:
+		return hasNativeType(ct.Elt, seen)
+	case *gno.ArrayType:
+		return hasNativeType(ct.Elt, seen)
+	case *gno.ChanType:
+		return hasNativeType(ct.Elt, seen)
+	case *gno.MapType:
+		return hasNativeType(ct.Key, seen) || hasNativeType(ct.Value, seen)
+	case *gno.StructType:
+		for _, f := range ct.Fields {
+			if hasNativeType(f.Type, seen) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,46 @@
+package main
+
+import "testing"
+
+func TestLintApp(t *testing.T) {
+	tc := []testMainCase{
+		{
+			args:        []string{"lint"},
+			errShouldBe: "flag: help requested",
+		},
+		{
+			args: []string{"lint", "../../../examples/gno.land/p/demo/avl"},
+		},
+		{
+			args:                []string{"lint", "../../tests/integ/lint-realm"},
+			stderrShouldContain: "lint-realm/lint.gno:10:1: Render should have signature func(path string) string",
+			errShouldBe:         "2 lint issues",
+		},
+		{
+			args:                []string{"lint", "../../tests/integ/lint-realm"},
+			stderrShouldContain: "lint-realm/lint.gno:7: global random of native type gonative{*rand.Rand} can never be persisted",
+			errShouldBe:         "2 lint issues",
+		},
+		{
+			args:           []string{"lint", "../../tests/integ/lint-preprocess-error"},
+			stderrShouldBe: "../../tests/integ/lint-preprocess-error/preprocess.gno:6: name undefinedName not declared\n",
+			errShouldBe:    "1 lint issues",
+		},
+		{
+			args:                []string{"lint", "../../tests/integ/lint-pure-imports-realm"},
+			stderrShouldContain: "lint-pure-imports-realm/pure.gno:4:2: import \"net/http\" is not in the stdlib or gno.land/ allowlist",
+			errShouldBe:         "3 lint issues",
+		},
+		{
+			args:                []string{"lint", "../../tests/integ/lint-pure-imports-realm"},
+			stderrShouldContain: "lint-pure-imports-realm/pure.gno:6:2: pure package gno.land/p/test/pure imports realm \"gno.land/r/demo/users\"",
+			errShouldBe:         "3 lint issues",
+		},
+		{
+			args:           []string{"lint", "../../tests/integ/lint-realm-no-render"},
+			stderrShouldBe: "../../tests/integ/lint-realm-no-render/norender.gno:1:1: realm has no Render function\n",
+			errShouldBe:    "1 lint issues",
+		},
+	}
+	testMainCaseRun(t, tc)
+}
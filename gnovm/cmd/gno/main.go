@@ -35,7 +35,9 @@ func newGnodevCmd(io *commands.IO) *commands.Command {
 		newTestCmd(io),
 		newModCmd(io),
 		newReplCmd(),
-		// fmt -- gofmt
+		newFmtCmd(io),
+		newLintCmd(io),
+		newDocCmd(io),
 		// clean
 		// graph
 		// vendor -- download deps from the chain in vendor/
@@ -43,7 +45,6 @@ func newGnodevCmd(io *commands.IO) *commands.Command {
 		// render -- call render()?
 		// publish/release
 		// generate
-		// doc -- godoc
 		// "vm" -- starts an in-memory chain that can be interacted with?
 		// bug -- start a bug report
 		// version -- show gnodev, golang versions
@@ -4,12 +4,18 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
 
+	gno "github.com/gnolang/gno/gnovm/pkg/gnolang"
 	"github.com/gnolang/gno/gnovm/pkg/gnomod"
 	"github.com/gnolang/gno/tm2/pkg/commands"
 	"github.com/gnolang/gno/tm2/pkg/errors"
+	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/module"
 )
 
 type modDownloadCfg struct {
@@ -29,6 +35,9 @@ func newModCmd(io *commands.IO) *commands.Command {
 
 	cmd.AddSubCommands(
 		newModDownloadCmd(io),
+		newModInitCmd(io),
+		newModTidyCmd(io),
+		newModGraphCmd(io),
 	)
 
 	return cmd
@@ -68,35 +77,27 @@ func execModDownload(cfg *modDownloadCfg, args []string, io *commands.IO) error
 	if err != nil {
 		return err
 	}
-	modPath := filepath.Join(path, "gno.mod")
-	if !isFileExist(modPath) {
-		return errors.New("gno.mod not found")
-	}
-
-	// read gno.mod
-	data, err := os.ReadFile(modPath)
+	gnoMod, err := readGnoMod(path)
 	if err != nil {
-		return fmt.Errorf("readfile %q: %w", modPath, err)
+		return err
 	}
 
-	// parse gno.mod
-	gnoMod, err := gnomod.Parse(modPath, data)
+	// read gno.sum
+	sum, err := gnomod.ReadSum(path)
 	if err != nil {
-		return fmt.Errorf("parse: %w", err)
-	}
-	// sanitize gno.mod
-	gnoMod.Sanitize()
-
-	// validate gno.mod
-	if err := gnoMod.Validate(); err != nil {
-		return fmt.Errorf("validate: %w", err)
+		return fmt.Errorf("read gno.sum: %w", err)
 	}
 
 	// fetch dependencies
-	if err := gnoMod.FetchDeps(gnomod.GetGnoModPath(), cfg.remote); err != nil {
+	if err := gnoMod.FetchDeps(gnomod.GetGnoModPath(), cfg.remote, sum); err != nil {
 		return fmt.Errorf("fetch: %w", err)
 	}
 
+	// write gno.sum file
+	if err := sum.WriteToPath(path); err != nil {
+		return fmt.Errorf("write gno.sum file: %w", err)
+	}
+
 	gomod, err := gnomod.GnoToGoMod(*gnoMod)
 	if err != nil {
 		return fmt.Errorf("sanitize: %w", err)
@@ -110,3 +111,268 @@ func execModDownload(cfg *modDownloadCfg, args []string, io *commands.IO) error
 
 	return nil
 }
+
+func newModInitCmd(io *commands.IO) *commands.Command {
+	return commands.NewCommand(
+		commands.Metadata{
+			Name:       "init",
+			ShortUsage: "init <module path>",
+			ShortHelp:  "Initialize gno.mod file in current directory",
+		},
+		commands.NewEmptyConfig(),
+		func(_ context.Context, args []string) error {
+			return execModInit(args, io)
+		},
+	)
+}
+
+func execModInit(args []string, io *commands.IO) error {
+	if len(args) != 1 {
+		return flag.ErrHelp
+	}
+	modPath := args[0]
+	if err := module.CheckImportPath(modPath); err != nil {
+		return fmt.Errorf("invalid module path: %w", err)
+	}
+
+	path, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	if isFileExist(filepath.Join(path, "gno.mod")) {
+		return errors.New("gno.mod already exists")
+	}
+
+	gnoMod := &gnomod.File{
+		Module: &modfile.Module{
+			Mod: module.Version{Path: modPath},
+		},
+	}
+	if err := gnoMod.WriteGnoMod(path); err != nil {
+		return err
+	}
+	io.ErrPrintfln("creating new gno.mod: module %s", modPath)
+
+	return nil
+}
+
+func newModTidyCmd(io *commands.IO) *commands.Command {
+	return commands.NewCommand(
+		commands.Metadata{
+			Name:       "tidy",
+			ShortUsage: "tidy",
+			ShortHelp:  "Add missing and remove unused requirements of gno.mod",
+		},
+		commands.NewEmptyConfig(),
+		func(_ context.Context, args []string) error {
+			return execModTidy(args, io)
+		},
+	)
+}
+
+func execModTidy(args []string, io *commands.IO) error {
+	if len(args) > 0 {
+		return flag.ErrHelp
+	}
+
+	path, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	gnoMod, err := readGnoMod(path)
+	if err != nil {
+		return err
+	}
+
+	imports, err := getGnoModImports(path, gnoMod.Module.Mod.Path)
+	if err != nil {
+		return err
+	}
+
+	// keep the versions of the required modules still imported.
+	versions := make(map[string]string, len(gnoMod.Require))
+	for _, r := range gnoMod.Require {
+		versions[r.Mod.Path] = r.Mod.Version
+	}
+	requires := make([]*modfile.Require, 0, len(imports))
+	for _, imp := range imports {
+		version, ok := versions[imp]
+		if !ok {
+			version = "v0.0.0"
+		}
+		requires = append(requires, &modfile.Require{
+			Mod: module.Version{Path: imp, Version: version},
+		})
+	}
+	gnoMod.Require = nil
+	if len(requires) > 0 {
+		gnoMod.Require = requires
+	}
+
+	return gnoMod.WriteGnoMod(path)
+}
+
+// getGnoModImports returns the sorted paths of the modules imported by
+// the .gno files of the module modPath in root. Directories of nested
+// modules and packages of the module itself are skipped.
+func getGnoModImports(root, modPath string) ([]string, error) {
+	seen := map[string]bool{}
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if path != root && isFileExist(filepath.Join(path, "gno.mod")) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !isGnoFile(d) {
+			return nil
+		}
+
+		bz, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		res, err := gno.Precompile(string(bz), "", d.Name())
+		if err != nil {
+			return fmt.Errorf("precompile %q: %w", path, err)
+		}
+		for _, imp := range getPathsFromImportSpec(res.Imports) {
+			pkgPath := strings.TrimPrefix(string(imp), "./examples/")
+			if pkgPath == string(imp) {
+				continue // not a gno package, e.g. std.
+			}
+			if pkgPath == modPath || strings.HasPrefix(pkgPath, modPath+"/") {
+				continue
+			}
+			seen[pkgPath] = true
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	imports := make([]string, 0, len(seen))
+	for imp := range seen {
+		imports = append(imports, imp)
+	}
+	sort.Strings(imports)
+	return imports, nil
+}
+
+func newModGraphCmd(io *commands.IO) *commands.Command {
+	return commands.NewCommand(
+		commands.Metadata{
+			Name:       "graph",
+			ShortUsage: "graph",
+			ShortHelp:  "Print module requirement graph",
+			LongHelp: "Prints the requirement graph of gno.mod, one edge per line.\n" +
+				"Requirements of dependencies are read from the module cache,\n" +
+				"see `gno mod download`.",
+		},
+		commands.NewEmptyConfig(),
+		func(_ context.Context, args []string) error {
+			return execModGraph(args, io)
+		},
+	)
+}
+
+func execModGraph(args []string, io *commands.IO) error {
+	if len(args) > 0 {
+		return flag.ErrHelp
+	}
+
+	path, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	gnoMod, err := readGnoMod(path)
+	if err != nil {
+		return err
+	}
+
+	cachePath := gnomod.GetGnoModPath()
+	visited := map[string]bool{}
+	var walk func(from string, requires []*modfile.Require) error
+	walk = func(from string, requires []*modfile.Require) error {
+		for _, r := range requires {
+			mod := r.Mod
+			if rep := getReplacement(mod, gnoMod.Replace); rep != nil {
+				if modfile.IsDirectoryPath(rep.Path) {
+					io.Printfln("%s %s@%s", from, mod.Path, mod.Version)
+					continue
+				}
+				mod = *rep
+			}
+			to := mod.Path + "@" + mod.Version
+			io.Printfln("%s %s", from, to)
+			if visited[to] {
+				continue
+			}
+			visited[to] = true
+
+			// requirements of dependencies, from their go.mod.
+			depPath := filepath.Join(cachePath, mod.Path, "go.mod")
+			data, err := os.ReadFile(depPath)
+			if os.IsNotExist(err) {
+				continue // not downloaded.
+			} else if err != nil {
+				return err
+			}
+			depMod, err := gnomod.Parse(depPath, data)
+			if err != nil {
+				return fmt.Errorf("parse: %w", err)
+			}
+			for _, dr := range depMod.Require {
+				dr.Mod.Path = strings.TrimPrefix(dr.Mod.Path, gno.ImportPrefix+"/examples/")
+			}
+			if err := walk(to, depMod.Require); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return walk(gnoMod.Module.Mod.Path, gnoMod.Require)
+}
+
+func getReplacement(mod module.Version, replace []*modfile.Replace) *module.Version {
+	for _, r := range replace {
+		if r.Old.Path == mod.Path && (r.Old.Version == "" || r.Old.Version == mod.Version) {
+			return &r.New
+		}
+	}
+	return nil
+}
+
+// readGnoMod reads, sanitizes and validates the gno.mod in path.
+func readGnoMod(path string) (*gnomod.File, error) {
+	modPath := filepath.Join(path, "gno.mod")
+	if !isFileExist(modPath) {
+		return nil, errors.New("gno.mod not found")
+	}
+
+	// read gno.mod
+	data, err := os.ReadFile(modPath)
+	if err != nil {
+		return nil, fmt.Errorf("readfile %q: %w", modPath, err)
+	}
+
+	// parse gno.mod
+	gnoMod, err := gnomod.Parse(modPath, data)
+	if err != nil {
+		return nil, fmt.Errorf("parse: %w", err)
+	}
+	// sanitize gno.mod
+	gnoMod.Sanitize()
+
+	// validate gno.mod
+	if err := gnoMod.Validate(); err != nil {
+		return nil, fmt.Errorf("validate: %w", err)
+	}
+
+	return gnoMod, nil
+}
@@ -1,6 +1,16 @@
 package main
 
-import "testing"
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gnolang/gno/gnovm/pkg/gnomod"
+	"github.com/gnolang/gno/tm2/pkg/commands"
+	"github.com/stretchr/testify/require"
+)
 
 func TestModApp(t *testing.T) {
 	tc := []testMainCase{
@@ -42,7 +52,7 @@ func TestModApp(t *testing.T) {
 			args:                 []string{"mod", "download"},
 			testDir:              "../../tests/integ/require-invalid-module",
 			simulateExternalRepo: true,
-			errShouldContain:     "fetch: writepackage: querychain:",
+			errShouldContain:     "fetch: fetchpackage: querychain:",
 		},
 		{
 			args:                 []string{"mod", "download"},
@@ -70,8 +80,180 @@ func TestModApp(t *testing.T) {
 			args:                 []string{"mod", "download"},
 			testDir:              "../../tests/integ/replace-with-invalid-module",
 			simulateExternalRepo: true,
-			errShouldContain:     "fetch: writepackage: querychain:",
+			errShouldContain:     "fetch: fetchpackage: querychain:",
+		},
+
+		// test gno mod init
+		{
+			args:                 []string{"mod", "init"},
+			testDir:              "../../tests/integ/empty-dir",
+			simulateExternalRepo: true,
+			errShouldBe:          "flag: help requested",
+		},
+		{
+			args:                 []string{"mod", "init", "gno.land/p/demo/foo"},
+			testDir:              "../../tests/integ/empty-dir",
+			simulateExternalRepo: true,
+			stderrShouldBe:       "creating new gno.mod: module gno.land/p/demo/foo\n",
+		},
+		{
+			args:                 []string{"mod", "init", "gno.land/p/demo/foo"},
+			testDir:              "../../tests/integ/minimalist-gnomod",
+			simulateExternalRepo: true,
+			errShouldBe:          "gno.mod already exists",
+		},
+		{
+			args:                 []string{"mod", "init", "invalid path"},
+			testDir:              "../../tests/integ/empty-dir",
+			simulateExternalRepo: true,
+			errShouldContain:     "invalid module path",
+		},
+
+		// test gno mod tidy
+		{
+			args:                 []string{"mod", "tidy"},
+			testDir:              "../../tests/integ/empty-dir",
+			simulateExternalRepo: true,
+			errShouldBe:          "gno.mod not found",
+		},
+		{
+			args:                 []string{"mod", "tidy"},
+			testDir:              "../../tests/integ/require-remote-module",
+			simulateExternalRepo: true,
+		},
+
+		// test gno mod graph
+		{
+			args:                 []string{"mod", "graph"},
+			testDir:              "../../tests/integ/empty-dir",
+			simulateExternalRepo: true,
+			errShouldBe:          "gno.mod not found",
+		},
+		{
+			args:                 []string{"mod", "graph"},
+			testDir:              "../../tests/integ/replace-with-dir",
+			simulateExternalRepo: true,
+			stdoutShouldBe:       "gno.land/tests/replaceavl gno.land/p/demo/notexists@v0.0.0\n",
 		},
 	}
 	testMainCaseRun(t, tc)
 }
+
+func TestModTidy(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"gno.mod": `module gno.land/r/demo/tidy
+
+require (
+	"gno.land/p/demo/unused" v0.0.0
+	"gno.land/p/demo/avl" v0.1.0
+)
+`,
+		"tidy.gno": `package tidy
+
+import (
+	"std"
+
+	"gno.land/p/demo/avl"
+	"gno.land/r/demo/tidy/sub"
+)
+
+var tree avl.Tree
+
+func Caller() std.Address { return std.GetOrigCaller() }
+`,
+		"tidy_test.gno": `package tidy
+
+import "gno.land/p/demo/testutils"
+
+var _ = testutils.TestAddress
+`,
+		"sub/sub.gno": `package sub
+
+import "gno.land/p/demo/ufmt"
+
+var _ = ufmt.Sprintf
+`,
+		"nested/gno.mod": "module gno.land/r/demo/nested\n",
+		"nested/nested.gno": `package nested
+
+import "gno.land/p/demo/blog"
+
+var _ blog.Blog
+`,
+	})
+	runModCmd(t, dir, "mod", "tidy")
+
+	bz, err := os.ReadFile(filepath.Join(dir, "gno.mod"))
+	require.NoError(t, err)
+	require.Equal(t, `module gno.land/r/demo/tidy
+
+require (
+	gno.land/p/demo/avl v0.1.0
+	gno.land/p/demo/testutils v0.0.0
+	gno.land/p/demo/ufmt v0.0.0
+)
+`, string(bz))
+}
+
+func TestModGraph(t *testing.T) {
+	// dependencies are read from the module cache.
+	home := t.TempDir()
+	t.Setenv("GNO_HOME", home)
+	cache := gnomod.GetGnoModPath()
+	writeFiles(t, cache, map[string]string{
+		"gno.land/p/demo/blog/go.mod": `module github.com/gnolang/gno/examples/gno.land/p/demo/blog
+
+require (
+	github.com/gnolang/gno/examples/gno.land/p/demo/avl v0.0.0
+	github.com/gnolang/gno/examples/gno.land/p/demo/ufmt v0.0.0
+)
+`,
+		"gno.land/p/demo/ufmt/go.mod": "module github.com/gnolang/gno/examples/gno.land/p/demo/ufmt\n",
+	})
+
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"gno.mod": `module gno.land/r/demo/graph
+
+require (
+	gno.land/p/demo/blog v0.0.0
+	gno.land/p/demo/ufmt v0.0.0
+)
+`,
+	})
+	out := runModCmd(t, dir, "mod", "graph")
+	require.Equal(t, `gno.land/r/demo/graph gno.land/p/demo/blog@v0.0.0
+gno.land/p/demo/blog@v0.0.0 gno.land/p/demo/avl@v0.0.0
+gno.land/p/demo/blog@v0.0.0 gno.land/p/demo/ufmt@v0.0.0
+gno.land/r/demo/graph gno.land/p/demo/ufmt@v0.0.0
+`, out)
+}
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+
+	for name, body := range files {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(body), 0o644))
+	}
+}
+
+// runModCmd runs gno with args in dir, and returns its stdout.
+func runModCmd(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(dir))
+	defer os.Chdir(wd)
+
+	out := new(bytes.Buffer)
+	io := commands.NewTestIO()
+	io.SetOut(commands.WriteNopCloser(out))
+	io.SetErr(commands.WriteNopCloser(new(bytes.Buffer)))
+	err = newGnodevCmd(io).ParseAndRun(context.Background(), args)
+	require.NoError(t, err)
+	return out.String()
+}
@@ -95,8 +95,8 @@ func (c *precompileCfg) RegisterFlags(fs *flag.FlagSet) {
 	fs.StringVar(
 		&c.gofmtBinary,
 		"go-fmt-binary",
-		"gofmt",
-		"gofmt binary to use for syntax checking",
+		"",
+		"gofmt binary to use for syntax checking (default: built-in parser)",
 	)
 
 	fs.StringVar(
@@ -160,9 +160,6 @@ func precompilePkg(pkgPath importPath, opts *precompileOptions) error {
 func precompileFile(srcPath string, opts *precompileOptions) error {
 	flags := opts.getFlags()
 	gofmt := flags.gofmtBinary
-	if gofmt == "" {
-		gofmt = "gofmt"
-	}
 
 	if flags.verbose {
 		fmt.Fprintf(os.Stderr, "%s\n", srcPath)
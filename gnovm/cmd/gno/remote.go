@@ -0,0 +1,130 @@
+package main
+
+import (
+	"fmt"
+	"io"
+
+	gno "github.com/gnolang/gno/gnovm/pkg/gnolang"
+	"github.com/gnolang/gno/tm2/pkg/bft/rpc/client"
+	"github.com/gnolang/gno/tm2/pkg/store"
+	"github.com/gnolang/gno/tm2/pkg/store/cache"
+)
+
+// remoteQuerier queries a gnoland node, e.g. with abci_query.
+type remoteQuerier func(path string, data []byte) ([]byte, error)
+
+// newRemoteQuerier returns a remoteQuerier using the rpc endpoint
+// remote, querying the latest state, as realm objects are not
+// versioned. Responses are cached, so that repeated reads are
+// consistent.
+func newRemoteQuerier(remote string) remoteQuerier {
+	cli := client.NewHTTP(remote, "/websocket")
+	results := make(map[string][]byte)
+	return func(path string, data []byte) ([]byte, error) {
+		key := path + "\x00" + string(data)
+		if bz, ok := results[key]; ok {
+			return bz, nil
+		}
+		qres, err := cli.ABCIQuery(path, data)
+		if err != nil {
+			return nil, err
+		}
+		if qres.Response.Error != nil {
+			return nil, fmt.Errorf("%s: %w", qres.Response.Log, qres.Response.Error)
+		}
+		results[key] = qres.Response.Data
+		return qres.Response.Data, nil
+	}
+}
+
+// remoteKVStore is a read-only store.Store fetching the values of one
+// of the stores backing the gno store of a node ("base" or "iavl")
+// with the vm/store query.
+type remoteKVStore struct {
+	query remoteQuerier
+	name  string
+}
+
+var _ store.Store = remoteKVStore{}
+
+func (rs remoteKVStore) Get(key []byte) []byte {
+	bz, err := rs.query("vm/store", append([]byte(rs.name+"\n"), key...))
+	if err != nil {
+		panic(fmt.Sprintf("unable to fetch %q from remote %s store: %v", key, rs.name, err))
+	}
+	if len(bz) == 0 {
+		return nil
+	}
+	return bz
+}
+
+func (rs remoteKVStore) Has(key []byte) bool {
+	return rs.Get(key) != nil
+}
+
+func (rs remoteKVStore) Set(key, value []byte) {
+	panic("remote store is read-only")
+}
+
+func (rs remoteKVStore) Delete(key []byte) {
+	panic("remote store is read-only")
+}
+
+func (rs remoteKVStore) Iterator(start, end []byte) store.Iterator {
+	panic("remote store does not support iteration")
+}
+
+func (rs remoteKVStore) ReverseIterator(start, end []byte) store.Iterator {
+	panic("remote store does not support iteration")
+}
+
+func (rs remoteKVStore) CacheWrap() store.Store {
+	return cache.New(rs)
+}
+
+func (rs remoteKVStore) Write() {}
+
+// remoteStore is a gno.Store reading packages and realm state through
+// from a node. Packages are fetched lazily, when first imported, and
+// preprocessed locally since block nodes are not persisted. Writes are
+// kept in memory and never sent to the node.
+type remoteStore struct {
+	gno.Store
+	preprocessed map[string]bool
+}
+
+// newRemoteStore swaps the backends of the local store base with cached
+// remote stores, and returns a store reading through them. base still
+// provides the native injections, and packages not found remotely.
+func newRemoteStore(base gno.Store, query remoteQuerier) gno.Store {
+	base.SwapStores(
+		remoteKVStore{query: query, name: "base"}.CacheWrap(),
+		remoteKVStore{query: query, name: "iavl"}.CacheWrap(),
+	)
+	return &remoteStore{
+		Store:        base,
+		preprocessed: make(map[string]bool),
+	}
+}
+
+func (rs *remoteStore) GetPackage(pkgPath string, isImport bool) *gno.PackageValue {
+	if !rs.preprocessed[pkgPath] {
+		rs.preprocessed[pkgPath] = true
+		if memPkg := rs.GetMemPackage(pkgPath); memPkg != nil {
+			m := gno.NewMachineWithOptions(gno.MachineOptions{
+				Output: io.Discard,
+				Store:  rs,
+			})
+			m.PreprocessFilesAndSaveBlockNodes(memPkg)
+			m.Release()
+		}
+	}
+	return rs.Store.GetPackage(pkgPath, isImport)
+}
+
+func (rs *remoteStore) Fork() gno.Store {
+	return &remoteStore{
+		Store:        rs.Store.Fork(),
+		preprocessed: rs.preprocessed,
+	}
+}
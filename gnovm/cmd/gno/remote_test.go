@@ -0,0 +1,143 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	gno "github.com/gnolang/gno/gnovm/pkg/gnolang"
+	"github.com/gnolang/gno/gnovm/tests"
+	dbm "github.com/gnolang/gno/tm2/pkg/db"
+	"github.com/gnolang/gno/tm2/pkg/std"
+	"github.com/gnolang/gno/tm2/pkg/store/dbadapter"
+	"github.com/gnolang/gno/tm2/pkg/store/iavl"
+	stypes "github.com/gnolang/gno/tm2/pkg/store/types"
+)
+
+const remoteCounterPath = "gno.land/r/test/counter"
+
+// newTestChain deploys a counter realm into a local store, incremented
+// twice, and returns a remoteQuerier serving vm/store queries from it.
+func newTestChain(t *testing.T, rootDir string) remoteQuerier {
+	t.Helper()
+
+	db := dbm.NewMemDB()
+	baseStore := dbadapter.StoreConstructor(db, stypes.StoreOptions{})
+	iavlStore := iavl.StoreConstructor(db, stypes.StoreOptions{})
+	chain := tests.TestStore(rootDir, "", nil, io.Discard, io.Discard, tests.ImportModeStdlibsOnly)
+	chain.SwapStores(baseStore, iavlStore)
+
+	memPkg := &std.MemPackage{
+		Name: "counter",
+		Path: remoteCounterPath,
+		Files: []*std.MemFile{{
+			Name: "counter.gno",
+			Body: `package counter
+
+var count int
+
+func Incr() int {
+	count++
+	return count
+}
+
+func Get() int {
+	return count
+}
+`,
+		}},
+	}
+	m := gno.NewMachineWithOptions(gno.MachineOptions{
+		PkgPath: remoteCounterPath,
+		Output:  io.Discard,
+		Store:   chain,
+	})
+	_, pv := m.RunMemPackage(memPkg, true)
+	m.Release()
+
+	// call Incr twice, as a transaction would.
+	mpn := gno.NewPackageNode("main", "main", nil)
+	mpn.Define("pkg", gno.TypedValue{T: &gno.PackageType{}, V: pv})
+	mpv := mpn.NewPackage()
+	for i := 0; i < 2; i++ {
+		m := gno.NewMachineWithOptions(gno.MachineOptions{
+			Output: io.Discard,
+			Store:  chain,
+		})
+		m.SetActivePackage(mpv)
+		m.Eval(gno.Call(gno.Sel(gno.Nx("pkg"), "Incr")))
+		m.Release()
+	}
+
+	return func(path string, data []byte) ([]byte, error) {
+		if path != "vm/store" {
+			t.Fatalf("unexpected query path %q", path)
+		}
+		parts := bytes.SplitN(data, []byte("\n"), 2)
+		switch string(parts[0]) {
+		case "base":
+			return baseStore.Get(parts[1]), nil
+		case "iavl":
+			return iavlStore.Get(parts[1]), nil
+		default:
+			t.Fatalf("unexpected store %q", parts[0])
+			return nil, nil
+		}
+	}
+}
+
+func runRemote(t *testing.T, rootDir string, query remoteQuerier, src string) string {
+	t.Helper()
+
+	out := new(bytes.Buffer)
+	store := tests.TestStore(rootDir, "", nil, out, io.Discard, tests.ImportModeStdlibsOnly)
+	store = newRemoteStore(store, query)
+	m := gno.NewMachineWithOptions(gno.MachineOptions{
+		PkgPath: "main",
+		Output:  out,
+		Store:   store,
+	})
+	defer m.Release()
+	m.RunFiles(gno.MustParseFile("main.gno", src))
+	m.RunMain()
+	return out.String()
+}
+
+func TestRemoteStore(t *testing.T) {
+	rootDir := guessRootDir()
+	query := newTestChain(t, rootDir)
+
+	src := `package main
+
+import "gno.land/r/test/counter"
+
+func main() {
+	println(counter.Get())
+	println(counter.Incr())
+}
+`
+	// state is read from the chain, and local writes are not sent back.
+	for i := 0; i < 2; i++ {
+		if out := runRemote(t, rootDir, query, src); out != "2\n3\n" {
+			t.Fatalf("unexpected output %q", out)
+		}
+	}
+}
+
+func TestReplRemote(t *testing.T) {
+	rootDir := guessRootDir()
+	r := newReplSession(rootDir, nil, io.Discard, io.Discard)
+	r.remote = newTestChain(t, rootDir)
+
+	if _, err := r.Process(`import "gno.land/r/test/counter"`); err != nil {
+		t.Fatal(err)
+	}
+	out, err := r.Process("counter.Get()")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "(2 int)") {
+		t.Errorf("unexpected output %q", out)
+	}
+}
@@ -1,12 +1,19 @@
 package main
 
 import (
+	"bufio"
+	"bytes"
 	"context"
 	goerrors "errors"
 	"flag"
 	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/scanner"
+	"go/token"
 	"io"
 	"os"
+	"strings"
 
 	gno "github.com/gnolang/gno/gnovm/pkg/gnolang"
 	"github.com/gnolang/gno/gnovm/tests"
@@ -17,6 +24,7 @@ import (
 type replCfg struct {
 	verbose bool
 	rootDir string
+	remote  string
 }
 
 func newReplCmd() *commands.Command {
@@ -27,6 +35,7 @@ func newReplCmd() *commands.Command {
 			Name:       "repl",
 			ShortUsage: "repl [flags]",
 			ShortHelp:  "Starts a GnoVM REPL",
+			LongHelp:   replHelp,
 		},
 		cfg,
 		func(_ context.Context, args []string) error {
@@ -49,6 +58,14 @@ func (c *replCfg) RegisterFlags(fs *flag.FlagSet) {
 		"",
 		"clone location of github.com/gnolang/gno (gnodev tries to guess it)",
 	)
+
+	fs.StringVar(
+		&c.remote,
+		"remote",
+		"",
+		"evaluate against the state of a gnoland node at the given rpc address",
+	)
+
 }
 
 func execRepl(cfg *replCfg, args []string) error {
@@ -60,59 +77,518 @@ func execRepl(cfg *replCfg, args []string) error {
 		cfg.rootDir = guessRootDir()
 	}
 
-	return runRepl(cfg.rootDir, cfg.verbose)
+	return runRepl(cfg)
 }
 
-func runRepl(rootDir string, verbose bool) error {
+const replHelp = `Imports, declarations and statements are kept in the session;
+expressions are evaluated and their values printed with their types.
+Input spanning several lines is read until all brackets are closed.
+
+Meta-commands:
+  :reset              clear the session
+  :import <path>...   import the given packages
+  :save <file.gno>    write the session as a gno program
+  :load <file.gno>    load imports, declarations and main body of a file
+  :state              print the session source
+  :help               print this help`
+
+func runRepl(cfg *replCfg) error {
 	stdin := os.Stdin
 	stdout := os.Stdout
 	stderr := os.Stderr
 
-	// init store and machine
-	testStore := tests.TestStore(rootDir, "", stdin, stdout, stderr, tests.ImportModeStdlibsOnly)
-	if verbose {
-		testStore.SetLogStoreOps(true)
+	r := newReplSession(cfg.rootDir, stdin, stdout, stderr)
+	r.verbose = cfg.verbose
+	if cfg.remote != "" {
+		r.remote = newRemoteQuerier(cfg.remote)
 	}
-	m := gno.NewMachineWithOptions(gno.MachineOptions{
-		PkgPath: "test",
-		Output:  stdout,
-		Store:   testStore,
-	})
 
-	defer m.Release()
-
-	// init termui
-	rw := struct {
-		io.Reader
-		io.Writer
-	}{os.Stdin, os.Stderr}
-	t := term.NewTerminal(rw, "")
+	// init termui, or read plain lines if stdin is not a terminal.
+	var readLine func(prompt string) (string, error)
+	if term.IsTerminal(0) {
+		rw := struct {
+			io.Reader
+			io.Writer
+		}{os.Stdin, os.Stderr}
+		t := term.NewTerminal(rw, "")
+		readLine = func(prompt string) (string, error) {
+			t.SetPrompt(prompt)
+			oldState, err := term.MakeRaw(0)
+			if err != nil {
+				return "", err
+			}
+			defer term.Restore(0, oldState)
+			return t.ReadLine()
+		}
+	} else {
+		scanner := bufio.NewScanner(stdin)
+		readLine = func(string) (string, error) {
+			if !scanner.Scan() {
+				if err := scanner.Err(); err != nil {
+					return "", err
+				}
+				return "", io.EOF
+			}
+			return scanner.Text(), nil
+		}
+	}
 
 	// main loop
-	for i := 1; ; i++ {
-		// parse line and execute
-		t.SetPrompt(fmt.Sprintf("gno:%d> ", i))
-		oldState, err := term.MakeRaw(0)
-		input, err := t.ReadLine()
+	var input string
+	for i := 1; ; {
+		prompt := fmt.Sprintf("gno:%d> ", i)
+		if input != "" {
+			prompt = "... "
+		}
+		line, err := readLine(prompt)
 		if err != nil {
-			term.Restore(0, oldState)
 			if goerrors.Is(err, io.EOF) {
 				return nil
 			}
 			return fmt.Errorf("term error: %w", err)
 		}
-		term.Restore(0, oldState)
 
-		funcName := fmt.Sprintf("repl_%d", i)
-		src := "package test\nfunc " + funcName + "() {\n" + input + "\n}"
-		// FIXME: support ";" as line separator?
-		// FIXME: gofmt as linter + formatter
-		// FIXME: support multiline when unclosed parenthesis, etc
+		// read more lines until brackets balance.
+		input += line + "\n"
+		if replNeedsMore(input) {
+			continue
+		}
+		src := strings.TrimSpace(input)
+		input = ""
+		if src == "" {
+			continue
+		}
+		i++
+
+		out, err := r.Process(src)
+		if out != "" {
+			fmt.Fprint(stdout, out)
+		}
+		if err != nil {
+			fmt.Fprintln(stderr, "error:", err)
+		}
+	}
+}
+
+// replNeedsMore returns true if src has unbalanced brackets or an
+// unterminated raw string or comment, and more input should be read.
+func replNeedsMore(src string) bool {
+	var s scanner.Scanner
+	fset := token.NewFileSet()
+	file := fset.AddFile("", fset.Base(), len(src))
+	incomplete := false
+	s.Init(file, []byte(src), func(_ token.Position, msg string) {
+		if strings.Contains(msg, "not terminated") {
+			incomplete = true
+		}
+	}, scanner.ScanComments)
+
+	depth := 0
+	for {
+		_, tok, _ := s.Scan()
+		switch tok {
+		case token.LPAREN, token.LBRACK, token.LBRACE:
+			depth++
+		case token.RPAREN, token.RBRACK, token.RBRACE:
+			depth--
+		case token.EOF:
+			return incomplete || depth > 0
+		}
+	}
+}
+
+//----------------------------------------
+// replSession
+
+const (
+	replMainFunc    = "__repl_main"
+	replCaptureFunc = "__repl_capture"
+	replValuesVar   = "__repl_vals"
+)
+
+// replSession holds the imports, declarations and statements entered
+// so far. Every input is evaluated by replaying the whole session in a
+// new machine; only the output produced after the previous input is
+// shown, which relies on the replay being deterministic.
+type replSession struct {
+	rootDir string
+	verbose bool
+	remote  remoteQuerier // or nil.
+	stdin   io.Reader
+	stdout  io.Writer
+	stderr  io.Writer
+
+	imports []string   // import specs, e.g. `"strings"` or `s "strings"`.
+	decls   []replDecl // package level declarations.
+	stmts   []replStmt // body of the session main function.
+	outLen  int        // length of output already shown.
+}
+
+type replDecl struct {
+	names []string
+	src   string
+}
+
+type replStmt struct {
+	src  string
+	expr bool // src is an expression whose values are printed.
+}
+
+func newReplSession(rootDir string, stdin io.Reader, stdout, stderr io.Writer) *replSession {
+	return &replSession{
+		rootDir: rootDir,
+		stdin:   stdin,
+		stdout:  stdout,
+		stderr:  stderr,
+	}
+}
+
+// Process evaluates a complete input, either a meta-command, imports
+// and declarations, an expression or statements. It returns the output
+// of the evaluation. If the input fails, the session is left unchanged.
+func (r *replSession) Process(input string) (string, error) {
+	input = strings.TrimSpace(input)
+	if strings.HasPrefix(input, ":") {
+		return r.command(input)
+	}
+
+	// imports and declarations.
+	if f, err := parser.ParseFile(token.NewFileSet(), "", "package main\n"+input, 0); err == nil && len(f.Decls) > 0 {
+		prev := r.save()
+		if err := r.addFile("package main\n"+input, f, false); err != nil {
+			return "", err
+		}
+		return r.eval(prev, false)
+	}
+
+	// expressions.
+	if x, err := parser.ParseExpr(input); err == nil {
+		prev := r.save()
+		r.stmts = append(r.stmts, replStmt{src: input, expr: true})
+		out, err := r.eval(prev, true)
+		if _, ok := x.(*ast.CallExpr); !ok || !goerrors.Is(err, errReplPreprocess) {
+			return out, err
+		}
+		// a call without results is a statement.
+		r.restore(prev)
+	}
+
+	// statements.
+	const header = "package main\nfunc _() {\n"
+	f, err := parser.ParseFile(token.NewFileSet(), "", header+input+"\n}", 0)
+	if err != nil {
+		return "", replParseError(err, 2)
+	}
+	prev := r.save()
+	r.stmts = append(r.stmts, replStmt{src: input})
+	out, err := r.eval(prev, false)
+	if err != nil && strings.Contains(err.Error(), "no new variables") {
+		// allow redefining session variables, as an assignment.
+		body := f.Decls[0].(*ast.FuncDecl).Body.List
+		if as, ok := body[0].(*ast.AssignStmt); ok && len(body) == 1 && as.Tok == token.DEFINE {
+			pos := int(as.TokPos) - 1 - len(header)
+			r.stmts = append(r.stmts, replStmt{src: input[:pos] + "=" + input[pos+2:]})
+			return r.eval(prev, false)
+		}
+	}
+	return out, err
+}
 
-		n := gno.MustParseFile(funcName+".gno", src)
-		m.RunFiles(n)
-		m.RunStatement(gno.S(gno.Call(gno.X(funcName))))
+func (r *replSession) command(input string) (string, error) {
+	args := strings.Fields(input)
+	switch cmd := args[0]; cmd {
+	case ":reset":
+		r.restore(replState{})
+		r.outLen = 0
+		return "", nil
+	case ":import":
+		if len(args) < 2 {
+			return "", goerrors.New("usage: :import <path>...")
+		}
+		prev := r.save()
+		for _, path := range args[1:] {
+			r.addImport(fmt.Sprintf("%q", strings.Trim(path, `"`)))
+		}
+		return r.eval(prev, false)
+	case ":save":
+		if len(args) != 2 {
+			return "", goerrors.New("usage: :save <file.gno>")
+		}
+		src, err := r.source()
+		if err != nil {
+			return "", err
+		}
+		return "", os.WriteFile(args[1], []byte(src), 0o644)
+	case ":load":
+		if len(args) != 2 {
+			return "", goerrors.New("usage: :load <file.gno>")
+		}
+		bz, err := os.ReadFile(args[1])
+		if err != nil {
+			return "", err
+		}
+		f, err := parser.ParseFile(token.NewFileSet(), args[1], bz, 0)
+		if err != nil {
+			return "", err
+		}
+		prev := r.save()
+		if err := r.addFile(string(bz), f, true); err != nil {
+			return "", err
+		}
+		return r.eval(prev, false)
+	case ":state":
+		return r.source()
+	case ":help":
+		return replHelp + "\n", nil
+	default:
+		return "", fmt.Errorf("unknown command %q, see :help", cmd)
 	}
+}
 
+// addFile adds the imports and declarations of the parsed file f to
+// the session. If loadMain is set, the body of func main is added as
+// statements instead.
+func (r *replSession) addFile(src string, f *ast.File, loadMain bool) error {
+	text := func(n ast.Node) string {
+		// position offsets are 1-based.
+		return src[int(n.Pos())-1 : int(n.End())-1]
+	}
+	for _, d := range f.Decls {
+		switch d := d.(type) {
+		case *ast.GenDecl:
+			if d.Tok == token.IMPORT {
+				for _, spec := range d.Specs {
+					r.addImport(text(spec))
+				}
+				continue
+			}
+			var names []string
+			for _, spec := range d.Specs {
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					names = append(names, spec.Name.Name)
+				case *ast.ValueSpec:
+					for _, name := range spec.Names {
+						names = append(names, name.Name)
+					}
+				}
+			}
+			r.addDecl(replDecl{names: names, src: text(d)})
+		case *ast.FuncDecl:
+			if loadMain && d.Recv == nil && d.Name.Name == "main" {
+				for _, s := range d.Body.List {
+					r.stmts = append(r.stmts, replStmt{src: text(s)})
+				}
+				continue
+			}
+			name := d.Name.Name
+			if d.Recv != nil && len(d.Recv.List) > 0 {
+				name = replRecvName(d.Recv.List[0].Type) + "." + name
+			}
+			r.addDecl(replDecl{names: []string{name}, src: text(d)})
+		default:
+			return fmt.Errorf("unexpected declaration %T", d)
+		}
+	}
 	return nil
 }
+
+func replRecvName(x ast.Expr) string {
+	switch x := x.(type) {
+	case *ast.StarExpr:
+		return replRecvName(x.X)
+	case *ast.Ident:
+		return x.Name
+	default:
+		return ""
+	}
+}
+
+func (r *replSession) addImport(spec string) {
+	for _, imp := range r.imports {
+		if imp == spec {
+			return
+		}
+	}
+	r.imports = append(r.imports, spec)
+}
+
+// addDecl adds d to the session, replacing any previous declaration of
+// the same names.
+func (r *replSession) addDecl(d replDecl) {
+	decls := r.decls[:0:0]
+	for _, old := range r.decls {
+		if !replNamesOverlap(old.names, d.names) {
+			decls = append(decls, old)
+		}
+	}
+	r.decls = append(decls, d)
+}
+
+func replNamesOverlap(a, b []string) bool {
+	for _, x := range a {
+		for _, y := range b {
+			if x == y {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+type replState struct {
+	imports []string
+	decls   []replDecl
+	stmts   []replStmt
+}
+
+func (r *replSession) save() replState {
+	return replState{
+		imports: append([]string(nil), r.imports...),
+		decls:   append([]replDecl(nil), r.decls...),
+		stmts:   append([]replStmt(nil), r.stmts...),
+	}
+}
+
+func (r *replSession) restore(s replState) {
+	r.imports, r.decls, r.stmts = s.imports, s.decls, s.stmts
+}
+
+// source returns the session as a gno program, with the statements in
+// func main and expressions printed.
+func (r *replSession) source() (string, error) {
+	for _, d := range r.decls {
+		for _, name := range d.names {
+			if name == "main" && len(r.stmts) > 0 {
+				return "", goerrors.New("session declares main and has statements")
+			}
+		}
+	}
+	var b strings.Builder
+	r.writeHeader(&b)
+	if len(r.stmts) > 0 {
+		b.WriteString("func main() {\n")
+		for _, s := range r.stmts {
+			if s.expr {
+				b.WriteString("\tprintln(" + s.src + ")\n")
+			} else {
+				b.WriteString(replIndent(s.src) + "\n")
+			}
+		}
+		b.WriteString("}\n")
+	}
+	return b.String(), nil
+}
+
+func (r *replSession) writeHeader(b *strings.Builder) {
+	b.WriteString("package main\n\n")
+	if len(r.imports) > 0 {
+		b.WriteString("import (\n")
+		for _, imp := range r.imports {
+			b.WriteString("\t" + imp + "\n")
+		}
+		b.WriteString(")\n\n")
+	}
+	for _, d := range r.decls {
+		b.WriteString(d.src + "\n\n")
+	}
+}
+
+func replIndent(src string) string {
+	return "\t" + strings.ReplaceAll(src, "\n", "\n\t")
+}
+
+var errReplPreprocess = goerrors.New("preprocess error")
+
+// eval replays the session. If it fails, the session is restored to
+// prev. If printValues is set, the values of the last expression are
+// appended to the output.
+func (r *replSession) eval(prev replState, printValues bool) (string, error) {
+	var b strings.Builder
+	r.writeHeader(&b)
+	b.WriteString("var " + replValuesVar + " []interface{}\n\n")
+	b.WriteString("func " + replCaptureFunc + "(vs ...interface{}) { " + replValuesVar + " = vs }\n\n")
+	b.WriteString("func " + replMainFunc + "() {\n")
+	for _, s := range r.stmts {
+		if s.expr {
+			b.WriteString("\t" + replCaptureFunc + "(" + s.src + ")\n")
+		} else {
+			b.WriteString(replIndent(s.src) + "\n")
+		}
+	}
+	b.WriteString("}\n")
+
+	out := new(bytes.Buffer)
+	vals, err := r.run(b.String(), out, printValues)
+	res := ""
+	if out.Len() > r.outLen {
+		res = out.String()[r.outLen:]
+	}
+	if err != nil {
+		r.restore(prev)
+		return res, err
+	}
+	r.outLen = out.Len()
+	for _, v := range vals {
+		res += v + "\n"
+	}
+	return res, nil
+}
+
+func (r *replSession) run(src string, out io.Writer, values bool) (vals []string, err error) {
+	store := tests.TestStore(r.rootDir, "", r.stdin, out, r.stderr, tests.ImportModeStdlibsOnly)
+	if r.remote != nil {
+		store = newRemoteStore(store, r.remote)
+	}
+	if r.verbose {
+		store.SetLogStoreOps(true)
+	}
+	m := gno.NewMachineWithOptions(gno.MachineOptions{
+		PkgPath: "main",
+		Output:  out,
+		Store:   store,
+	})
+	defer m.Release()
+
+	n, err := gno.ParseFile("main.gno", src)
+	if err != nil {
+		return nil, replParseError(err, 0)
+	}
+
+	preprocessed := false
+	defer func() {
+		if rr := recover(); rr != nil {
+			if preprocessed {
+				err = fmt.Errorf("%v", rr)
+			} else {
+				err = fmt.Errorf("%w: %v", errReplPreprocess, rr)
+			}
+		}
+	}()
+	m.RunFiles(n)
+	preprocessed = true
+	m.RunStatement(gno.S(gno.Call(gno.X(replMainFunc))))
+	if !values {
+		return nil, nil
+	}
+	num := m.Eval(gno.MustParseExpr("len(" + replValuesVar + ")"))[0].GetInt()
+	for i := 0; i < num; i++ {
+		tv := m.Eval(gno.MustParseExpr(fmt.Sprintf("%s[%d]", replValuesVar, i)))[0]
+		vals = append(vals, tv.String())
+	}
+	return vals, nil
+}
+
+// replParseError strips the generated package header from the line
+// numbers of a parse error.
+func replParseError(err error, offset int) error {
+	var list scanner.ErrorList
+	if !goerrors.As(err, &list) || offset == 0 {
+		return err
+	}
+	msgs := make([]string, 0, len(list))
+	for _, e := range list {
+		msgs = append(msgs, fmt.Sprintf("%d:%d: %s", e.Pos.Line-offset, e.Pos.Column, e.Msg))
+	}
+	return goerrors.New(strings.Join(msgs, "\n"))
+}
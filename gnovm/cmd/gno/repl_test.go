@@ -1,6 +1,12 @@
 package main
 
-import "testing"
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
 
 func TestReplApp(t *testing.T) {
 	tc := []testMainCase{
@@ -11,3 +17,111 @@ func TestReplApp(t *testing.T) {
 	}
 	testMainCaseRun(t, tc)
 }
+
+func TestReplSession(t *testing.T) {
+	r := newReplSession(guessRootDir(), nil, io.Discard, io.Discard)
+
+	cases := []struct {
+		input  string
+		output string
+		err    string
+	}{
+		{input: "x := 1"},
+		{input: "x + 2", output: "(3 int)\n"},
+		{input: `import "strings"`},
+		{input: `strings.ToUpper("abc")`, output: "(\"ABC\" string)\n"},
+		{input: "func add(a, b int) int {\n\treturn a + b\n}"},
+		{input: "add(x, 4)", output: "(5 int)\n"},
+		{input: "func add(a, b int) int { return a * b }"},
+		{input: "add(x, 4)", output: "(4 int)\n"},
+		{input: "func divmod(a, b int) (int, int) { return a / b, a % b }"},
+		{input: "divmod(7, 2)", output: "(3 int)\n(1 int)\n"},
+		{input: `println("hello", x)`, output: "hello 1\n"},
+		{input: "for i := 0; i < 3; i++ {\n\tx += i\n}"},
+		{input: "x", output: "(4 int)\n"},
+		{input: "x := 10"},
+		{input: "x", output: "(10 int)\n"},
+		{input: "type point struct{ X, Y int }"},
+		{input: "point{1, 2}.Y", output: "(2 int)\n"},
+		{input: "y", err: "name y not declared"},
+		{input: "x +", err: "expected operand"},
+		{input: `panic("boom")`, err: "boom"},
+		{input: "x", output: "(10 int)\n"},
+		{input: ":unknown", err: `unknown command ":unknown"`},
+	}
+	for _, c := range cases {
+		out, err := r.Process(c.input)
+		if c.err != "" {
+			if err == nil || !strings.Contains(err.Error(), c.err) {
+				t.Fatalf("%q: expected error containing %q, got %v", c.input, c.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%q: unexpected error: %v", c.input, err)
+		}
+		if out != c.output {
+			t.Fatalf("%q: expected output %q, got %q", c.input, c.output, out)
+		}
+	}
+
+	// save and load the session.
+	file := filepath.Join(t.TempDir(), "session.gno")
+	if _, err := r.Process(":save " + file); err != nil {
+		t.Fatal(err)
+	}
+	bz, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"import (\n\t\"strings\"\n)", "func add(a, b int) int { return a * b }", "func main() {", "\tprintln(x + 2)"} {
+		if !strings.Contains(string(bz), want) {
+			t.Errorf("saved session should contain %q, got:\n%s", want, bz)
+		}
+	}
+
+	if _, err := r.Process(":reset"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Process("x"); err == nil {
+		t.Fatal("expected error after :reset")
+	}
+	if _, err := r.Process(":load " + file); err != nil {
+		t.Fatal(err)
+	}
+	out, err := r.Process("x")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out != "(10 int)\n" {
+		t.Errorf("expected x to be restored after :load, got %q", out)
+	}
+
+	state, err := r.Process(":state")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(state, "package main\n") {
+		t.Errorf("unexpected state %q", state)
+	}
+}
+
+func TestReplNeedsMore(t *testing.T) {
+	cases := []struct {
+		input string
+		more  bool
+	}{
+		{"x := 1", false},
+		{"func f() {", true},
+		{"func f() {\n}", false},
+		{"f(1,\n", true},
+		{"s := `abc", true},
+		{`s := "{"`, false},
+		{"// {", false},
+	}
+	for _, c := range cases {
+		if more := replNeedsMore(c.input); more != c.more {
+			t.Errorf("replNeedsMore(%q) = %v, want %v", c.input, more, c.more)
+		}
+	}
+}
@@ -2,16 +2,25 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
+	"fmt"
+	"net"
+	"os"
 
 	gno "github.com/gnolang/gno/gnovm/pkg/gnolang"
 	"github.com/gnolang/gno/gnovm/tests"
 	"github.com/gnolang/gno/tm2/pkg/commands"
+	"github.com/gnolang/gno/tm2/pkg/std"
 )
 
 type runCfg struct {
-	verbose bool
-	rootDir string
+	verbose   bool
+	rootDir   string
+	debug     bool
+	debugAddr string
+	debugDAP  bool
+	remote    string
 }
 
 func newRunCmd(io *commands.IO) *commands.Command {
@@ -44,6 +53,35 @@ func (c *runCfg) RegisterFlags(fs *flag.FlagSet) {
 		"",
 		"clone location of github.com/gnolang/gno (gnodev tries to guess it)",
 	)
+
+	fs.BoolVar(
+		&c.debug,
+		"debug",
+		false,
+		"enable interactive debugger using stdin and stdout",
+	)
+
+	fs.StringVar(
+		&c.debugAddr,
+		"debug-addr",
+		"",
+		"enable interactive debugger using tcp address in the form [host]:port",
+	)
+
+	fs.BoolVar(
+		&c.debugDAP,
+		"debug-dap",
+		false,
+		"use the Debug Adapter Protocol of editors with -debug-addr, instead of text commands",
+	)
+
+	fs.StringVar(
+		&c.remote,
+		"remote",
+		"",
+		"run against the state of a gnoland node at the given rpc address",
+	)
+
 }
 
 func execRun(cfg *runCfg, args []string, io *commands.IO) error {
@@ -63,27 +101,92 @@ func execRun(cfg *runCfg, args []string, io *commands.IO) error {
 	testStore := tests.TestStore(cfg.rootDir,
 		"", stdin, stdout, stderr,
 		tests.ImportModeStdlibsPreferred)
+	if cfg.remote != "" {
+		testStore = newRemoteStore(testStore, newRemoteQuerier(cfg.remote))
+	}
 	if cfg.verbose {
 		testStore.SetLogStoreOps(true)
 	}
 
+	var debugger *gno.Debugger
+	if cfg.debugAddr != "" {
+		conn, err := acceptDebugClient(cfg.debugAddr, io)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+		if cfg.debugDAP {
+			debugger = gno.NewDAPDebugger(conn)
+		} else {
+			debugger = gno.NewDebugger(conn, conn)
+		}
+		defer debugger.End()
+	} else if cfg.debugDAP {
+		return errors.New("-debug-dap requires -debug-addr")
+	} else if cfg.debug {
+		debugger = gno.NewDebugger(stdin, stdout)
+	}
+
 	m := gno.NewMachineWithOptions(gno.MachineOptions{
-		PkgPath: "main",
-		Output:  stdout,
-		Store:   testStore,
+		PkgPath:  "main",
+		Output:   stdout,
+		Store:    testStore,
+		Debugger: debugger,
 	})
 
 	defer m.Release()
 
 	// read files
+	memPkg := &std.MemPackage{Name: "main", Path: "main"}
 	files := make([]*gno.FileNode, len(args))
 	for i, fname := range args {
-		files[i] = gno.MustReadFile(fname)
+		bz, err := os.ReadFile(fname)
+		if err != nil {
+			panic(err)
+		}
+		memPkg.Files = append(memPkg.Files, &std.MemFile{Name: fname, Body: string(bz)})
+		files[i] = gno.MustParseFile(fname, string(bz))
+	}
+	if debugger != nil {
+		debugger.AddMemPackage(memPkg)
 	}
 
-	// run files
-	m.RunFiles(files...)
-	m.RunMain()
+	// run files, unless the user quits the debugger.
+	runUntilDebuggerQuit(func() {
+		m.RunFiles(files...)
+		m.RunMain()
+	})
 
 	return nil
 }
+
+// runUntilDebuggerQuit calls fn, and returns true if it was stopped
+// because the user quit the debugger.
+func runUntilDebuggerQuit(fn func()) (quit bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			if r != gno.ErrDebuggerQuit {
+				panic(r)
+			}
+			quit = true
+		}
+	}()
+	fn()
+	return false
+}
+
+// acceptDebugClient waits for a single debugger client to connect at addr.
+func acceptDebugClient(addr string, io *commands.IO) (net.Conn, error) {
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("unable to listen for debugger: %w", err)
+	}
+	defer l.Close()
+	io.ErrPrintfln("Waiting for debugger client to connect at %s", l.Addr())
+	conn, err := l.Accept()
+	if err != nil {
+		return nil, fmt.Errorf("unable to accept debugger client: %w", err)
+	}
+	io.ErrPrintfln("Debugger client connected from %s", conn.RemoteAddr())
+	return conn, nil
+}
@@ -30,6 +30,9 @@ type testCfg struct {
 	timeout           time.Duration
 	precompile        bool // TODO: precompile should be the default, but it needs to automatically precompile dependencies in memory.
 	updateGoldenTests bool
+	debug             bool
+	debugAddr         string
+	debugDAP          bool
 }
 
 func newTestCmd(io *commands.IO) *commands.Command {
@@ -90,6 +93,27 @@ func (c *testCfg) RegisterFlags(fs *flag.FlagSet) {
 		0,
 		"max execution time",
 	)
+
+	fs.BoolVar(
+		&c.debug,
+		"debug",
+		false,
+		"enable interactive debugger of unit tests using stdin and stdout",
+	)
+
+	fs.StringVar(
+		&c.debugAddr,
+		"debug-addr",
+		"",
+		"enable interactive debugger of unit tests using tcp address in the form [host]:port",
+	)
+
+	fs.BoolVar(
+		&c.debugDAP,
+		"debug-dap",
+		false,
+		"use the Debug Adapter Protocol of editors with -debug-addr, instead of text commands",
+	)
 }
 
 func execTest(cfg *testCfg, args []string, io *commands.IO) error {
@@ -117,6 +141,25 @@ func execTest(cfg *testCfg, args []string, io *commands.IO) error {
 		cfg.rootDir = guessRootDir()
 	}
 
+	var debugger *gno.Debugger
+	if cfg.debugAddr != "" {
+		conn, err := acceptDebugClient(cfg.debugAddr, io)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+		if cfg.debugDAP {
+			debugger = gno.NewDAPDebugger(conn)
+		} else {
+			debugger = gno.NewDebugger(conn, conn)
+		}
+		defer debugger.End()
+	} else if cfg.debugDAP {
+		return errors.New("-debug-dap requires -debug-addr")
+	} else if cfg.debug {
+		debugger = gno.NewDebugger(io.In, io.Out)
+	}
+
 	pkgPaths, err := gnoPackagesFromArgs(args)
 	if err != nil {
 		return fmt.Errorf("list packages from args: %w", err)
@@ -186,10 +229,15 @@ func execTest(cfg *testCfg, args []string, io *commands.IO) error {
 		sort.Strings(filetestFiles)
 
 		startedAt := time.Now()
-		err = gnoTestPkg(pkgPath, unittestFiles, filetestFiles, cfg, io)
+		err = gnoTestPkg(pkgPath, unittestFiles, filetestFiles, cfg, debugger, io)
 		duration := time.Since(startedAt)
 		dstr := fmtDuration(duration)
 
+		if err == gno.ErrDebuggerQuit {
+			// the user quit the debugger: skip the remaining tests.
+			return nil
+		}
+
 		if err != nil {
 			io.ErrPrintfln("%s: test pkg: %v", pkgPath, err)
 			io.ErrPrintfln("FAIL")
@@ -213,6 +261,7 @@ func gnoTestPkg(
 	unittestFiles,
 	filetestFiles []string,
 	cfg *testCfg,
+	debugger *gno.Debugger,
 	io *commands.IO,
 ) error {
 	verbose := cfg.verbose
@@ -247,28 +296,38 @@ func gnoTestPkg(
 
 		// tfiles, ifiles := gno.ParseMemPackageTests(memPkg)
 		tfiles, ifiles := parseMemPackageTests(memPkg)
-
-		// run test files in pkg
-		{
-			m := tests.TestMachine(testStore, stdout, "main")
-			m.RunMemPackage(memPkg, true)
-			err := runTestFiles(m, tfiles, memPkg.Name, verbose, runFlag, io)
-			if err != nil {
-				errs = multierr.Append(errs, err)
-			}
+		if debugger != nil {
+			debugger.AddMemPackage(memPkg)
 		}
 
-		// run test files in xxx_test pkg
-		{
-			testPkgName := getPkgNameFromFileset(ifiles)
-			if testPkgName != "" {
-				m := tests.TestMachine(testStore, stdout, testPkgName)
+		quit := runUntilDebuggerQuit(func() {
+			// run test files in pkg
+			{
+				m := tests.TestMachine(testStore, stdout, "main")
+				m.Debugger = debugger
 				m.RunMemPackage(memPkg, true)
-				err := runTestFiles(m, ifiles, testPkgName, verbose, runFlag, io)
+				err := runTestFiles(m, tfiles, memPkg.Name, verbose, runFlag, io)
 				if err != nil {
 					errs = multierr.Append(errs, err)
 				}
 			}
+
+			// run test files in xxx_test pkg
+			{
+				testPkgName := getPkgNameFromFileset(ifiles)
+				if testPkgName != "" {
+					m := tests.TestMachine(testStore, stdout, testPkgName)
+					m.Debugger = debugger
+					m.RunMemPackage(memPkg, true)
+					err := runTestFiles(m, ifiles, testPkgName, verbose, runFlag, io)
+					if err != nil {
+						errs = multierr.Append(errs, err)
+					}
+				}
+			}
+		})
+		if quit {
+			return gno.ErrDebuggerQuit
 		}
 	}
 
@@ -8,6 +8,7 @@ import (
 	"log"
 	"os"
 	"os/exec"
+	"path"
 	"path/filepath"
 	"strings"
 	"time"
@@ -234,3 +235,30 @@ func copyFile(src, dst string) error {
 
 	return nil
 }
+
+// guessPkgPath returns the package path of pkgDir, relative to the module
+// path in the nearest gno.mod, or else guessed from a gno.land/ directory
+// (as in examples/). It falls back to pkgDir itself, like gno test.
+func guessPkgPath(pkgDir string) string {
+	abs, err := filepath.Abs(pkgDir)
+	if err != nil {
+		return pkgDir
+	}
+	for dir := abs; ; dir = filepath.Dir(dir) {
+		if gnoMod, err := readGnoMod(dir); err == nil && gnoMod.Module != nil {
+			rel, err := filepath.Rel(dir, abs)
+			if err != nil {
+				break
+			}
+			return path.Join(gnoMod.Module.Mod.Path, filepath.ToSlash(rel))
+		}
+		if filepath.Dir(dir) == dir {
+			break
+		}
+	}
+	slashed := filepath.ToSlash(abs)
+	if i := strings.Index(slashed, "/gno.land/"); i >= 0 {
+		return slashed[i+1:]
+	}
+	return pkgDir
+}
@@ -0,0 +1,327 @@
+// Package doc extracts the documentation of gno packages, from the exported
+// declarations of their source files and the comments attached to them.
+package doc
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	godoc "go/doc"
+	"go/doc/comment"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io"
+	"strings"
+
+	"github.com/gnolang/gno/tm2/pkg/std"
+)
+
+// Package is the documentation of a gno package.
+type Package struct {
+	Name       string
+	ImportPath string
+	Doc        string
+	Consts     []*Value
+	Vars       []*Value
+	Funcs      []*Func
+	Types      []*Type
+}
+
+// Value is the documentation of a const or var declaration, which may
+// declare several names.
+type Value struct {
+	Names []string
+	Doc   string
+	Decl  string
+}
+
+// Func is the documentation of a function or method.
+type Func struct {
+	Name string
+	Recv string // empty for functions.
+	Doc  string
+	Decl string
+}
+
+// Type is the documentation of a type, with the constants, variables,
+// constructors and methods associated to it.
+type Type struct {
+	Name    string
+	Doc     string
+	Decl    string
+	Consts  []*Value
+	Vars    []*Value
+	Funcs   []*Func
+	Methods []*Func
+}
+
+// New returns the documentation of the exported declarations of memPkg.
+// Test files are ignored.
+func New(memPkg *std.MemPackage) (*Package, error) {
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for _, mfile := range memPkg.Files {
+		if !strings.HasSuffix(mfile.Name, ".gno") ||
+			strings.HasSuffix(mfile.Name, "_test.gno") ||
+			strings.HasSuffix(mfile.Name, "_filetest.gno") {
+			continue
+		}
+		// go/doc only accepts .go files.
+		f, err := parser.ParseFile(fset, mfile.Name+".go", mfile.Body, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, f)
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no gno files in package %s", memPkg.Path)
+	}
+	dpkg, err := godoc.NewFromFiles(fset, files, memPkg.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Package{
+		Name:       dpkg.Name,
+		ImportPath: dpkg.ImportPath,
+		Doc:        dpkg.Doc,
+		Consts:     newValues(fset, dpkg.Consts),
+		Vars:       newValues(fset, dpkg.Vars),
+		Funcs:      newFuncs(fset, dpkg.Funcs),
+	}
+	for _, dt := range dpkg.Types {
+		decl := *dt.Decl
+		decl.Doc = nil
+		p.Types = append(p.Types, &Type{
+			Name:    dt.Name,
+			Doc:     dt.Doc,
+			Decl:    formatDecl(fset, &decl),
+			Consts:  newValues(fset, dt.Consts),
+			Vars:    newValues(fset, dt.Vars),
+			Funcs:   newFuncs(fset, dt.Funcs),
+			Methods: newFuncs(fset, dt.Methods),
+		})
+	}
+	return p, nil
+}
+
+func newValues(fset *token.FileSet, dvals []*godoc.Value) []*Value {
+	vals := make([]*Value, 0, len(dvals))
+	for _, dv := range dvals {
+		decl := *dv.Decl
+		decl.Doc = nil
+		vals = append(vals, &Value{
+			Names: dv.Names,
+			Doc:   dv.Doc,
+			Decl:  formatDecl(fset, &decl),
+		})
+	}
+	return vals
+}
+
+func newFuncs(fset *token.FileSet, dfuncs []*godoc.Func) []*Func {
+	funcs := make([]*Func, 0, len(dfuncs))
+	for _, df := range dfuncs {
+		// only keep the signature.
+		decl := *df.Decl
+		decl.Doc = nil
+		decl.Body = nil
+		funcs = append(funcs, &Func{
+			Name: df.Name,
+			Recv: strings.TrimPrefix(df.Recv, "*"),
+			Doc:  df.Doc,
+			Decl: formatDecl(fset, &decl),
+		})
+	}
+	return funcs
+}
+
+func formatDecl(fset *token.FileSet, decl ast.Decl) string {
+	var buf bytes.Buffer
+	cfg := printer.Config{Mode: printer.UseSpaces | printer.TabIndent, Tabwidth: 8}
+	if err := cfg.Fprint(&buf, fset, decl); err != nil {
+		// decls come from parsed files, so this can't happen.
+		panic(err)
+	}
+	return buf.String()
+}
+
+// Text formats a doc comment as plain text, for terminals.
+func Text(doc string) string {
+	var p comment.Parser
+	pr := comment.Printer{TextWidth: 76}
+	return string(pr.Text(p.Parse(doc)))
+}
+
+// HTML formats a doc comment as HTML.
+func HTML(doc string) string {
+	var p comment.Parser
+	var pr comment.Printer
+	return string(pr.HTML(p.Parse(doc)))
+}
+
+// WriteText writes a summary of the package documentation to w: the
+// package comment, then the declarations of exported constants,
+// variables, functions and types, in a format similar to `go doc`.
+func (p *Package) WriteText(w io.Writer) error {
+	tw := &textWriter{w: w}
+	tw.printf("package %s // import %q\n", p.Name, p.ImportPath)
+	if p.Doc != "" {
+		tw.printf("\n%s", Text(p.Doc))
+	}
+	section := func(decls []string) {
+		if len(decls) == 0 {
+			return
+		}
+		tw.printf("\n")
+		for _, decl := range decls {
+			tw.printf("%s\n", decl)
+		}
+	}
+	section(valueDecls(p.Consts))
+	section(valueDecls(p.Vars))
+	section(funcDecls(p.Funcs))
+	var types []string
+	for _, t := range p.Types {
+		types = append(types, typeSummary(t.Decl))
+		for _, decl := range valueDecls(t.Consts) {
+			types = append(types, indent(decl))
+		}
+		for _, decl := range valueDecls(t.Vars) {
+			types = append(types, indent(decl))
+		}
+		for _, decl := range funcDecls(t.Funcs) {
+			types = append(types, indent(decl))
+		}
+		for _, decl := range funcDecls(t.Methods) {
+			types = append(types, indent(decl))
+		}
+	}
+	section(types)
+	return tw.err
+}
+
+// WriteSymbolText writes the documentation of symbol to w. The symbol is
+// the name of an exported constant, variable, function or type, or of a
+// method, as "Type.Method".
+func (p *Package) WriteSymbolText(w io.Writer, symbol string) error {
+	tw := &textWriter{w: w}
+	writeDecl := func(decl, doc string) {
+		tw.printf("%s\n", decl)
+		if doc != "" {
+			tw.printf("%s", indent(Text(doc)))
+		}
+	}
+
+	typName, methName, isMethod := strings.Cut(symbol, ".")
+	for _, t := range p.Types {
+		if t.Name != typName {
+			continue
+		}
+		if isMethod {
+			for _, f := range t.Methods {
+				if f.Name == methName {
+					writeDecl(f.Decl, f.Doc)
+					return tw.err
+				}
+			}
+			return fmt.Errorf("no method %s on type %s in package %s", methName, typName, p.ImportPath)
+		}
+		writeDecl(t.Decl, t.Doc)
+		var decls []string
+		decls = append(decls, valueDecls(t.Consts)...)
+		decls = append(decls, valueDecls(t.Vars)...)
+		decls = append(decls, funcDecls(t.Funcs)...)
+		decls = append(decls, funcDecls(t.Methods)...)
+		if len(decls) > 0 {
+			tw.printf("\n%s\n", strings.Join(decls, "\n"))
+		}
+		return tw.err
+	}
+	if !isMethod {
+		for _, f := range p.Funcs {
+			if f.Name == symbol {
+				writeDecl(f.Decl, f.Doc)
+				return tw.err
+			}
+		}
+		for _, vals := range [][]*Value{p.Consts, p.Vars} {
+			for _, v := range vals {
+				for _, name := range v.Names {
+					if name == symbol {
+						writeDecl(v.Decl, v.Doc)
+						return tw.err
+					}
+				}
+			}
+		}
+		for _, t := range p.Types {
+			for _, vals := range [][]*Value{t.Consts, t.Vars} {
+				for _, v := range vals {
+					for _, name := range v.Names {
+						if name == symbol {
+							writeDecl(v.Decl, v.Doc)
+							return tw.err
+						}
+					}
+				}
+			}
+			for _, f := range t.Funcs {
+				if f.Name == symbol {
+					writeDecl(f.Decl, f.Doc)
+					return tw.err
+				}
+			}
+		}
+	}
+	return fmt.Errorf("no symbol %s in package %s", symbol, p.ImportPath)
+}
+
+func valueDecls(vals []*Value) []string {
+	decls := make([]string, len(vals))
+	for i, v := range vals {
+		decls[i] = v.Decl
+	}
+	return decls
+}
+
+func funcDecls(funcs []*Func) []string {
+	decls := make([]string, len(funcs))
+	for i, f := range funcs {
+		decls[i] = f.Decl
+	}
+	return decls
+}
+
+// typeSummary returns the first line of a type declaration, with the
+// body of struct and interface types elided.
+func typeSummary(decl string) string {
+	first, _, multiline := strings.Cut(decl, "\n")
+	if multiline && strings.HasSuffix(first, "{") {
+		return first + " ... }"
+	}
+	return first
+}
+
+func indent(s string) string {
+	lines := strings.SplitAfter(s, "\n")
+	for i, line := range lines {
+		if line != "" && line != "\n" {
+			lines[i] = "    " + line
+		}
+	}
+	return strings.Join(lines, "")
+}
+
+// textWriter keeps the first write error, for printing in sequence.
+type textWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (tw *textWriter) printf(format string, args ...interface{}) {
+	if tw.err == nil {
+		_, tw.err = fmt.Fprintf(tw.w, format, args...)
+	}
+}
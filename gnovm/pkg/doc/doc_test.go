@@ -0,0 +1,155 @@
+package doc
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/gnolang/gno/tm2/pkg/std"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testPkg = &std.MemPackage{
+	Name: "counter",
+	Path: "gno.land/p/demo/counter",
+	Files: []*std.MemFile{
+		{
+			Name: "counter.gno",
+			Body: `// Package counter counts things.
+package counter
+
+// Max is the maximum count.
+const Max = 10
+
+// ErrMax is returned when the maximum is reached.
+var ErrMax = error(nil)
+
+// Counter counts.
+type Counter struct {
+	// N is the count.
+	N    int
+	seen map[string]bool
+}
+
+// New returns a new Counter.
+func New() *Counter {
+	return &Counter{}
+}
+
+// Incr increments the counter.
+func (c *Counter) Incr() int {
+	c.N++
+	return c.N
+}
+
+func (c *Counter) reset() {}
+
+// Sum returns the sum of counters.
+func Sum(cs ...*Counter) int {
+	return 0
+}
+
+func helper() {}
+`,
+		},
+		{
+			Name: "counter_test.gno",
+			Body: "package counter\n\nfunc TestCounter() {}\n",
+		},
+		{Name: "README.md", Body: "# counter"},
+	},
+}
+
+func TestNew(t *testing.T) {
+	p, err := New(testPkg)
+	require.NoError(t, err)
+
+	assert.Equal(t, "counter", p.Name)
+	assert.Equal(t, "gno.land/p/demo/counter", p.ImportPath)
+	assert.Equal(t, "Package counter counts things.\n", p.Doc)
+
+	require.Len(t, p.Consts, 1)
+	assert.Equal(t, []string{"Max"}, p.Consts[0].Names)
+	assert.Equal(t, "const Max = 10", p.Consts[0].Decl)
+	require.Len(t, p.Vars, 1)
+	assert.Equal(t, "ErrMax is returned when the maximum is reached.\n", p.Vars[0].Doc)
+
+	// unexported and test functions are ignored.
+	require.Len(t, p.Funcs, 1)
+	assert.Equal(t, "func Sum(cs ...*Counter) int", p.Funcs[0].Decl)
+
+	require.Len(t, p.Types, 1)
+	typ := p.Types[0]
+	assert.Equal(t, "Counter", typ.Name)
+	assert.Equal(t, "type Counter struct {\n\t// N is the count.\n\tN int\n\t// contains filtered or unexported fields\n}", typ.Decl)
+	require.Len(t, typ.Funcs, 1)
+	assert.Equal(t, "New", typ.Funcs[0].Name)
+	require.Len(t, typ.Methods, 1)
+	assert.Equal(t, "Counter", typ.Methods[0].Recv)
+	assert.Equal(t, "func (c *Counter) Incr() int", typ.Methods[0].Decl)
+	assert.Equal(t, "Incr increments the counter.\n", typ.Methods[0].Doc)
+}
+
+func TestNewNoFiles(t *testing.T) {
+	_, err := New(&std.MemPackage{Name: "empty", Path: "gno.land/p/demo/empty"})
+	assert.Error(t, err)
+}
+
+func TestWriteText(t *testing.T) {
+	p, err := New(testPkg)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, p.WriteText(&buf))
+	assert.Equal(t, `package counter // import "gno.land/p/demo/counter"
+
+Package counter counts things.
+
+const Max = 10
+
+var ErrMax = error(nil)
+
+func Sum(cs ...*Counter) int
+
+type Counter struct { ... }
+    func New() *Counter
+    func (c *Counter) Incr() int
+`, buf.String())
+}
+
+func TestWriteSymbolText(t *testing.T) {
+	p, err := New(testPkg)
+	require.NoError(t, err)
+
+	cases := []struct {
+		symbol string
+		want   string
+		err    string
+	}{
+		{symbol: "Sum", want: "func Sum(cs ...*Counter) int\n    Sum returns the sum of counters.\n"},
+		{symbol: "Max", want: "const Max = 10\n    Max is the maximum count.\n"},
+		{symbol: "New", want: "func New() *Counter\n    New returns a new Counter.\n"},
+		{symbol: "Counter.Incr", want: "func (c *Counter) Incr() int\n    Incr increments the counter.\n"},
+		{symbol: "Counter", want: "type Counter struct {\n\t// N is the count.\n\tN int\n\t// contains filtered or unexported fields\n}\n    Counter counts.\n\nfunc New() *Counter\nfunc (c *Counter) Incr() int\n"},
+		{symbol: "helper", err: "no symbol helper in package gno.land/p/demo/counter"},
+		{symbol: "Counter.reset", err: "no method reset on type Counter"},
+	}
+	for _, c := range cases {
+		t.Run(c.symbol, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := p.WriteSymbolText(&buf, c.symbol)
+			if c.err != "" {
+				require.Error(t, err)
+				assert.True(t, strings.Contains(err.Error(), c.err), err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, c.want, buf.String())
+		})
+	}
+}
+
+func TestHTML(t *testing.T) {
+	assert.Equal(t, "<p>Escapes &lt;b&gt; tags.\n", HTML("Escapes <b> tags.\n"))
+}
@@ -77,6 +77,15 @@ func (alloc *Allocator) Status() (maxBytes int64, bytes int64) {
 	return alloc.maxBytes, alloc.bytes
 }
 
+// SetMaxBytes updates the allocation limit, e.g. when it is
+// changed by on-chain parameters.
+func (alloc *Allocator) SetMaxBytes(maxBytes int64) {
+	if alloc == nil {
+		return
+	}
+	alloc.maxBytes = maxBytes
+}
+
 func (alloc *Allocator) Reset() *Allocator {
 	if alloc == nil {
 		return nil
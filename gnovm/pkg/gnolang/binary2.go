@@ -0,0 +1,10562 @@
+// Code generated by gengo. DO NOT EDIT.
+
+package gnolang
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/gnolang/gno/tm2/pkg/amino"
+)
+
+func (goo TypedValue) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field T (#1)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 0); err != nil {
+		return
+	}
+	// Field V (#2)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 1); err != nil {
+		return
+	}
+	// Field N (#3)
+	if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3ByteLength); err != nil {
+		return
+	}
+	if err = amino.EncodeByteSlice(buf, goo.N[:]); err != nil {
+		return
+	}
+	return nil
+}
+
+func (goo *TypedValue) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field T (#1)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 0, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field V (#2)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 1, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field N (#3)
+	if len(bz) == 0 {
+		goo.N = [8]uint8{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.N = [8]uint8{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of gnolang.TypedValue, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 3 of gnolang.TypedValue, got %v", amino.Typ3ByteLength, typ)
+		}
+		if len(bz) < 8 {
+			return fmt.Errorf("insufficient bytes to decode [8]byte")
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if len(v) != 8 {
+			return fmt.Errorf("mismatched byte array length: Expected 8, got %v", len(v))
+		}
+		copy(goo.N[:], v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo PointerValue) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field TV (#1)
+	if goo.TV != nil {
+		buf2 := new(bytes.Buffer)
+		if err = goo.TV.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	// Field Base (#2)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 1); err != nil {
+		return
+	}
+	// Field Index (#3)
+	if goo.Index != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Index)); err != nil {
+			return
+		}
+	}
+	// Field Key (#4)
+	if goo.Key != nil {
+		buf2 := new(bytes.Buffer)
+		if err = goo.Key.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 4, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *PointerValue) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field TV (#1)
+	if len(bz) == 0 {
+		goo.TV = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.TV = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.PointerValue, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.PointerValue, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if goo.TV == nil {
+			goo.TV = new(TypedValue)
+		}
+		if err := goo.TV.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Base (#2)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 1, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Index (#3)
+	if len(bz) == 0 {
+		goo.Index = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.Index = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of gnolang.PointerValue, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 3 of gnolang.PointerValue, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Index = int(v)
+	}
+	// Field Key (#4)
+	if len(bz) == 0 {
+		goo.Key = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 4 {
+		goo.Key = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 4 {
+			return fmt.Errorf("expected field # 4 of gnolang.PointerValue, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 4 of gnolang.PointerValue, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if goo.Key == nil {
+			goo.Key = new(TypedValue)
+		}
+		if err := goo.Key.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo ArrayValue) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field ObjectInfo (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.ObjectInfo.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field List (#2)
+	for _, e := range goo.List {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		buf2 := new(bytes.Buffer)
+		if err = e.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	// Field Data (#3)
+	if len(goo.Data) != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, goo.Data); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *ArrayValue) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field ObjectInfo (#1)
+	if len(bz) == 0 {
+		goo.ObjectInfo = ObjectInfo{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.ObjectInfo = ObjectInfo{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.ArrayValue, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.ArrayValue, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.ObjectInfo.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field List (#2)
+	if len(bz) == 0 {
+		goo.List = nil
+	} else if fnum, _, _, err := amino.DecodeFieldNumberAndTyp3(bz); err != nil {
+		return err
+	} else if fnum <= 2 {
+		var list []TypedValue
+		for len(bz) > 0 {
+			fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz)
+			if fnum > 2 {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			if fnum < 2 {
+				return fmt.Errorf("expected repeated field number 2 or greater, got %v", fnum)
+			}
+			if typ != amino.Typ3ByteLength {
+				return fmt.Errorf("expected repeated field type %v, got %v", amino.Typ3ByteLength, typ)
+			}
+			if len(bz) > 0 && bz[0] == 0x00 {
+				bz = bz[1:]
+				list = append(list, TypedValue{})
+				continue
+			}
+			v, n, err := amino.DecodeByteSlice(bz)
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			var e TypedValue
+			if err := e.UnmarshalBinary2(cdc, v); err != nil {
+				return err
+			}
+			list = append(list, e)
+		}
+		goo.List = list
+	}
+	// Field Data (#3)
+	if len(bz) == 0 {
+		goo.Data = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.Data = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of gnolang.ArrayValue, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 3 of gnolang.ArrayValue, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if len(v) == 0 {
+			goo.Data = nil
+		} else {
+			goo.Data = []uint8(v)
+		}
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo SliceValue) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Base (#1)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 0); err != nil {
+		return
+	}
+	// Field Offset (#2)
+	if goo.Offset != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Offset)); err != nil {
+			return
+		}
+	}
+	// Field Length (#3)
+	if goo.Length != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Length)); err != nil {
+			return
+		}
+	}
+	// Field Maxcap (#4)
+	if goo.Maxcap != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 4, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Maxcap)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *SliceValue) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Base (#1)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 0, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Offset (#2)
+	if len(bz) == 0 {
+		goo.Offset = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.Offset = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of gnolang.SliceValue, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 2 of gnolang.SliceValue, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Offset = int(v)
+	}
+	// Field Length (#3)
+	if len(bz) == 0 {
+		goo.Length = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.Length = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of gnolang.SliceValue, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 3 of gnolang.SliceValue, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Length = int(v)
+	}
+	// Field Maxcap (#4)
+	if len(bz) == 0 {
+		goo.Maxcap = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 4 {
+		goo.Maxcap = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 4 {
+			return fmt.Errorf("expected field # 4 of gnolang.SliceValue, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 4 of gnolang.SliceValue, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Maxcap = int(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo StructValue) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field ObjectInfo (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.ObjectInfo.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Fields (#2)
+	for _, e := range goo.Fields {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		buf2 := new(bytes.Buffer)
+		if err = e.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *StructValue) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field ObjectInfo (#1)
+	if len(bz) == 0 {
+		goo.ObjectInfo = ObjectInfo{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.ObjectInfo = ObjectInfo{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.StructValue, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.StructValue, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.ObjectInfo.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Fields (#2)
+	if len(bz) == 0 {
+		goo.Fields = nil
+	} else if fnum, _, _, err := amino.DecodeFieldNumberAndTyp3(bz); err != nil {
+		return err
+	} else if fnum <= 2 {
+		var list []TypedValue
+		for len(bz) > 0 {
+			fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz)
+			if fnum > 2 {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			if fnum < 2 {
+				return fmt.Errorf("expected repeated field number 2 or greater, got %v", fnum)
+			}
+			if typ != amino.Typ3ByteLength {
+				return fmt.Errorf("expected repeated field type %v, got %v", amino.Typ3ByteLength, typ)
+			}
+			if len(bz) > 0 && bz[0] == 0x00 {
+				bz = bz[1:]
+				list = append(list, TypedValue{})
+				continue
+			}
+			v, n, err := amino.DecodeByteSlice(bz)
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			var e TypedValue
+			if err := e.UnmarshalBinary2(cdc, v); err != nil {
+				return err
+			}
+			list = append(list, e)
+		}
+		goo.Fields = list
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo FuncValue) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Type (#1)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 0); err != nil {
+		return
+	}
+	// Field IsMethod (#2)
+	if goo.IsMethod {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeBool(buf, bool(goo.IsMethod)); err != nil {
+			return
+		}
+	}
+	// Field Source (#3)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 2); err != nil {
+		return
+	}
+	// Field Name (#4)
+	if goo.Name != "" {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 4, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(goo.Name)); err != nil {
+			return
+		}
+	}
+	// Field Closure (#5)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 4); err != nil {
+		return
+	}
+	// Field FileName (#6)
+	if goo.FileName != "" {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 6, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(goo.FileName)); err != nil {
+			return
+		}
+	}
+	// Field PkgPath (#7)
+	if goo.PkgPath != "" {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 7, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(goo.PkgPath)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *FuncValue) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Type (#1)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 0, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field IsMethod (#2)
+	if len(bz) == 0 {
+		goo.IsMethod = false
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.IsMethod = false
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of gnolang.FuncValue, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 2 of gnolang.FuncValue, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeBool(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.IsMethod = bool(v)
+	}
+	// Field Source (#3)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 2, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Name (#4)
+	if len(bz) == 0 {
+		goo.Name = ""
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 4 {
+		goo.Name = ""
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 4 {
+			return fmt.Errorf("expected field # 4 of gnolang.FuncValue, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 4 of gnolang.FuncValue, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Name = Name(v)
+	}
+	// Field Closure (#5)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 4, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field FileName (#6)
+	if len(bz) == 0 {
+		goo.FileName = ""
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 6 {
+		goo.FileName = ""
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 6 {
+			return fmt.Errorf("expected field # 6 of gnolang.FuncValue, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 6 of gnolang.FuncValue, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.FileName = Name(v)
+	}
+	// Field PkgPath (#7)
+	if len(bz) == 0 {
+		goo.PkgPath = ""
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 7 {
+		goo.PkgPath = ""
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 7 {
+			return fmt.Errorf("expected field # 7 of gnolang.FuncValue, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 7 of gnolang.FuncValue, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.PkgPath = string(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo MapValue) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field ObjectInfo (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.ObjectInfo.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field List (#2)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 1); err != nil {
+		return
+	}
+	return nil
+}
+
+func (goo *MapValue) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field ObjectInfo (#1)
+	if len(bz) == 0 {
+		goo.ObjectInfo = ObjectInfo{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.ObjectInfo = ObjectInfo{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.MapValue, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.MapValue, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.ObjectInfo.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field List (#2)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 1, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo MapListItem) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field Key (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Key.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Value (#2)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Value.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	return nil
+}
+
+func (goo *MapListItem) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field Key (#1)
+	if len(bz) == 0 {
+		goo.Key = TypedValue{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Key = TypedValue{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.MapListItem, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.MapListItem, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Key.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Value (#2)
+	if len(bz) == 0 {
+		goo.Value = TypedValue{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.Value = TypedValue{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of gnolang.MapListItem, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of gnolang.MapListItem, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Value.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo BoundMethodValue) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field ObjectInfo (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.ObjectInfo.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Func (#2)
+	if goo.Func != nil {
+		buf2 := new(bytes.Buffer)
+		if err = goo.Func.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	// Field Receiver (#3)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Receiver.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	return nil
+}
+
+func (goo *BoundMethodValue) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field ObjectInfo (#1)
+	if len(bz) == 0 {
+		goo.ObjectInfo = ObjectInfo{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.ObjectInfo = ObjectInfo{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.BoundMethodValue, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.BoundMethodValue, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.ObjectInfo.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Func (#2)
+	if len(bz) == 0 {
+		goo.Func = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.Func = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of gnolang.BoundMethodValue, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of gnolang.BoundMethodValue, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if goo.Func == nil {
+			goo.Func = new(FuncValue)
+		}
+		if err := goo.Func.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Receiver (#3)
+	if len(bz) == 0 {
+		goo.Receiver = TypedValue{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.Receiver = TypedValue{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of gnolang.BoundMethodValue, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 3 of gnolang.BoundMethodValue, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Receiver.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo TypeValue) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Type (#1)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 0); err != nil {
+		return
+	}
+	return nil
+}
+
+func (goo *TypeValue) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Type (#1)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 0, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo PackageValue) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field ObjectInfo (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.ObjectInfo.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Block (#2)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 1); err != nil {
+		return
+	}
+	// Field PkgName (#3)
+	if goo.PkgName != "" {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(goo.PkgName)); err != nil {
+			return
+		}
+	}
+	// Field PkgPath (#4)
+	if goo.PkgPath != "" {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 4, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(goo.PkgPath)); err != nil {
+			return
+		}
+	}
+	// Field FNames (#5)
+	for _, e := range goo.FNames {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 5, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(e)); err != nil {
+			return
+		}
+	}
+	// Field FBlocks (#6)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 5); err != nil {
+		return
+	}
+	return nil
+}
+
+func (goo *PackageValue) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field ObjectInfo (#1)
+	if len(bz) == 0 {
+		goo.ObjectInfo = ObjectInfo{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.ObjectInfo = ObjectInfo{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.PackageValue, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.PackageValue, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.ObjectInfo.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Block (#2)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 1, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field PkgName (#3)
+	if len(bz) == 0 {
+		goo.PkgName = ""
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.PkgName = ""
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of gnolang.PackageValue, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 3 of gnolang.PackageValue, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.PkgName = Name(v)
+	}
+	// Field PkgPath (#4)
+	if len(bz) == 0 {
+		goo.PkgPath = ""
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 4 {
+		goo.PkgPath = ""
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 4 {
+			return fmt.Errorf("expected field # 4 of gnolang.PackageValue, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 4 of gnolang.PackageValue, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.PkgPath = string(v)
+	}
+	// Field FNames (#5)
+	if len(bz) == 0 {
+		goo.FNames = nil
+	} else if fnum, _, _, err := amino.DecodeFieldNumberAndTyp3(bz); err != nil {
+		return err
+	} else if fnum <= 5 {
+		var list []Name
+		for len(bz) > 0 {
+			fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz)
+			if fnum > 5 {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			if fnum < 5 {
+				return fmt.Errorf("expected repeated field number 5 or greater, got %v", fnum)
+			}
+			if typ != amino.Typ3ByteLength {
+				return fmt.Errorf("expected repeated field type %v, got %v", amino.Typ3ByteLength, typ)
+			}
+			if len(bz) > 0 && bz[0] == 0x00 {
+				bz = bz[1:]
+				list = append(list, "")
+				continue
+			}
+			v, n, err := amino.DecodeString(bz)
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			list = append(list, Name(v))
+		}
+		goo.FNames = list
+	}
+	// Field FBlocks (#6)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 5, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo Block) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field ObjectInfo (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.ObjectInfo.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Source (#2)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 1); err != nil {
+		return
+	}
+	// Field Values (#3)
+	for _, e := range goo.Values {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		buf2 := new(bytes.Buffer)
+		if err = e.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	// Field Parent (#4)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 3); err != nil {
+		return
+	}
+	// Field Blank (#5)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Blank.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 5, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	return nil
+}
+
+func (goo *Block) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field ObjectInfo (#1)
+	if len(bz) == 0 {
+		goo.ObjectInfo = ObjectInfo{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.ObjectInfo = ObjectInfo{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.Block, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.Block, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.ObjectInfo.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Source (#2)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 1, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Values (#3)
+	if len(bz) == 0 {
+		goo.Values = nil
+	} else if fnum, _, _, err := amino.DecodeFieldNumberAndTyp3(bz); err != nil {
+		return err
+	} else if fnum <= 3 {
+		var list []TypedValue
+		for len(bz) > 0 {
+			fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz)
+			if fnum > 3 {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			if fnum < 3 {
+				return fmt.Errorf("expected repeated field number 3 or greater, got %v", fnum)
+			}
+			if typ != amino.Typ3ByteLength {
+				return fmt.Errorf("expected repeated field type %v, got %v", amino.Typ3ByteLength, typ)
+			}
+			if len(bz) > 0 && bz[0] == 0x00 {
+				bz = bz[1:]
+				list = append(list, TypedValue{})
+				continue
+			}
+			v, n, err := amino.DecodeByteSlice(bz)
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			var e TypedValue
+			if err := e.UnmarshalBinary2(cdc, v); err != nil {
+				return err
+			}
+			list = append(list, e)
+		}
+		goo.Values = list
+	}
+	// Field Parent (#4)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 3, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Blank (#5)
+	if len(bz) == 0 {
+		goo.Blank = TypedValue{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 5 {
+		goo.Blank = TypedValue{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 5 {
+			return fmt.Errorf("expected field # 5 of gnolang.Block, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 5 of gnolang.Block, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Blank.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo RefValue) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field ObjectID (#1)
+	{
+		var repr string
+		if repr, err = goo.ObjectID.MarshalAmino(); err != nil {
+			return
+		}
+		if repr != "" {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeString(buf, repr); err != nil {
+				return
+			}
+		}
+	}
+	// Field Escaped (#2)
+	if goo.Escaped {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeBool(buf, bool(goo.Escaped)); err != nil {
+			return
+		}
+	}
+	// Field PkgPath (#3)
+	if goo.PkgPath != "" {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(goo.PkgPath)); err != nil {
+			return
+		}
+	}
+	// Field Hash (#4)
+	{
+		var repr string
+		if repr, err = goo.Hash.MarshalAmino(); err != nil {
+			return
+		}
+		if repr != "" {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 4, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeString(buf, repr); err != nil {
+				return
+			}
+		}
+	}
+	return nil
+}
+
+func (goo *RefValue) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field ObjectID (#1)
+	if len(bz) == 0 {
+		goo.ObjectID = ObjectID{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.ObjectID = ObjectID{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.RefValue, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.RefValue, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.ObjectID.UnmarshalAmino(v); err != nil {
+			return err
+		}
+	}
+	// Field Escaped (#2)
+	if len(bz) == 0 {
+		goo.Escaped = false
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.Escaped = false
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of gnolang.RefValue, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 2 of gnolang.RefValue, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeBool(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Escaped = bool(v)
+	}
+	// Field PkgPath (#3)
+	if len(bz) == 0 {
+		goo.PkgPath = ""
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.PkgPath = ""
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of gnolang.RefValue, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 3 of gnolang.RefValue, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.PkgPath = string(v)
+	}
+	// Field Hash (#4)
+	if len(bz) == 0 {
+		goo.Hash = ValueHash{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 4 {
+		goo.Hash = ValueHash{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 4 {
+			return fmt.Errorf("expected field # 4 of gnolang.RefValue, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 4 of gnolang.RefValue, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Hash.UnmarshalAmino(v); err != nil {
+			return err
+		}
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo ObjectInfo) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field ID (#1)
+	{
+		var repr string
+		if repr, err = goo.ID.MarshalAmino(); err != nil {
+			return
+		}
+		if repr != "" {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeString(buf, repr); err != nil {
+				return
+			}
+		}
+	}
+	// Field Hash (#2)
+	{
+		var repr string
+		if repr, err = goo.Hash.MarshalAmino(); err != nil {
+			return
+		}
+		if repr != "" {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeString(buf, repr); err != nil {
+				return
+			}
+		}
+	}
+	// Field OwnerID (#3)
+	{
+		var repr string
+		if repr, err = goo.OwnerID.MarshalAmino(); err != nil {
+			return
+		}
+		if repr != "" {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeString(buf, repr); err != nil {
+				return
+			}
+		}
+	}
+	// Field ModTime (#4)
+	if goo.ModTime != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 4, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeUvarint(buf, uint64(goo.ModTime)); err != nil {
+			return
+		}
+	}
+	// Field RefCount (#5)
+	if goo.RefCount != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 5, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.RefCount)); err != nil {
+			return
+		}
+	}
+	// Field IsEscaped (#6)
+	if goo.IsEscaped {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 6, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeBool(buf, bool(goo.IsEscaped)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *ObjectInfo) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field ID (#1)
+	if len(bz) == 0 {
+		goo.ID = ObjectID{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.ID = ObjectID{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.ObjectInfo, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.ObjectInfo, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.ID.UnmarshalAmino(v); err != nil {
+			return err
+		}
+	}
+	// Field Hash (#2)
+	if len(bz) == 0 {
+		goo.Hash = ValueHash{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.Hash = ValueHash{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of gnolang.ObjectInfo, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of gnolang.ObjectInfo, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Hash.UnmarshalAmino(v); err != nil {
+			return err
+		}
+	}
+	// Field OwnerID (#3)
+	if len(bz) == 0 {
+		goo.OwnerID = ObjectID{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.OwnerID = ObjectID{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of gnolang.ObjectInfo, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 3 of gnolang.ObjectInfo, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.OwnerID.UnmarshalAmino(v); err != nil {
+			return err
+		}
+	}
+	// Field ModTime (#4)
+	if len(bz) == 0 {
+		goo.ModTime = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 4 {
+		goo.ModTime = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 4 {
+			return fmt.Errorf("expected field # 4 of gnolang.ObjectInfo, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 4 of gnolang.ObjectInfo, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeUvarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.ModTime = uint64(v)
+	}
+	// Field RefCount (#5)
+	if len(bz) == 0 {
+		goo.RefCount = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 5 {
+		goo.RefCount = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 5 {
+			return fmt.Errorf("expected field # 5 of gnolang.ObjectInfo, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 5 of gnolang.ObjectInfo, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.RefCount = int(v)
+	}
+	// Field IsEscaped (#6)
+	if len(bz) == 0 {
+		goo.IsEscaped = false
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 6 {
+		goo.IsEscaped = false
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 6 {
+			return fmt.Errorf("expected field # 6 of gnolang.ObjectInfo, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 6 of gnolang.ObjectInfo, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeBool(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.IsEscaped = bool(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo ValuePath) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field Type (#1)
+	if goo.Type != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeUvarint(buf, uint64(goo.Type)); err != nil {
+			return
+		}
+	}
+	// Field Depth (#2)
+	if goo.Depth != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeUvarint(buf, uint64(goo.Depth)); err != nil {
+			return
+		}
+	}
+	// Field Index (#3)
+	if goo.Index != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeUvarint(buf, uint64(goo.Index)); err != nil {
+			return
+		}
+	}
+	// Field Name (#4)
+	if goo.Name != "" {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 4, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(goo.Name)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *ValuePath) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field Type (#1)
+	if len(bz) == 0 {
+		goo.Type = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Type = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.ValuePath, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.ValuePath, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeUvarint8(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Type = VPType(v)
+	}
+	// Field Depth (#2)
+	if len(bz) == 0 {
+		goo.Depth = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.Depth = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of gnolang.ValuePath, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 2 of gnolang.ValuePath, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeUvarint8(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Depth = uint8(v)
+	}
+	// Field Index (#3)
+	if len(bz) == 0 {
+		goo.Index = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.Index = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of gnolang.ValuePath, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 3 of gnolang.ValuePath, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeUvarint16(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Index = uint16(v)
+	}
+	// Field Name (#4)
+	if len(bz) == 0 {
+		goo.Name = ""
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 4 {
+		goo.Name = ""
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 4 {
+			return fmt.Errorf("expected field # 4 of gnolang.ValuePath, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 4 of gnolang.ValuePath, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Name = Name(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo Location) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field PkgPath (#1)
+	if goo.PkgPath != "" {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(goo.PkgPath)); err != nil {
+			return
+		}
+	}
+	// Field File (#2)
+	if goo.File != "" {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(goo.File)); err != nil {
+			return
+		}
+	}
+	// Field Line (#3)
+	if goo.Line != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Line)); err != nil {
+			return
+		}
+	}
+	// Field Nonce (#4)
+	if goo.Nonce != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 4, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Nonce)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *Location) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field PkgPath (#1)
+	if len(bz) == 0 {
+		goo.PkgPath = ""
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.PkgPath = ""
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.Location, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.Location, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.PkgPath = string(v)
+	}
+	// Field File (#2)
+	if len(bz) == 0 {
+		goo.File = ""
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.File = ""
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of gnolang.Location, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of gnolang.Location, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.File = string(v)
+	}
+	// Field Line (#3)
+	if len(bz) == 0 {
+		goo.Line = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.Line = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of gnolang.Location, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 3 of gnolang.Location, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Line = int(v)
+	}
+	// Field Nonce (#4)
+	if len(bz) == 0 {
+		goo.Nonce = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 4 {
+		goo.Nonce = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 4 {
+			return fmt.Errorf("expected field # 4 of gnolang.Location, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 4 of gnolang.Location, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Nonce = int(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo Attributes) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field Line (#1)
+	if goo.Line != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Line)); err != nil {
+			return
+		}
+	}
+	// Field Label (#2)
+	if goo.Label != "" {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(goo.Label)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *Attributes) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field Line (#1)
+	if len(bz) == 0 {
+		goo.Line = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Line = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.Attributes, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.Attributes, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Line = int(v)
+	}
+	// Field Label (#2)
+	if len(bz) == 0 {
+		goo.Label = ""
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.Label = ""
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of gnolang.Attributes, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of gnolang.Attributes, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Label = Name(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo NameExpr) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Path (#2)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Path.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Name (#3)
+	if goo.Name != "" {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(goo.Name)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *NameExpr) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.NameExpr, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.NameExpr, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Path (#2)
+	if len(bz) == 0 {
+		goo.Path = ValuePath{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.Path = ValuePath{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of gnolang.NameExpr, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of gnolang.NameExpr, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Path.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Name (#3)
+	if len(bz) == 0 {
+		goo.Name = ""
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.Name = ""
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of gnolang.NameExpr, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 3 of gnolang.NameExpr, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Name = Name(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo BasicLitExpr) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Kind (#2)
+	if goo.Kind != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Kind)); err != nil {
+			return
+		}
+	}
+	// Field Value (#3)
+	if goo.Value != "" {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(goo.Value)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *BasicLitExpr) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.BasicLitExpr, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.BasicLitExpr, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Kind (#2)
+	if len(bz) == 0 {
+		goo.Kind = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.Kind = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of gnolang.BasicLitExpr, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 2 of gnolang.BasicLitExpr, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Kind = Word(v)
+	}
+	// Field Value (#3)
+	if len(bz) == 0 {
+		goo.Value = ""
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.Value = ""
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of gnolang.BasicLitExpr, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 3 of gnolang.BasicLitExpr, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Value = string(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo BinaryExpr) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Left (#2)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 1); err != nil {
+		return
+	}
+	// Field Op (#3)
+	if goo.Op != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Op)); err != nil {
+			return
+		}
+	}
+	// Field Right (#4)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 3); err != nil {
+		return
+	}
+	return nil
+}
+
+func (goo *BinaryExpr) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.BinaryExpr, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.BinaryExpr, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Left (#2)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 1, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Op (#3)
+	if len(bz) == 0 {
+		goo.Op = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.Op = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of gnolang.BinaryExpr, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 3 of gnolang.BinaryExpr, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Op = Word(v)
+	}
+	// Field Right (#4)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 3, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo CallExpr) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Func (#2)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 1); err != nil {
+		return
+	}
+	// Field Args (#3)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 2); err != nil {
+		return
+	}
+	// Field Varg (#4)
+	if goo.Varg {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 4, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeBool(buf, bool(goo.Varg)); err != nil {
+			return
+		}
+	}
+	// Field NumArgs (#5)
+	if goo.NumArgs != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 5, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.NumArgs)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *CallExpr) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.CallExpr, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.CallExpr, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Func (#2)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 1, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Args (#3)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 2, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Varg (#4)
+	if len(bz) == 0 {
+		goo.Varg = false
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 4 {
+		goo.Varg = false
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 4 {
+			return fmt.Errorf("expected field # 4 of gnolang.CallExpr, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 4 of gnolang.CallExpr, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeBool(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Varg = bool(v)
+	}
+	// Field NumArgs (#5)
+	if len(bz) == 0 {
+		goo.NumArgs = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 5 {
+		goo.NumArgs = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 5 {
+			return fmt.Errorf("expected field # 5 of gnolang.CallExpr, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 5 of gnolang.CallExpr, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.NumArgs = int(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo IndexExpr) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field X (#2)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 1); err != nil {
+		return
+	}
+	// Field Index (#3)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 2); err != nil {
+		return
+	}
+	// Field HasOK (#4)
+	if goo.HasOK {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 4, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeBool(buf, bool(goo.HasOK)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *IndexExpr) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.IndexExpr, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.IndexExpr, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field X (#2)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 1, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Index (#3)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 2, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field HasOK (#4)
+	if len(bz) == 0 {
+		goo.HasOK = false
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 4 {
+		goo.HasOK = false
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 4 {
+			return fmt.Errorf("expected field # 4 of gnolang.IndexExpr, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 4 of gnolang.IndexExpr, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeBool(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.HasOK = bool(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo SelectorExpr) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field X (#2)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 1); err != nil {
+		return
+	}
+	// Field Path (#3)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Path.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Sel (#4)
+	if goo.Sel != "" {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 4, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(goo.Sel)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *SelectorExpr) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.SelectorExpr, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.SelectorExpr, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field X (#2)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 1, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Path (#3)
+	if len(bz) == 0 {
+		goo.Path = ValuePath{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.Path = ValuePath{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of gnolang.SelectorExpr, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 3 of gnolang.SelectorExpr, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Path.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Sel (#4)
+	if len(bz) == 0 {
+		goo.Sel = ""
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 4 {
+		goo.Sel = ""
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 4 {
+			return fmt.Errorf("expected field # 4 of gnolang.SelectorExpr, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 4 of gnolang.SelectorExpr, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Sel = Name(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo SliceExpr) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field X (#2)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 1); err != nil {
+		return
+	}
+	// Field Low (#3)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 2); err != nil {
+		return
+	}
+	// Field High (#4)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 3); err != nil {
+		return
+	}
+	// Field Max (#5)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 4); err != nil {
+		return
+	}
+	return nil
+}
+
+func (goo *SliceExpr) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.SliceExpr, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.SliceExpr, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field X (#2)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 1, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Low (#3)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 2, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field High (#4)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 3, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Max (#5)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 4, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo StarExpr) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field X (#2)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 1); err != nil {
+		return
+	}
+	return nil
+}
+
+func (goo *StarExpr) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.StarExpr, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.StarExpr, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field X (#2)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 1, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo RefExpr) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field X (#2)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 1); err != nil {
+		return
+	}
+	return nil
+}
+
+func (goo *RefExpr) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.RefExpr, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.RefExpr, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field X (#2)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 1, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo TypeAssertExpr) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field X (#2)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 1); err != nil {
+		return
+	}
+	// Field Type (#3)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 2); err != nil {
+		return
+	}
+	// Field HasOK (#4)
+	if goo.HasOK {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 4, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeBool(buf, bool(goo.HasOK)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *TypeAssertExpr) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.TypeAssertExpr, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.TypeAssertExpr, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field X (#2)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 1, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Type (#3)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 2, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field HasOK (#4)
+	if len(bz) == 0 {
+		goo.HasOK = false
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 4 {
+		goo.HasOK = false
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 4 {
+			return fmt.Errorf("expected field # 4 of gnolang.TypeAssertExpr, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 4 of gnolang.TypeAssertExpr, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeBool(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.HasOK = bool(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo UnaryExpr) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field X (#2)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 1); err != nil {
+		return
+	}
+	// Field Op (#3)
+	if goo.Op != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Op)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *UnaryExpr) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.UnaryExpr, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.UnaryExpr, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field X (#2)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 1, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Op (#3)
+	if len(bz) == 0 {
+		goo.Op = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.Op = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of gnolang.UnaryExpr, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 3 of gnolang.UnaryExpr, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Op = Word(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo CompositeLitExpr) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Type (#2)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 1); err != nil {
+		return
+	}
+	// Field Elts (#3)
+	for _, e := range goo.Elts {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		buf2 := new(bytes.Buffer)
+		if err = e.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *CompositeLitExpr) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.CompositeLitExpr, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.CompositeLitExpr, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Type (#2)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 1, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Elts (#3)
+	if len(bz) == 0 {
+		goo.Elts = nil
+	} else if fnum, _, _, err := amino.DecodeFieldNumberAndTyp3(bz); err != nil {
+		return err
+	} else if fnum <= 3 {
+		var list KeyValueExprs
+		for len(bz) > 0 {
+			fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz)
+			if fnum > 3 {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			if fnum < 3 {
+				return fmt.Errorf("expected repeated field number 3 or greater, got %v", fnum)
+			}
+			if typ != amino.Typ3ByteLength {
+				return fmt.Errorf("expected repeated field type %v, got %v", amino.Typ3ByteLength, typ)
+			}
+			if len(bz) > 0 && bz[0] == 0x00 {
+				bz = bz[1:]
+				list = append(list, KeyValueExpr{})
+				continue
+			}
+			v, n, err := amino.DecodeByteSlice(bz)
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			var e KeyValueExpr
+			if err := e.UnmarshalBinary2(cdc, v); err != nil {
+				return err
+			}
+			list = append(list, e)
+		}
+		goo.Elts = list
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo KeyValueExpr) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Key (#2)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 1); err != nil {
+		return
+	}
+	// Field Value (#3)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 2); err != nil {
+		return
+	}
+	return nil
+}
+
+func (goo *KeyValueExpr) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.KeyValueExpr, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.KeyValueExpr, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Key (#2)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 1, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Value (#3)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 2, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo FuncLitExpr) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field StaticBlock (#2)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.StaticBlock.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Type (#3)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Type.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Body (#4)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 3); err != nil {
+		return
+	}
+	return nil
+}
+
+func (goo *FuncLitExpr) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.FuncLitExpr, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.FuncLitExpr, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field StaticBlock (#2)
+	if len(bz) == 0 {
+		goo.StaticBlock = StaticBlock{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.StaticBlock = StaticBlock{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of gnolang.FuncLitExpr, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of gnolang.FuncLitExpr, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.StaticBlock.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Type (#3)
+	if len(bz) == 0 {
+		goo.Type = FuncTypeExpr{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.Type = FuncTypeExpr{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of gnolang.FuncLitExpr, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 3 of gnolang.FuncLitExpr, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Type.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Body (#4)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 3, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo ConstExpr) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Source (#2)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 1); err != nil {
+		return
+	}
+	// Field TypedValue (#3)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.TypedValue.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	return nil
+}
+
+func (goo *ConstExpr) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.ConstExpr, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.ConstExpr, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Source (#2)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 1, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field TypedValue (#3)
+	if len(bz) == 0 {
+		goo.TypedValue = TypedValue{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.TypedValue = TypedValue{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of gnolang.ConstExpr, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 3 of gnolang.ConstExpr, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.TypedValue.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo FieldTypeExpr) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Name (#2)
+	if goo.Name != "" {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(goo.Name)); err != nil {
+			return
+		}
+	}
+	// Field Type (#3)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 2); err != nil {
+		return
+	}
+	// Field Tag (#4)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 3); err != nil {
+		return
+	}
+	return nil
+}
+
+func (goo *FieldTypeExpr) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.FieldTypeExpr, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.FieldTypeExpr, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Name (#2)
+	if len(bz) == 0 {
+		goo.Name = ""
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.Name = ""
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of gnolang.FieldTypeExpr, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of gnolang.FieldTypeExpr, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Name = Name(v)
+	}
+	// Field Type (#3)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 2, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Tag (#4)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 3, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo ArrayTypeExpr) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Len (#2)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 1); err != nil {
+		return
+	}
+	// Field Elt (#3)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 2); err != nil {
+		return
+	}
+	return nil
+}
+
+func (goo *ArrayTypeExpr) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.ArrayTypeExpr, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.ArrayTypeExpr, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Len (#2)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 1, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Elt (#3)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 2, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo SliceTypeExpr) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Elt (#2)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 1); err != nil {
+		return
+	}
+	// Field Vrd (#3)
+	if goo.Vrd {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeBool(buf, bool(goo.Vrd)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *SliceTypeExpr) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.SliceTypeExpr, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.SliceTypeExpr, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Elt (#2)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 1, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Vrd (#3)
+	if len(bz) == 0 {
+		goo.Vrd = false
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.Vrd = false
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of gnolang.SliceTypeExpr, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 3 of gnolang.SliceTypeExpr, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeBool(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Vrd = bool(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo InterfaceTypeExpr) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Methods (#2)
+	for _, e := range goo.Methods {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		buf2 := new(bytes.Buffer)
+		if err = e.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	// Field Generic (#3)
+	if goo.Generic != "" {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(goo.Generic)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *InterfaceTypeExpr) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.InterfaceTypeExpr, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.InterfaceTypeExpr, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Methods (#2)
+	if len(bz) == 0 {
+		goo.Methods = nil
+	} else if fnum, _, _, err := amino.DecodeFieldNumberAndTyp3(bz); err != nil {
+		return err
+	} else if fnum <= 2 {
+		var list FieldTypeExprs
+		for len(bz) > 0 {
+			fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz)
+			if fnum > 2 {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			if fnum < 2 {
+				return fmt.Errorf("expected repeated field number 2 or greater, got %v", fnum)
+			}
+			if typ != amino.Typ3ByteLength {
+				return fmt.Errorf("expected repeated field type %v, got %v", amino.Typ3ByteLength, typ)
+			}
+			if len(bz) > 0 && bz[0] == 0x00 {
+				bz = bz[1:]
+				list = append(list, FieldTypeExpr{})
+				continue
+			}
+			v, n, err := amino.DecodeByteSlice(bz)
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			var e FieldTypeExpr
+			if err := e.UnmarshalBinary2(cdc, v); err != nil {
+				return err
+			}
+			list = append(list, e)
+		}
+		goo.Methods = list
+	}
+	// Field Generic (#3)
+	if len(bz) == 0 {
+		goo.Generic = ""
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.Generic = ""
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of gnolang.InterfaceTypeExpr, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 3 of gnolang.InterfaceTypeExpr, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Generic = Name(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo ChanTypeExpr) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Dir (#2)
+	if goo.Dir != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Dir)); err != nil {
+			return
+		}
+	}
+	// Field Value (#3)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 2); err != nil {
+		return
+	}
+	return nil
+}
+
+func (goo *ChanTypeExpr) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.ChanTypeExpr, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.ChanTypeExpr, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Dir (#2)
+	if len(bz) == 0 {
+		goo.Dir = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.Dir = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of gnolang.ChanTypeExpr, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 2 of gnolang.ChanTypeExpr, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Dir = ChanDir(v)
+	}
+	// Field Value (#3)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 2, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo FuncTypeExpr) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Params (#2)
+	for _, e := range goo.Params {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		buf2 := new(bytes.Buffer)
+		if err = e.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	// Field Results (#3)
+	for _, e := range goo.Results {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		buf2 := new(bytes.Buffer)
+		if err = e.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *FuncTypeExpr) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.FuncTypeExpr, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.FuncTypeExpr, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Params (#2)
+	if len(bz) == 0 {
+		goo.Params = nil
+	} else if fnum, _, _, err := amino.DecodeFieldNumberAndTyp3(bz); err != nil {
+		return err
+	} else if fnum <= 2 {
+		var list FieldTypeExprs
+		for len(bz) > 0 {
+			fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz)
+			if fnum > 2 {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			if fnum < 2 {
+				return fmt.Errorf("expected repeated field number 2 or greater, got %v", fnum)
+			}
+			if typ != amino.Typ3ByteLength {
+				return fmt.Errorf("expected repeated field type %v, got %v", amino.Typ3ByteLength, typ)
+			}
+			if len(bz) > 0 && bz[0] == 0x00 {
+				bz = bz[1:]
+				list = append(list, FieldTypeExpr{})
+				continue
+			}
+			v, n, err := amino.DecodeByteSlice(bz)
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			var e FieldTypeExpr
+			if err := e.UnmarshalBinary2(cdc, v); err != nil {
+				return err
+			}
+			list = append(list, e)
+		}
+		goo.Params = list
+	}
+	// Field Results (#3)
+	if len(bz) == 0 {
+		goo.Results = nil
+	} else if fnum, _, _, err := amino.DecodeFieldNumberAndTyp3(bz); err != nil {
+		return err
+	} else if fnum <= 3 {
+		var list FieldTypeExprs
+		for len(bz) > 0 {
+			fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz)
+			if fnum > 3 {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			if fnum < 3 {
+				return fmt.Errorf("expected repeated field number 3 or greater, got %v", fnum)
+			}
+			if typ != amino.Typ3ByteLength {
+				return fmt.Errorf("expected repeated field type %v, got %v", amino.Typ3ByteLength, typ)
+			}
+			if len(bz) > 0 && bz[0] == 0x00 {
+				bz = bz[1:]
+				list = append(list, FieldTypeExpr{})
+				continue
+			}
+			v, n, err := amino.DecodeByteSlice(bz)
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			var e FieldTypeExpr
+			if err := e.UnmarshalBinary2(cdc, v); err != nil {
+				return err
+			}
+			list = append(list, e)
+		}
+		goo.Results = list
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo MapTypeExpr) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Key (#2)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 1); err != nil {
+		return
+	}
+	// Field Value (#3)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 2); err != nil {
+		return
+	}
+	return nil
+}
+
+func (goo *MapTypeExpr) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.MapTypeExpr, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.MapTypeExpr, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Key (#2)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 1, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Value (#3)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 2, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo StructTypeExpr) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Fields (#2)
+	for _, e := range goo.Fields {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		buf2 := new(bytes.Buffer)
+		if err = e.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *StructTypeExpr) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.StructTypeExpr, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.StructTypeExpr, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Fields (#2)
+	if len(bz) == 0 {
+		goo.Fields = nil
+	} else if fnum, _, _, err := amino.DecodeFieldNumberAndTyp3(bz); err != nil {
+		return err
+	} else if fnum <= 2 {
+		var list FieldTypeExprs
+		for len(bz) > 0 {
+			fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz)
+			if fnum > 2 {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			if fnum < 2 {
+				return fmt.Errorf("expected repeated field number 2 or greater, got %v", fnum)
+			}
+			if typ != amino.Typ3ByteLength {
+				return fmt.Errorf("expected repeated field type %v, got %v", amino.Typ3ByteLength, typ)
+			}
+			if len(bz) > 0 && bz[0] == 0x00 {
+				bz = bz[1:]
+				list = append(list, FieldTypeExpr{})
+				continue
+			}
+			v, n, err := amino.DecodeByteSlice(bz)
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			var e FieldTypeExpr
+			if err := e.UnmarshalBinary2(cdc, v); err != nil {
+				return err
+			}
+			list = append(list, e)
+		}
+		goo.Fields = list
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo constTypeExpr) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Source (#2)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 1); err != nil {
+		return
+	}
+	// Field Type (#3)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 2); err != nil {
+		return
+	}
+	return nil
+}
+
+func (goo *constTypeExpr) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.constTypeExpr, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.constTypeExpr, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Source (#2)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 1, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Type (#3)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 2, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo MaybeNativeTypeExpr) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Type (#2)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 1); err != nil {
+		return
+	}
+	return nil
+}
+
+func (goo *MaybeNativeTypeExpr) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.MaybeNativeTypeExpr, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.MaybeNativeTypeExpr, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Type (#2)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 1, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo AssignStmt) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Lhs (#2)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 1); err != nil {
+		return
+	}
+	// Field Op (#3)
+	if goo.Op != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Op)); err != nil {
+			return
+		}
+	}
+	// Field Rhs (#4)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 3); err != nil {
+		return
+	}
+	return nil
+}
+
+func (goo *AssignStmt) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.AssignStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.AssignStmt, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Lhs (#2)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 1, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Op (#3)
+	if len(bz) == 0 {
+		goo.Op = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.Op = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of gnolang.AssignStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 3 of gnolang.AssignStmt, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Op = Word(v)
+	}
+	// Field Rhs (#4)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 3, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo BlockStmt) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field StaticBlock (#2)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.StaticBlock.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Body (#3)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 2); err != nil {
+		return
+	}
+	return nil
+}
+
+func (goo *BlockStmt) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.BlockStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.BlockStmt, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field StaticBlock (#2)
+	if len(bz) == 0 {
+		goo.StaticBlock = StaticBlock{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.StaticBlock = StaticBlock{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of gnolang.BlockStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of gnolang.BlockStmt, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.StaticBlock.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Body (#3)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 2, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo BranchStmt) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Op (#2)
+	if goo.Op != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Op)); err != nil {
+			return
+		}
+	}
+	// Field Label (#3)
+	if goo.Label != "" {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(goo.Label)); err != nil {
+			return
+		}
+	}
+	// Field Depth (#4)
+	if goo.Depth != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 4, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeUvarint(buf, uint64(goo.Depth)); err != nil {
+			return
+		}
+	}
+	// Field BodyIndex (#5)
+	if goo.BodyIndex != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 5, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.BodyIndex)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *BranchStmt) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.BranchStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.BranchStmt, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Op (#2)
+	if len(bz) == 0 {
+		goo.Op = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.Op = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of gnolang.BranchStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 2 of gnolang.BranchStmt, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Op = Word(v)
+	}
+	// Field Label (#3)
+	if len(bz) == 0 {
+		goo.Label = ""
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.Label = ""
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of gnolang.BranchStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 3 of gnolang.BranchStmt, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Label = Name(v)
+	}
+	// Field Depth (#4)
+	if len(bz) == 0 {
+		goo.Depth = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 4 {
+		goo.Depth = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 4 {
+			return fmt.Errorf("expected field # 4 of gnolang.BranchStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 4 of gnolang.BranchStmt, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeUvarint8(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Depth = uint8(v)
+	}
+	// Field BodyIndex (#5)
+	if len(bz) == 0 {
+		goo.BodyIndex = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 5 {
+		goo.BodyIndex = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 5 {
+			return fmt.Errorf("expected field # 5 of gnolang.BranchStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 5 of gnolang.BranchStmt, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.BodyIndex = int(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo DeclStmt) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Body (#2)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 1); err != nil {
+		return
+	}
+	return nil
+}
+
+func (goo *DeclStmt) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.DeclStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.DeclStmt, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Body (#2)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 1, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo DeferStmt) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Call (#2)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Call.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	return nil
+}
+
+func (goo *DeferStmt) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.DeferStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.DeferStmt, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Call (#2)
+	if len(bz) == 0 {
+		goo.Call = CallExpr{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.Call = CallExpr{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of gnolang.DeferStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of gnolang.DeferStmt, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Call.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo ExprStmt) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field X (#2)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 1); err != nil {
+		return
+	}
+	return nil
+}
+
+func (goo *ExprStmt) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.ExprStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.ExprStmt, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field X (#2)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 1, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo ForStmt) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field StaticBlock (#2)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.StaticBlock.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Init (#3)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 2); err != nil {
+		return
+	}
+	// Field Cond (#4)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 3); err != nil {
+		return
+	}
+	// Field Post (#5)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 4); err != nil {
+		return
+	}
+	// Field Body (#6)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 5); err != nil {
+		return
+	}
+	return nil
+}
+
+func (goo *ForStmt) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.ForStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.ForStmt, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field StaticBlock (#2)
+	if len(bz) == 0 {
+		goo.StaticBlock = StaticBlock{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.StaticBlock = StaticBlock{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of gnolang.ForStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of gnolang.ForStmt, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.StaticBlock.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Init (#3)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 2, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Cond (#4)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 3, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Post (#5)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 4, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Body (#6)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 5, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo GoStmt) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Call (#2)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Call.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	return nil
+}
+
+func (goo *GoStmt) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.GoStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.GoStmt, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Call (#2)
+	if len(bz) == 0 {
+		goo.Call = CallExpr{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.Call = CallExpr{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of gnolang.GoStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of gnolang.GoStmt, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Call.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo IfStmt) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field StaticBlock (#2)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.StaticBlock.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Init (#3)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 2); err != nil {
+		return
+	}
+	// Field Cond (#4)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 3); err != nil {
+		return
+	}
+	// Field Then (#5)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Then.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 5, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Else (#6)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Else.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 6, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	return nil
+}
+
+func (goo *IfStmt) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.IfStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.IfStmt, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field StaticBlock (#2)
+	if len(bz) == 0 {
+		goo.StaticBlock = StaticBlock{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.StaticBlock = StaticBlock{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of gnolang.IfStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of gnolang.IfStmt, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.StaticBlock.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Init (#3)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 2, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Cond (#4)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 3, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Then (#5)
+	if len(bz) == 0 {
+		goo.Then = IfCaseStmt{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 5 {
+		goo.Then = IfCaseStmt{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 5 {
+			return fmt.Errorf("expected field # 5 of gnolang.IfStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 5 of gnolang.IfStmt, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Then.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Else (#6)
+	if len(bz) == 0 {
+		goo.Else = IfCaseStmt{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 6 {
+		goo.Else = IfCaseStmt{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 6 {
+			return fmt.Errorf("expected field # 6 of gnolang.IfStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 6 of gnolang.IfStmt, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Else.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo IfCaseStmt) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field StaticBlock (#2)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.StaticBlock.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Body (#3)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 2); err != nil {
+		return
+	}
+	return nil
+}
+
+func (goo *IfCaseStmt) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.IfCaseStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.IfCaseStmt, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field StaticBlock (#2)
+	if len(bz) == 0 {
+		goo.StaticBlock = StaticBlock{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.StaticBlock = StaticBlock{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of gnolang.IfCaseStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of gnolang.IfCaseStmt, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.StaticBlock.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Body (#3)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 2, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo IncDecStmt) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field X (#2)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 1); err != nil {
+		return
+	}
+	// Field Op (#3)
+	if goo.Op != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Op)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *IncDecStmt) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.IncDecStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.IncDecStmt, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field X (#2)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 1, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Op (#3)
+	if len(bz) == 0 {
+		goo.Op = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.Op = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of gnolang.IncDecStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 3 of gnolang.IncDecStmt, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Op = Word(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo RangeStmt) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field StaticBlock (#2)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.StaticBlock.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field X (#3)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 2); err != nil {
+		return
+	}
+	// Field Key (#4)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 3); err != nil {
+		return
+	}
+	// Field Value (#5)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 4); err != nil {
+		return
+	}
+	// Field Op (#6)
+	if goo.Op != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 6, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Op)); err != nil {
+			return
+		}
+	}
+	// Field Body (#7)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 6); err != nil {
+		return
+	}
+	// Field IsMap (#8)
+	if goo.IsMap {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 8, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeBool(buf, bool(goo.IsMap)); err != nil {
+			return
+		}
+	}
+	// Field IsString (#9)
+	if goo.IsString {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 9, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeBool(buf, bool(goo.IsString)); err != nil {
+			return
+		}
+	}
+	// Field IsArrayPtr (#10)
+	if goo.IsArrayPtr {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 10, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeBool(buf, bool(goo.IsArrayPtr)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *RangeStmt) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.RangeStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.RangeStmt, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field StaticBlock (#2)
+	if len(bz) == 0 {
+		goo.StaticBlock = StaticBlock{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.StaticBlock = StaticBlock{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of gnolang.RangeStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of gnolang.RangeStmt, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.StaticBlock.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field X (#3)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 2, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Key (#4)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 3, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Value (#5)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 4, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Op (#6)
+	if len(bz) == 0 {
+		goo.Op = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 6 {
+		goo.Op = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 6 {
+			return fmt.Errorf("expected field # 6 of gnolang.RangeStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 6 of gnolang.RangeStmt, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Op = Word(v)
+	}
+	// Field Body (#7)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 6, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field IsMap (#8)
+	if len(bz) == 0 {
+		goo.IsMap = false
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 8 {
+		goo.IsMap = false
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 8 {
+			return fmt.Errorf("expected field # 8 of gnolang.RangeStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 8 of gnolang.RangeStmt, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeBool(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.IsMap = bool(v)
+	}
+	// Field IsString (#9)
+	if len(bz) == 0 {
+		goo.IsString = false
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 9 {
+		goo.IsString = false
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 9 {
+			return fmt.Errorf("expected field # 9 of gnolang.RangeStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 9 of gnolang.RangeStmt, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeBool(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.IsString = bool(v)
+	}
+	// Field IsArrayPtr (#10)
+	if len(bz) == 0 {
+		goo.IsArrayPtr = false
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 10 {
+		goo.IsArrayPtr = false
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 10 {
+			return fmt.Errorf("expected field # 10 of gnolang.RangeStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 10 of gnolang.RangeStmt, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeBool(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.IsArrayPtr = bool(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo ReturnStmt) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Results (#2)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 1); err != nil {
+		return
+	}
+	return nil
+}
+
+func (goo *ReturnStmt) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.ReturnStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.ReturnStmt, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Results (#2)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 1, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo PanicStmt) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Exception (#2)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 1); err != nil {
+		return
+	}
+	return nil
+}
+
+func (goo *PanicStmt) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.PanicStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.PanicStmt, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Exception (#2)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 1, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo SelectStmt) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Cases (#2)
+	for _, e := range goo.Cases {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		buf2 := new(bytes.Buffer)
+		if err = e.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *SelectStmt) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.SelectStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.SelectStmt, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Cases (#2)
+	if len(bz) == 0 {
+		goo.Cases = nil
+	} else if fnum, _, _, err := amino.DecodeFieldNumberAndTyp3(bz); err != nil {
+		return err
+	} else if fnum <= 2 {
+		var list []SelectCaseStmt
+		for len(bz) > 0 {
+			fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz)
+			if fnum > 2 {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			if fnum < 2 {
+				return fmt.Errorf("expected repeated field number 2 or greater, got %v", fnum)
+			}
+			if typ != amino.Typ3ByteLength {
+				return fmt.Errorf("expected repeated field type %v, got %v", amino.Typ3ByteLength, typ)
+			}
+			if len(bz) > 0 && bz[0] == 0x00 {
+				bz = bz[1:]
+				list = append(list, SelectCaseStmt{})
+				continue
+			}
+			v, n, err := amino.DecodeByteSlice(bz)
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			var e SelectCaseStmt
+			if err := e.UnmarshalBinary2(cdc, v); err != nil {
+				return err
+			}
+			list = append(list, e)
+		}
+		goo.Cases = list
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo SelectCaseStmt) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field StaticBlock (#2)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.StaticBlock.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Comm (#3)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 2); err != nil {
+		return
+	}
+	// Field Body (#4)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 3); err != nil {
+		return
+	}
+	return nil
+}
+
+func (goo *SelectCaseStmt) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.SelectCaseStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.SelectCaseStmt, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field StaticBlock (#2)
+	if len(bz) == 0 {
+		goo.StaticBlock = StaticBlock{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.StaticBlock = StaticBlock{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of gnolang.SelectCaseStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of gnolang.SelectCaseStmt, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.StaticBlock.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Comm (#3)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 2, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Body (#4)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 3, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo SendStmt) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Chan (#2)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 1); err != nil {
+		return
+	}
+	// Field Value (#3)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 2); err != nil {
+		return
+	}
+	return nil
+}
+
+func (goo *SendStmt) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.SendStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.SendStmt, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Chan (#2)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 1, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Value (#3)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 2, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo SwitchStmt) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field StaticBlock (#2)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.StaticBlock.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Init (#3)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 2); err != nil {
+		return
+	}
+	// Field X (#4)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 3); err != nil {
+		return
+	}
+	// Field IsTypeSwitch (#5)
+	if goo.IsTypeSwitch {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 5, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeBool(buf, bool(goo.IsTypeSwitch)); err != nil {
+			return
+		}
+	}
+	// Field Clauses (#6)
+	for _, e := range goo.Clauses {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 6, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		buf2 := new(bytes.Buffer)
+		if err = e.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	// Field VarName (#7)
+	if goo.VarName != "" {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 7, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(goo.VarName)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *SwitchStmt) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.SwitchStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.SwitchStmt, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field StaticBlock (#2)
+	if len(bz) == 0 {
+		goo.StaticBlock = StaticBlock{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.StaticBlock = StaticBlock{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of gnolang.SwitchStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of gnolang.SwitchStmt, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.StaticBlock.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Init (#3)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 2, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field X (#4)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 3, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field IsTypeSwitch (#5)
+	if len(bz) == 0 {
+		goo.IsTypeSwitch = false
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 5 {
+		goo.IsTypeSwitch = false
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 5 {
+			return fmt.Errorf("expected field # 5 of gnolang.SwitchStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 5 of gnolang.SwitchStmt, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeBool(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.IsTypeSwitch = bool(v)
+	}
+	// Field Clauses (#6)
+	if len(bz) == 0 {
+		goo.Clauses = nil
+	} else if fnum, _, _, err := amino.DecodeFieldNumberAndTyp3(bz); err != nil {
+		return err
+	} else if fnum <= 6 {
+		var list []SwitchClauseStmt
+		for len(bz) > 0 {
+			fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz)
+			if fnum > 6 {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			if fnum < 6 {
+				return fmt.Errorf("expected repeated field number 6 or greater, got %v", fnum)
+			}
+			if typ != amino.Typ3ByteLength {
+				return fmt.Errorf("expected repeated field type %v, got %v", amino.Typ3ByteLength, typ)
+			}
+			if len(bz) > 0 && bz[0] == 0x00 {
+				bz = bz[1:]
+				list = append(list, SwitchClauseStmt{})
+				continue
+			}
+			v, n, err := amino.DecodeByteSlice(bz)
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			var e SwitchClauseStmt
+			if err := e.UnmarshalBinary2(cdc, v); err != nil {
+				return err
+			}
+			list = append(list, e)
+		}
+		goo.Clauses = list
+	}
+	// Field VarName (#7)
+	if len(bz) == 0 {
+		goo.VarName = ""
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 7 {
+		goo.VarName = ""
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 7 {
+			return fmt.Errorf("expected field # 7 of gnolang.SwitchStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 7 of gnolang.SwitchStmt, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.VarName = Name(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo SwitchClauseStmt) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field StaticBlock (#2)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.StaticBlock.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Cases (#3)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 2); err != nil {
+		return
+	}
+	// Field Body (#4)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 3); err != nil {
+		return
+	}
+	return nil
+}
+
+func (goo *SwitchClauseStmt) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.SwitchClauseStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.SwitchClauseStmt, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field StaticBlock (#2)
+	if len(bz) == 0 {
+		goo.StaticBlock = StaticBlock{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.StaticBlock = StaticBlock{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of gnolang.SwitchClauseStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of gnolang.SwitchClauseStmt, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.StaticBlock.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Cases (#3)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 2, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Body (#4)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 3, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo EmptyStmt) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	return nil
+}
+
+func (goo *EmptyStmt) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.EmptyStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.EmptyStmt, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo bodyStmt) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Body (#2)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 1); err != nil {
+		return
+	}
+	// Field BodyLen (#3)
+	if goo.BodyLen != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.BodyLen)); err != nil {
+			return
+		}
+	}
+	// Field NextBodyIndex (#4)
+	if goo.NextBodyIndex != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 4, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.NextBodyIndex)); err != nil {
+			return
+		}
+	}
+	// Field NumOps (#5)
+	if goo.NumOps != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 5, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.NumOps)); err != nil {
+			return
+		}
+	}
+	// Field NumValues (#6)
+	if goo.NumValues != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 6, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.NumValues)); err != nil {
+			return
+		}
+	}
+	// Field NumExprs (#7)
+	if goo.NumExprs != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 7, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.NumExprs)); err != nil {
+			return
+		}
+	}
+	// Field NumStmts (#8)
+	if goo.NumStmts != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 8, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.NumStmts)); err != nil {
+			return
+		}
+	}
+	// Field Cond (#9)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 8); err != nil {
+		return
+	}
+	// Field Post (#10)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 9); err != nil {
+		return
+	}
+	// Field Active (#11)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 10); err != nil {
+		return
+	}
+	// Field Key (#12)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 11); err != nil {
+		return
+	}
+	// Field Value (#13)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 12); err != nil {
+		return
+	}
+	// Field Op (#14)
+	if goo.Op != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 14, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Op)); err != nil {
+			return
+		}
+	}
+	// Field ListLen (#15)
+	if goo.ListLen != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 15, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.ListLen)); err != nil {
+			return
+		}
+	}
+	// Field ListIndex (#16)
+	if goo.ListIndex != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 16, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.ListIndex)); err != nil {
+			return
+		}
+	}
+	// Field NextItem (#17)
+	if goo.NextItem != nil {
+		buf2 := new(bytes.Buffer)
+		if err = goo.NextItem.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 17, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	// Field StrLen (#18)
+	if goo.StrLen != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 18, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.StrLen)); err != nil {
+			return
+		}
+	}
+	// Field StrIndex (#19)
+	if goo.StrIndex != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 19, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.StrIndex)); err != nil {
+			return
+		}
+	}
+	// Field NextRune (#20)
+	if goo.NextRune != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 20, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.NextRune)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *bodyStmt) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.bodyStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.bodyStmt, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Body (#2)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 1, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field BodyLen (#3)
+	if len(bz) == 0 {
+		goo.BodyLen = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.BodyLen = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of gnolang.bodyStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 3 of gnolang.bodyStmt, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.BodyLen = int(v)
+	}
+	// Field NextBodyIndex (#4)
+	if len(bz) == 0 {
+		goo.NextBodyIndex = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 4 {
+		goo.NextBodyIndex = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 4 {
+			return fmt.Errorf("expected field # 4 of gnolang.bodyStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 4 of gnolang.bodyStmt, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.NextBodyIndex = int(v)
+	}
+	// Field NumOps (#5)
+	if len(bz) == 0 {
+		goo.NumOps = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 5 {
+		goo.NumOps = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 5 {
+			return fmt.Errorf("expected field # 5 of gnolang.bodyStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 5 of gnolang.bodyStmt, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.NumOps = int(v)
+	}
+	// Field NumValues (#6)
+	if len(bz) == 0 {
+		goo.NumValues = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 6 {
+		goo.NumValues = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 6 {
+			return fmt.Errorf("expected field # 6 of gnolang.bodyStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 6 of gnolang.bodyStmt, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.NumValues = int(v)
+	}
+	// Field NumExprs (#7)
+	if len(bz) == 0 {
+		goo.NumExprs = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 7 {
+		goo.NumExprs = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 7 {
+			return fmt.Errorf("expected field # 7 of gnolang.bodyStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 7 of gnolang.bodyStmt, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.NumExprs = int(v)
+	}
+	// Field NumStmts (#8)
+	if len(bz) == 0 {
+		goo.NumStmts = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 8 {
+		goo.NumStmts = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 8 {
+			return fmt.Errorf("expected field # 8 of gnolang.bodyStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 8 of gnolang.bodyStmt, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.NumStmts = int(v)
+	}
+	// Field Cond (#9)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 8, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Post (#10)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 9, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Active (#11)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 10, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Key (#12)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 11, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Value (#13)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 12, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Op (#14)
+	if len(bz) == 0 {
+		goo.Op = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 14 {
+		goo.Op = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 14 {
+			return fmt.Errorf("expected field # 14 of gnolang.bodyStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 14 of gnolang.bodyStmt, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Op = Word(v)
+	}
+	// Field ListLen (#15)
+	if len(bz) == 0 {
+		goo.ListLen = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 15 {
+		goo.ListLen = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 15 {
+			return fmt.Errorf("expected field # 15 of gnolang.bodyStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 15 of gnolang.bodyStmt, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.ListLen = int(v)
+	}
+	// Field ListIndex (#16)
+	if len(bz) == 0 {
+		goo.ListIndex = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 16 {
+		goo.ListIndex = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 16 {
+			return fmt.Errorf("expected field # 16 of gnolang.bodyStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 16 of gnolang.bodyStmt, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.ListIndex = int(v)
+	}
+	// Field NextItem (#17)
+	if len(bz) == 0 {
+		goo.NextItem = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 17 {
+		goo.NextItem = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 17 {
+			return fmt.Errorf("expected field # 17 of gnolang.bodyStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 17 of gnolang.bodyStmt, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if goo.NextItem == nil {
+			goo.NextItem = new(MapListItem)
+		}
+		if err := goo.NextItem.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field StrLen (#18)
+	if len(bz) == 0 {
+		goo.StrLen = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 18 {
+		goo.StrLen = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 18 {
+			return fmt.Errorf("expected field # 18 of gnolang.bodyStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 18 of gnolang.bodyStmt, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.StrLen = int(v)
+	}
+	// Field StrIndex (#19)
+	if len(bz) == 0 {
+		goo.StrIndex = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 19 {
+		goo.StrIndex = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 19 {
+			return fmt.Errorf("expected field # 19 of gnolang.bodyStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 19 of gnolang.bodyStmt, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.StrIndex = int(v)
+	}
+	// Field NextRune (#20)
+	if len(bz) == 0 {
+		goo.NextRune = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 20 {
+		goo.NextRune = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 20 {
+			return fmt.Errorf("expected field # 20 of gnolang.bodyStmt, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 20 of gnolang.bodyStmt, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.NextRune = int32(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo FuncDecl) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field StaticBlock (#2)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.StaticBlock.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field NameExpr (#3)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.NameExpr.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field IsMethod (#4)
+	if goo.IsMethod {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 4, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeBool(buf, bool(goo.IsMethod)); err != nil {
+			return
+		}
+	}
+	// Field Recv (#5)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Recv.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 5, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Type (#6)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Type.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 6, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Body (#7)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 6); err != nil {
+		return
+	}
+	return nil
+}
+
+func (goo *FuncDecl) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.FuncDecl, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.FuncDecl, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field StaticBlock (#2)
+	if len(bz) == 0 {
+		goo.StaticBlock = StaticBlock{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.StaticBlock = StaticBlock{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of gnolang.FuncDecl, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of gnolang.FuncDecl, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.StaticBlock.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field NameExpr (#3)
+	if len(bz) == 0 {
+		goo.NameExpr = NameExpr{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.NameExpr = NameExpr{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of gnolang.FuncDecl, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 3 of gnolang.FuncDecl, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.NameExpr.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field IsMethod (#4)
+	if len(bz) == 0 {
+		goo.IsMethod = false
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 4 {
+		goo.IsMethod = false
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 4 {
+			return fmt.Errorf("expected field # 4 of gnolang.FuncDecl, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 4 of gnolang.FuncDecl, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeBool(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.IsMethod = bool(v)
+	}
+	// Field Recv (#5)
+	if len(bz) == 0 {
+		goo.Recv = FieldTypeExpr{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 5 {
+		goo.Recv = FieldTypeExpr{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 5 {
+			return fmt.Errorf("expected field # 5 of gnolang.FuncDecl, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 5 of gnolang.FuncDecl, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Recv.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Type (#6)
+	if len(bz) == 0 {
+		goo.Type = FuncTypeExpr{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 6 {
+		goo.Type = FuncTypeExpr{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 6 {
+			return fmt.Errorf("expected field # 6 of gnolang.FuncDecl, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 6 of gnolang.FuncDecl, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Type.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Body (#7)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 6, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo ImportDecl) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field NameExpr (#2)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.NameExpr.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field PkgPath (#3)
+	if goo.PkgPath != "" {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(goo.PkgPath)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *ImportDecl) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.ImportDecl, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.ImportDecl, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field NameExpr (#2)
+	if len(bz) == 0 {
+		goo.NameExpr = NameExpr{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.NameExpr = NameExpr{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of gnolang.ImportDecl, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of gnolang.ImportDecl, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.NameExpr.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field PkgPath (#3)
+	if len(bz) == 0 {
+		goo.PkgPath = ""
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.PkgPath = ""
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of gnolang.ImportDecl, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 3 of gnolang.ImportDecl, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.PkgPath = string(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo ValueDecl) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field NameExprs (#2)
+	for _, e := range goo.NameExprs {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		buf2 := new(bytes.Buffer)
+		if err = e.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	// Field Type (#3)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 2); err != nil {
+		return
+	}
+	// Field Values (#4)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 3); err != nil {
+		return
+	}
+	// Field Const (#5)
+	if goo.Const {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 5, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeBool(buf, bool(goo.Const)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *ValueDecl) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.ValueDecl, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.ValueDecl, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field NameExprs (#2)
+	if len(bz) == 0 {
+		goo.NameExprs = nil
+	} else if fnum, _, _, err := amino.DecodeFieldNumberAndTyp3(bz); err != nil {
+		return err
+	} else if fnum <= 2 {
+		var list NameExprs
+		for len(bz) > 0 {
+			fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz)
+			if fnum > 2 {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			if fnum < 2 {
+				return fmt.Errorf("expected repeated field number 2 or greater, got %v", fnum)
+			}
+			if typ != amino.Typ3ByteLength {
+				return fmt.Errorf("expected repeated field type %v, got %v", amino.Typ3ByteLength, typ)
+			}
+			if len(bz) > 0 && bz[0] == 0x00 {
+				bz = bz[1:]
+				list = append(list, NameExpr{})
+				continue
+			}
+			v, n, err := amino.DecodeByteSlice(bz)
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			var e NameExpr
+			if err := e.UnmarshalBinary2(cdc, v); err != nil {
+				return err
+			}
+			list = append(list, e)
+		}
+		goo.NameExprs = list
+	}
+	// Field Type (#3)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 2, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Values (#4)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 3, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Const (#5)
+	if len(bz) == 0 {
+		goo.Const = false
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 5 {
+		goo.Const = false
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 5 {
+			return fmt.Errorf("expected field # 5 of gnolang.ValueDecl, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 5 of gnolang.ValueDecl, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeBool(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Const = bool(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo TypeDecl) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field NameExpr (#2)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.NameExpr.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Type (#3)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 2); err != nil {
+		return
+	}
+	// Field IsAlias (#4)
+	if goo.IsAlias {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 4, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeBool(buf, bool(goo.IsAlias)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *TypeDecl) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.TypeDecl, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.TypeDecl, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field NameExpr (#2)
+	if len(bz) == 0 {
+		goo.NameExpr = NameExpr{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.NameExpr = NameExpr{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of gnolang.TypeDecl, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of gnolang.TypeDecl, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.NameExpr.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Type (#3)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 2, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field IsAlias (#4)
+	if len(bz) == 0 {
+		goo.IsAlias = false
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 4 {
+		goo.IsAlias = false
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 4 {
+			return fmt.Errorf("expected field # 4 of gnolang.TypeDecl, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 4 of gnolang.TypeDecl, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeBool(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.IsAlias = bool(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo StaticBlock) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Block (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Block.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Types (#2)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 1); err != nil {
+		return
+	}
+	// Field NumNames (#3)
+	if goo.NumNames != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeUvarint(buf, uint64(goo.NumNames)); err != nil {
+			return
+		}
+	}
+	// Field Names (#4)
+	for _, e := range goo.Names {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 4, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(e)); err != nil {
+			return
+		}
+	}
+	// Field Consts (#5)
+	for _, e := range goo.Consts {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 5, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(e)); err != nil {
+			return
+		}
+	}
+	// Field Externs (#6)
+	for _, e := range goo.Externs {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 6, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(e)); err != nil {
+			return
+		}
+	}
+	// Field Loc (#7)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Loc.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 7, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	return nil
+}
+
+func (goo *StaticBlock) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Block (#1)
+	if len(bz) == 0 {
+		goo.Block = Block{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Block = Block{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.StaticBlock, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.StaticBlock, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Block.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Types (#2)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 1, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field NumNames (#3)
+	if len(bz) == 0 {
+		goo.NumNames = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.NumNames = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of gnolang.StaticBlock, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 3 of gnolang.StaticBlock, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeUvarint16(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.NumNames = uint16(v)
+	}
+	// Field Names (#4)
+	if len(bz) == 0 {
+		goo.Names = nil
+	} else if fnum, _, _, err := amino.DecodeFieldNumberAndTyp3(bz); err != nil {
+		return err
+	} else if fnum <= 4 {
+		var list []Name
+		for len(bz) > 0 {
+			fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz)
+			if fnum > 4 {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			if fnum < 4 {
+				return fmt.Errorf("expected repeated field number 4 or greater, got %v", fnum)
+			}
+			if typ != amino.Typ3ByteLength {
+				return fmt.Errorf("expected repeated field type %v, got %v", amino.Typ3ByteLength, typ)
+			}
+			if len(bz) > 0 && bz[0] == 0x00 {
+				bz = bz[1:]
+				list = append(list, "")
+				continue
+			}
+			v, n, err := amino.DecodeString(bz)
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			list = append(list, Name(v))
+		}
+		goo.Names = list
+	}
+	// Field Consts (#5)
+	if len(bz) == 0 {
+		goo.Consts = nil
+	} else if fnum, _, _, err := amino.DecodeFieldNumberAndTyp3(bz); err != nil {
+		return err
+	} else if fnum <= 5 {
+		var list []Name
+		for len(bz) > 0 {
+			fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz)
+			if fnum > 5 {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			if fnum < 5 {
+				return fmt.Errorf("expected repeated field number 5 or greater, got %v", fnum)
+			}
+			if typ != amino.Typ3ByteLength {
+				return fmt.Errorf("expected repeated field type %v, got %v", amino.Typ3ByteLength, typ)
+			}
+			if len(bz) > 0 && bz[0] == 0x00 {
+				bz = bz[1:]
+				list = append(list, "")
+				continue
+			}
+			v, n, err := amino.DecodeString(bz)
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			list = append(list, Name(v))
+		}
+		goo.Consts = list
+	}
+	// Field Externs (#6)
+	if len(bz) == 0 {
+		goo.Externs = nil
+	} else if fnum, _, _, err := amino.DecodeFieldNumberAndTyp3(bz); err != nil {
+		return err
+	} else if fnum <= 6 {
+		var list []Name
+		for len(bz) > 0 {
+			fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz)
+			if fnum > 6 {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			if fnum < 6 {
+				return fmt.Errorf("expected repeated field number 6 or greater, got %v", fnum)
+			}
+			if typ != amino.Typ3ByteLength {
+				return fmt.Errorf("expected repeated field type %v, got %v", amino.Typ3ByteLength, typ)
+			}
+			if len(bz) > 0 && bz[0] == 0x00 {
+				bz = bz[1:]
+				list = append(list, "")
+				continue
+			}
+			v, n, err := amino.DecodeString(bz)
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			list = append(list, Name(v))
+		}
+		goo.Externs = list
+	}
+	// Field Loc (#7)
+	if len(bz) == 0 {
+		goo.Loc = Location{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 7 {
+		goo.Loc = Location{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 7 {
+			return fmt.Errorf("expected field # 7 of gnolang.StaticBlock, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 7 of gnolang.StaticBlock, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Loc.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo FileSet) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field Files (#1)
+	for _, e := range goo.Files {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if e == nil {
+			return errors.New("nil struct pointers in lists not supported unless nil_elements field tag is also set")
+		}
+		buf2 := new(bytes.Buffer)
+		if err = e.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *FileSet) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field Files (#1)
+	if len(bz) == 0 {
+		goo.Files = nil
+	} else if fnum, _, _, err := amino.DecodeFieldNumberAndTyp3(bz); err != nil {
+		return err
+	} else if fnum <= 1 {
+		var list []*FileNode
+		for len(bz) > 0 {
+			fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz)
+			if fnum > 1 {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			if fnum < 1 {
+				return fmt.Errorf("expected repeated field number 1 or greater, got %v", fnum)
+			}
+			if typ != amino.Typ3ByteLength {
+				return fmt.Errorf("expected repeated field type %v, got %v", amino.Typ3ByteLength, typ)
+			}
+			v, n, err := amino.DecodeByteSlice(bz)
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			e := new(FileNode)
+			if err := e.UnmarshalBinary2(cdc, v); err != nil {
+				return err
+			}
+			list = append(list, e)
+		}
+		goo.Files = list
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo FileNode) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field StaticBlock (#2)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.StaticBlock.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Name (#3)
+	if goo.Name != "" {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(goo.Name)); err != nil {
+			return
+		}
+	}
+	// Field PkgName (#4)
+	if goo.PkgName != "" {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 4, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(goo.PkgName)); err != nil {
+			return
+		}
+	}
+	// Field Decls (#5)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 4); err != nil {
+		return
+	}
+	return nil
+}
+
+func (goo *FileNode) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.FileNode, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.FileNode, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field StaticBlock (#2)
+	if len(bz) == 0 {
+		goo.StaticBlock = StaticBlock{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.StaticBlock = StaticBlock{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of gnolang.FileNode, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of gnolang.FileNode, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.StaticBlock.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Name (#3)
+	if len(bz) == 0 {
+		goo.Name = ""
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.Name = ""
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of gnolang.FileNode, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 3 of gnolang.FileNode, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Name = Name(v)
+	}
+	// Field PkgName (#4)
+	if len(bz) == 0 {
+		goo.PkgName = ""
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 4 {
+		goo.PkgName = ""
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 4 {
+			return fmt.Errorf("expected field # 4 of gnolang.FileNode, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 4 of gnolang.FileNode, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.PkgName = Name(v)
+	}
+	// Field Decls (#5)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 4, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo PackageNode) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field Attributes (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Attributes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field StaticBlock (#2)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.StaticBlock.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field PkgPath (#3)
+	if goo.PkgPath != "" {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(goo.PkgPath)); err != nil {
+			return
+		}
+	}
+	// Field PkgName (#4)
+	if goo.PkgName != "" {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 4, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(goo.PkgName)); err != nil {
+			return
+		}
+	}
+	// Field FileSet (#5)
+	if goo.FileSet != nil {
+		buf2 := new(bytes.Buffer)
+		if err = goo.FileSet.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 5, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *PackageNode) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field Attributes (#1)
+	if len(bz) == 0 {
+		goo.Attributes = Attributes{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Attributes = Attributes{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.PackageNode, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.PackageNode, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Attributes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field StaticBlock (#2)
+	if len(bz) == 0 {
+		goo.StaticBlock = StaticBlock{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.StaticBlock = StaticBlock{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of gnolang.PackageNode, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of gnolang.PackageNode, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.StaticBlock.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field PkgPath (#3)
+	if len(bz) == 0 {
+		goo.PkgPath = ""
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.PkgPath = ""
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of gnolang.PackageNode, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 3 of gnolang.PackageNode, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.PkgPath = string(v)
+	}
+	// Field PkgName (#4)
+	if len(bz) == 0 {
+		goo.PkgName = ""
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 4 {
+		goo.PkgName = ""
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 4 {
+			return fmt.Errorf("expected field # 4 of gnolang.PackageNode, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 4 of gnolang.PackageNode, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.PkgName = Name(v)
+	}
+	// Field FileSet (#5)
+	if len(bz) == 0 {
+		goo.FileSet = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 5 {
+		goo.FileSet = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 5 {
+			return fmt.Errorf("expected field # 5 of gnolang.PackageNode, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 5 of gnolang.PackageNode, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if goo.FileSet == nil {
+			goo.FileSet = new(FileSet)
+		}
+		if err := goo.FileSet.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo RefNode) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Location (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Location.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field BlockNode (#2)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 1); err != nil {
+		return
+	}
+	return nil
+}
+
+func (goo *RefNode) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Location (#1)
+	if len(bz) == 0 {
+		goo.Location = Location{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Location = Location{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.RefNode, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.RefNode, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Location.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field BlockNode (#2)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 1, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo PointerType) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Elt (#1)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 0); err != nil {
+		return
+	}
+	return nil
+}
+
+func (goo *PointerType) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Elt (#1)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 0, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo ArrayType) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Len (#1)
+	if goo.Len != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Len)); err != nil {
+			return
+		}
+	}
+	// Field Elt (#2)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 1); err != nil {
+		return
+	}
+	// Field Vrd (#3)
+	if goo.Vrd {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeBool(buf, bool(goo.Vrd)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *ArrayType) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Len (#1)
+	if len(bz) == 0 {
+		goo.Len = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Len = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.ArrayType, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.ArrayType, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Len = int(v)
+	}
+	// Field Elt (#2)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 1, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Vrd (#3)
+	if len(bz) == 0 {
+		goo.Vrd = false
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.Vrd = false
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of gnolang.ArrayType, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 3 of gnolang.ArrayType, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeBool(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Vrd = bool(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo SliceType) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Elt (#1)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 0); err != nil {
+		return
+	}
+	// Field Vrd (#2)
+	if goo.Vrd {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeBool(buf, bool(goo.Vrd)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *SliceType) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Elt (#1)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 0, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Vrd (#2)
+	if len(bz) == 0 {
+		goo.Vrd = false
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.Vrd = false
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of gnolang.SliceType, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 2 of gnolang.SliceType, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeBool(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Vrd = bool(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo StructType) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field PkgPath (#1)
+	if goo.PkgPath != "" {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(goo.PkgPath)); err != nil {
+			return
+		}
+	}
+	// Field Fields (#2)
+	for _, e := range goo.Fields {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		buf2 := new(bytes.Buffer)
+		if err = e.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *StructType) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field PkgPath (#1)
+	if len(bz) == 0 {
+		goo.PkgPath = ""
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.PkgPath = ""
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.StructType, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.StructType, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.PkgPath = string(v)
+	}
+	// Field Fields (#2)
+	if len(bz) == 0 {
+		goo.Fields = nil
+	} else if fnum, _, _, err := amino.DecodeFieldNumberAndTyp3(bz); err != nil {
+		return err
+	} else if fnum <= 2 {
+		var list []FieldType
+		for len(bz) > 0 {
+			fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz)
+			if fnum > 2 {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			if fnum < 2 {
+				return fmt.Errorf("expected repeated field number 2 or greater, got %v", fnum)
+			}
+			if typ != amino.Typ3ByteLength {
+				return fmt.Errorf("expected repeated field type %v, got %v", amino.Typ3ByteLength, typ)
+			}
+			if len(bz) > 0 && bz[0] == 0x00 {
+				bz = bz[1:]
+				list = append(list, FieldType{})
+				continue
+			}
+			v, n, err := amino.DecodeByteSlice(bz)
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			var e FieldType
+			if err := e.UnmarshalBinary2(cdc, v); err != nil {
+				return err
+			}
+			list = append(list, e)
+		}
+		goo.Fields = list
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo FieldType) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Name (#1)
+	if goo.Name != "" {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(goo.Name)); err != nil {
+			return
+		}
+	}
+	// Field Type (#2)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 1); err != nil {
+		return
+	}
+	// Field Embedded (#3)
+	if goo.Embedded {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeBool(buf, bool(goo.Embedded)); err != nil {
+			return
+		}
+	}
+	// Field Tag (#4)
+	if goo.Tag != "" {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 4, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(goo.Tag)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *FieldType) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Name (#1)
+	if len(bz) == 0 {
+		goo.Name = ""
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Name = ""
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.FieldType, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.FieldType, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Name = Name(v)
+	}
+	// Field Type (#2)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 1, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Embedded (#3)
+	if len(bz) == 0 {
+		goo.Embedded = false
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.Embedded = false
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of gnolang.FieldType, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 3 of gnolang.FieldType, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeBool(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Embedded = bool(v)
+	}
+	// Field Tag (#4)
+	if len(bz) == 0 {
+		goo.Tag = ""
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 4 {
+		goo.Tag = ""
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 4 {
+			return fmt.Errorf("expected field # 4 of gnolang.FieldType, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 4 of gnolang.FieldType, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Tag = Tag(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo FuncType) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field Params (#1)
+	for _, e := range goo.Params {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		buf2 := new(bytes.Buffer)
+		if err = e.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	// Field Results (#2)
+	for _, e := range goo.Results {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		buf2 := new(bytes.Buffer)
+		if err = e.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *FuncType) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field Params (#1)
+	if len(bz) == 0 {
+		goo.Params = nil
+	} else if fnum, _, _, err := amino.DecodeFieldNumberAndTyp3(bz); err != nil {
+		return err
+	} else if fnum <= 1 {
+		var list []FieldType
+		for len(bz) > 0 {
+			fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz)
+			if fnum > 1 {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			if fnum < 1 {
+				return fmt.Errorf("expected repeated field number 1 or greater, got %v", fnum)
+			}
+			if typ != amino.Typ3ByteLength {
+				return fmt.Errorf("expected repeated field type %v, got %v", amino.Typ3ByteLength, typ)
+			}
+			if len(bz) > 0 && bz[0] == 0x00 {
+				bz = bz[1:]
+				list = append(list, FieldType{})
+				continue
+			}
+			v, n, err := amino.DecodeByteSlice(bz)
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			var e FieldType
+			if err := e.UnmarshalBinary2(cdc, v); err != nil {
+				return err
+			}
+			list = append(list, e)
+		}
+		goo.Params = list
+	}
+	// Field Results (#2)
+	if len(bz) == 0 {
+		goo.Results = nil
+	} else if fnum, _, _, err := amino.DecodeFieldNumberAndTyp3(bz); err != nil {
+		return err
+	} else if fnum <= 2 {
+		var list []FieldType
+		for len(bz) > 0 {
+			fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz)
+			if fnum > 2 {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			if fnum < 2 {
+				return fmt.Errorf("expected repeated field number 2 or greater, got %v", fnum)
+			}
+			if typ != amino.Typ3ByteLength {
+				return fmt.Errorf("expected repeated field type %v, got %v", amino.Typ3ByteLength, typ)
+			}
+			if len(bz) > 0 && bz[0] == 0x00 {
+				bz = bz[1:]
+				list = append(list, FieldType{})
+				continue
+			}
+			v, n, err := amino.DecodeByteSlice(bz)
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			var e FieldType
+			if err := e.UnmarshalBinary2(cdc, v); err != nil {
+				return err
+			}
+			list = append(list, e)
+		}
+		goo.Results = list
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo MapType) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Key (#1)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 0); err != nil {
+		return
+	}
+	// Field Value (#2)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 1); err != nil {
+		return
+	}
+	return nil
+}
+
+func (goo *MapType) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Key (#1)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 0, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Value (#2)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 1, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo InterfaceType) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field PkgPath (#1)
+	if goo.PkgPath != "" {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(goo.PkgPath)); err != nil {
+			return
+		}
+	}
+	// Field Methods (#2)
+	for _, e := range goo.Methods {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		buf2 := new(bytes.Buffer)
+		if err = e.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	// Field Generic (#3)
+	if goo.Generic != "" {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(goo.Generic)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *InterfaceType) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field PkgPath (#1)
+	if len(bz) == 0 {
+		goo.PkgPath = ""
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.PkgPath = ""
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.InterfaceType, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.InterfaceType, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.PkgPath = string(v)
+	}
+	// Field Methods (#2)
+	if len(bz) == 0 {
+		goo.Methods = nil
+	} else if fnum, _, _, err := amino.DecodeFieldNumberAndTyp3(bz); err != nil {
+		return err
+	} else if fnum <= 2 {
+		var list []FieldType
+		for len(bz) > 0 {
+			fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz)
+			if fnum > 2 {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			if fnum < 2 {
+				return fmt.Errorf("expected repeated field number 2 or greater, got %v", fnum)
+			}
+			if typ != amino.Typ3ByteLength {
+				return fmt.Errorf("expected repeated field type %v, got %v", amino.Typ3ByteLength, typ)
+			}
+			if len(bz) > 0 && bz[0] == 0x00 {
+				bz = bz[1:]
+				list = append(list, FieldType{})
+				continue
+			}
+			v, n, err := amino.DecodeByteSlice(bz)
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			var e FieldType
+			if err := e.UnmarshalBinary2(cdc, v); err != nil {
+				return err
+			}
+			list = append(list, e)
+		}
+		goo.Methods = list
+	}
+	// Field Generic (#3)
+	if len(bz) == 0 {
+		goo.Generic = ""
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.Generic = ""
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of gnolang.InterfaceType, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 3 of gnolang.InterfaceType, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Generic = Name(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo TypeType) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	return nil
+}
+
+func (goo *TypeType) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo DeclaredType) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field PkgPath (#1)
+	if goo.PkgPath != "" {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(goo.PkgPath)); err != nil {
+			return
+		}
+	}
+	// Field Name (#2)
+	if goo.Name != "" {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(goo.Name)); err != nil {
+			return
+		}
+	}
+	// Field Base (#3)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 2); err != nil {
+		return
+	}
+	// Field Methods (#4)
+	for _, e := range goo.Methods {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 4, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		buf2 := new(bytes.Buffer)
+		if err = e.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *DeclaredType) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field PkgPath (#1)
+	if len(bz) == 0 {
+		goo.PkgPath = ""
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.PkgPath = ""
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.DeclaredType, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.DeclaredType, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.PkgPath = string(v)
+	}
+	// Field Name (#2)
+	if len(bz) == 0 {
+		goo.Name = ""
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.Name = ""
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of gnolang.DeclaredType, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of gnolang.DeclaredType, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Name = Name(v)
+	}
+	// Field Base (#3)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 2, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Methods (#4)
+	if len(bz) == 0 {
+		goo.Methods = nil
+	} else if fnum, _, _, err := amino.DecodeFieldNumberAndTyp3(bz); err != nil {
+		return err
+	} else if fnum <= 4 {
+		var list []TypedValue
+		for len(bz) > 0 {
+			fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz)
+			if fnum > 4 {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			if fnum < 4 {
+				return fmt.Errorf("expected repeated field number 4 or greater, got %v", fnum)
+			}
+			if typ != amino.Typ3ByteLength {
+				return fmt.Errorf("expected repeated field type %v, got %v", amino.Typ3ByteLength, typ)
+			}
+			if len(bz) > 0 && bz[0] == 0x00 {
+				bz = bz[1:]
+				list = append(list, TypedValue{})
+				continue
+			}
+			v, n, err := amino.DecodeByteSlice(bz)
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			var e TypedValue
+			if err := e.UnmarshalBinary2(cdc, v); err != nil {
+				return err
+			}
+			list = append(list, e)
+		}
+		goo.Methods = list
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo PackageType) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	return nil
+}
+
+func (goo *PackageType) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo ChanType) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Dir (#1)
+	if goo.Dir != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Dir)); err != nil {
+			return
+		}
+	}
+	// Field Elt (#2)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 1); err != nil {
+		return
+	}
+	return nil
+}
+
+func (goo *ChanType) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Dir (#1)
+	if len(bz) == 0 {
+		goo.Dir = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Dir = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.ChanType, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.ChanType, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Dir = ChanDir(v)
+	}
+	// Field Elt (#2)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 1, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo blockType) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	return nil
+}
+
+func (goo *blockType) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo tupleType) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Elts (#1)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 0); err != nil {
+		return
+	}
+	return nil
+}
+
+func (goo *tupleType) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Elts (#1)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 0, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo RefType) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field ID (#1)
+	if goo.ID != "" {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(goo.ID)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *RefType) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field ID (#1)
+	if len(bz) == 0 {
+		goo.ID = ""
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.ID = ""
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of gnolang.RefType, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of gnolang.RefType, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.ID = TypeID(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
@@ -0,0 +1,556 @@
+package gnolang
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/gnolang/gno/tm2/pkg/std"
+)
+
+// Debugger is an interactive, line oriented debugger for the Gno VM.
+// When set on a Machine (see MachineOptions.Debugger), it is called
+// before each statement is executed, and when stopped it reads
+// commands from In and writes their output to Out, until the program
+// is resumed. Type "help" at the prompt for the list of commands.
+//
+// The debugger stops at the first statement executed, then on
+// breakpoints or after a step. Locations are source file lines;
+// a line is "entered" when the first of its statements is about
+// to be executed.
+//
+// The commands are read as lines of text, whether from the terminal
+// or from a tcp connection. Editors use the Debug Adapter Protocol
+// instead, with a debugger returned by NewDAPDebugger.
+type Debugger struct {
+	In  io.Reader
+	Out io.Writer
+
+	scanner     *bufio.Scanner
+	state       debugState
+	breakpoints []Location
+	loc         Location // location of the current statement
+	depth       int      // call depth of the current statement
+	stopDepth   int      // call depth at the last stop, for next/stepout
+	frame       int      // selected frame, 0 being the innermost
+	lastCmd     string
+	sources     map[string][]string // source lines, by pkgpath/file
+	dap         *dapSession         // or nil, for text commands.
+}
+
+// ErrDebuggerQuit is the panic value with which the machine stops
+// when the user quits the debugger. Callers of the machine recover
+// it to end the program without error.
+var ErrDebuggerQuit = errors.New("debugger: quit")
+
+type debugState int
+
+const (
+	debugStep debugState = iota
+	debugNext
+	debugStepOut
+	debugContinue
+	debugDetached
+)
+
+// NewDebugger returns a debugger reading commands from in, and
+// writing to out. It stops at the first statement executed.
+func NewDebugger(in io.Reader, out io.Writer) *Debugger {
+	return &Debugger{
+		In:      in,
+		Out:     out,
+		scanner: bufio.NewScanner(in),
+		state:   debugStep,
+		sources: make(map[string][]string),
+	}
+}
+
+// AddMemPackage makes the source files of memPkg available to the
+// debugger, for the packages that are run without being added to
+// the store, like the files of gno run.
+func (d *Debugger) AddMemPackage(memPkg *std.MemPackage) {
+	for _, mfile := range memPkg.Files {
+		d.sources[memPkg.Path+"/"+mfile.Name] = strings.Split(mfile.Body, "\n")
+	}
+}
+
+// atStmt is called by the machine before executing statement s.
+func (d *Debugger) atStmt(m *Machine, s Stmt) {
+	if d.dap != nil {
+		d.dap.poll(d, m)
+	}
+	if d.state == debugDetached {
+		return
+	}
+	loc := d.stmtLocation(m, s)
+	if loc.File == "" || loc.Line == 0 {
+		return // e.g. the synthetic call to main().
+	}
+	depth := debugCallDepth(m)
+	entered := loc != d.loc || depth != d.depth
+	d.loc, d.depth = loc, depth
+	if !entered {
+		return
+	}
+	stop := false
+	switch d.state {
+	case debugStep:
+		stop = true
+	case debugNext:
+		stop = depth <= d.stopDepth
+	case debugStepOut:
+		stop = depth < d.stopDepth
+	}
+	reason := "step"
+	if !stop && d.breakpointAt(loc) >= 0 {
+		stop, reason = true, "breakpoint"
+	}
+	if !stop {
+		return
+	}
+	d.stopDepth = depth
+	d.frame = 0
+	if d.dap != nil {
+		d.dap.stop(d, m, reason)
+		return
+	}
+	d.printLocation(m)
+	d.prompt(m)
+}
+
+// prompt reads and executes commands until the program is resumed.
+func (d *Debugger) prompt(m *Machine) {
+	for {
+		fmt.Fprint(d.Out, "dbg> ")
+		if !d.scanner.Scan() {
+			// end of input: run the program to completion.
+			fmt.Fprintln(d.Out)
+			d.state = debugDetached
+			return
+		}
+		line := strings.TrimSpace(d.scanner.Text())
+		if line == "" {
+			line = d.lastCmd
+		}
+		if line == "" {
+			continue
+		}
+		d.lastCmd = line
+		args := strings.Fields(line)
+		if d.command(m, args[0], args[1:]) {
+			return
+		}
+	}
+}
+
+// command executes a debugger command, and returns true if the
+// program should be resumed.
+func (d *Debugger) command(m *Machine, cmd string, args []string) (resume bool) {
+	switch cmd {
+	case "break", "b":
+		d.cmdBreak(args)
+	case "breakpoints", "bp":
+		for i, bp := range d.breakpoints {
+			if bp.File != "" { // not cleared.
+				fmt.Fprintf(d.Out, "Breakpoint %d at %s:%d\n", i, bp.File, bp.Line)
+			}
+		}
+	case "clear":
+		d.cmdClear(args)
+	case "continue", "c":
+		d.state = debugContinue
+		return true
+	case "next", "n":
+		d.state = debugNext
+		return true
+	case "step", "s":
+		d.state = debugStep
+		return true
+	case "stepout", "so":
+		d.state = debugStepOut
+		return true
+	case "detach":
+		d.state = debugDetached
+		return true
+	case "exit", "quit", "q":
+		d.state = debugDetached
+		panic(ErrDebuggerQuit)
+	case "stack", "bt":
+		for i, fr := range d.frames(m) {
+			mark := " "
+			if i == d.frame {
+				mark = "*"
+			}
+			fmt.Fprintf(d.Out, "%s%d  %s() %s:%d\n", mark, i, debugFuncName(m, fr.fn), fr.loc.File, fr.loc.Line)
+		}
+	case "up", "down":
+		n := 1
+		if len(args) > 0 {
+			var err error
+			if n, err = strconv.Atoi(args[0]); err != nil {
+				fmt.Fprintf(d.Out, "invalid frame count %q\n", args[0])
+				return false
+			}
+		}
+		if cmd == "down" {
+			n = -n
+		}
+		frames := d.frames(m)
+		if f := d.frame + n; f < 0 || f >= len(frames) {
+			fmt.Fprintln(d.Out, "invalid frame")
+		} else {
+			d.frame = f
+			d.printLocation(m)
+		}
+	case "list", "l":
+		loc := d.selectedFrame(m).loc
+		if len(args) > 0 {
+			line, err := strconv.Atoi(args[0])
+			if err != nil {
+				fmt.Fprintf(d.Out, "invalid line %q\n", args[0])
+				return false
+			}
+			loc.Line = line
+		}
+		d.list(m, loc, d.selectedFrame(m).loc.Line)
+	case "locals":
+		names, values := debugLocals(m, d.selectedFrame(m))
+		for i, name := range names {
+			fmt.Fprintf(d.Out, "%s = %s\n", name, debugValueString(values[i]))
+		}
+	case "print", "p":
+		if len(args) != 1 {
+			fmt.Fprintln(d.Out, "usage: print <name>[.field...]")
+			return false
+		}
+		tv, err := d.lookup(m, args[0])
+		if err != nil {
+			fmt.Fprintln(d.Out, err)
+		} else {
+			fmt.Fprintln(d.Out, debugValueString(tv))
+		}
+	case "help", "h":
+		fmt.Fprint(d.Out, debugHelp)
+	default:
+		fmt.Fprintf(d.Out, "unknown command %q, type help for the list of commands\n", cmd)
+	}
+	return false
+}
+
+const debugHelp = `The following commands are available:
+    break|b [file:]line    Set a breakpoint.
+    breakpoints|bp         List breakpoints.
+    clear [n]              Clear breakpoint n, or all breakpoints.
+    continue|c             Run until a breakpoint or the program ends.
+    next|n                 Step over to the next source line.
+    step|s                 Step into the next source line.
+    stepout|so             Step out of the current function.
+    stack|bt               Print the call stack.
+    up [n], down [n]       Select a caller, or callee frame.
+    list|l [line]          Show source code around the current, or given line.
+    locals                 Print the local variables of the selected frame.
+    print|p <name>         Print a variable, or a field of it (e.g. p.X).
+    detach                 Stop debugging, and run the program to completion.
+    exit|quit|q            Exit the program.
+    help|h                 Print this help.
+An empty line repeats the last command.
+`
+
+func (d *Debugger) cmdBreak(args []string) {
+	if len(args) != 1 {
+		fmt.Fprintln(d.Out, "usage: break [file:]line")
+		return
+	}
+	file, sline := "", args[0]
+	if i := strings.LastIndexByte(sline, ':'); i >= 0 {
+		file, sline = sline[:i], sline[i+1:]
+	}
+	line, err := strconv.Atoi(sline)
+	if err != nil || line <= 0 {
+		fmt.Fprintf(d.Out, "invalid line %q\n", sline)
+		return
+	}
+	if file == "" {
+		file = d.loc.File
+	}
+	bp := Location{File: file, Line: line}
+	d.breakpoints = append(d.breakpoints, bp)
+	fmt.Fprintf(d.Out, "Breakpoint %d at %s:%d\n", len(d.breakpoints)-1, bp.File, bp.Line)
+}
+
+func (d *Debugger) cmdClear(args []string) {
+	if len(args) == 0 {
+		d.breakpoints = nil
+		fmt.Fprintln(d.Out, "Cleared all breakpoints")
+		return
+	}
+	n, err := strconv.Atoi(args[0])
+	if err != nil || n < 0 || n >= len(d.breakpoints) {
+		fmt.Fprintf(d.Out, "invalid breakpoint %q\n", args[0])
+		return
+	}
+	// keep the numbering of other breakpoints.
+	d.breakpoints[n] = Location{}
+	fmt.Fprintf(d.Out, "Cleared breakpoint %d\n", n)
+}
+
+// breakpointAt returns the index of the breakpoint at loc, or -1.
+// The file of a breakpoint matches any file with the same path
+// suffix, e.g. "main.gno" matches "./main.gno", and the other way
+// around, for the absolute paths of editors.
+func (d *Debugger) breakpointAt(loc Location) int {
+	for i, bp := range d.breakpoints {
+		if bp.Line != loc.Line || bp.File == "" {
+			continue
+		}
+		file := filepath.ToSlash(loc.File)
+		if file == bp.File || strings.HasSuffix(file, "/"+bp.File) ||
+			strings.HasSuffix(loc.PkgPath+"/"+file, "/"+bp.File) ||
+			strings.HasSuffix(bp.File, "/"+path.Clean(file)) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (d *Debugger) printLocation(m *Machine) {
+	fr := d.selectedFrame(m)
+	fmt.Fprintf(d.Out, "> %s() %s:%d\n", debugFuncName(m, fr.fn), fr.loc.File, fr.loc.Line)
+	d.list(m, fr.loc, fr.loc.Line)
+}
+
+// list prints the source lines around loc, marking the current line.
+func (d *Debugger) list(m *Machine, loc Location, current int) {
+	lines := d.source(m, loc)
+	if lines == nil {
+		return
+	}
+	const context = 3
+	for l := loc.Line - context; l <= loc.Line+context; l++ {
+		if l < 1 || l > len(lines) {
+			continue
+		}
+		mark := "  "
+		if l == current {
+			mark = "=>"
+		}
+		fmt.Fprintf(d.Out, "%s %4d: %s\n", mark, l, lines[l-1])
+	}
+}
+
+// source returns the lines of the file at loc, from the packages
+// added with AddMemPackage or from the store, or nil if not found.
+func (d *Debugger) source(m *Machine, loc Location) []string {
+	key := loc.PkgPath + "/" + loc.File
+	if lines, ok := d.sources[key]; ok {
+		return lines
+	}
+	body := debugMemFile(m.Store, loc.PkgPath, loc.File)
+	var lines []string
+	if body != "" {
+		lines = strings.Split(body, "\n")
+	}
+	d.sources[key] = lines
+	return lines
+}
+
+func debugMemFile(store Store, pkgPath, name string) (body string) {
+	defer func() {
+		if r := recover(); r != nil {
+			body = "" // not a stored package.
+		}
+	}()
+	if mfile := store.GetMemFile(pkgPath, name); mfile != nil {
+		return mfile.Body
+	}
+	return ""
+}
+
+// lookup resolves a name, followed by struct field selectors, in the
+// blocks of the selected frame and their parents.
+func (d *Debugger) lookup(m *Machine, expr string) (TypedValue, error) {
+	parts := strings.Split(expr, ".")
+	name := Name(parts[0])
+	fr := d.selectedFrame(m)
+	if len(fr.blocks) == 0 {
+		return TypedValue{}, fmt.Errorf("could not find symbol value for %s", name)
+	}
+	var tv TypedValue
+	found := false
+	for b := fr.blocks[0]; b != nil && !found; b = b.GetParent(m.Store) {
+		names := b.GetSource(m.Store).GetBlockNames()
+		for i := len(names) - 1; i >= 0; i-- {
+			if names[i] == name && i < len(b.Values) {
+				tv = b.GetPointerToInt(m.Store, i).Deref()
+				found = true
+				break
+			}
+		}
+	}
+	if !found {
+		return TypedValue{}, fmt.Errorf("could not find symbol value for %s", name)
+	}
+	for _, field := range parts[1:] {
+		if _, ok := baseOf(tv.T).(*PointerType); ok {
+			if tv.V == nil {
+				return TypedValue{}, fmt.Errorf("nil pointer dereference of %s", name)
+			}
+			tv = tv.V.(PointerValue).Deref()
+		}
+		st, ok := baseOf(tv.T).(*StructType)
+		if !ok {
+			return TypedValue{}, fmt.Errorf("%s is not a struct", name)
+		}
+		fillValueTV(m.Store, &tv)
+		sv := tv.V.(*StructValue)
+		idx := -1
+		for i, f := range st.Fields {
+			if string(f.Name) == field {
+				idx = i
+				break
+			}
+		}
+		if idx < 0 {
+			return TypedValue{}, fmt.Errorf("%s has no field %s", name, field)
+		}
+		tv = sv.GetPointerToInt(m.Store, idx).Deref()
+		name = Name(string(name) + "." + field)
+	}
+	return tv, nil
+}
+
+// debugLocals returns the names and values of the local variables of fr.
+func debugLocals(m *Machine, fr debugFrame) (names []Name, values []TypedValue) {
+	for _, b := range fr.blocks {
+		for i, name := range b.GetSource(m.Store).GetBlockNames() {
+			if name == "" || name == "_" || name[0] == '.' || i >= len(b.Values) {
+				continue // e.g. hidden result names.
+			}
+			names = append(names, name)
+			values = append(values, b.GetPointerToInt(m.Store, i).Deref())
+		}
+	}
+	return names, values
+}
+
+// debugFields returns the names and values of the fields of tv, and
+// whether tv is a struct, or a non-nil pointer to one.
+func debugFields(m *Machine, tv TypedValue) (names []Name, values []TypedValue, ok bool) {
+	if _, isPtr := baseOf(tv.T).(*PointerType); isPtr {
+		if tv.V == nil {
+			return nil, nil, false
+		}
+		tv = tv.V.(PointerValue).Deref()
+	}
+	st, isStruct := baseOf(tv.T).(*StructType)
+	if !isStruct || tv.V == nil {
+		return nil, nil, false
+	}
+	fillValueTV(m.Store, &tv)
+	sv := tv.V.(*StructValue)
+	for i, f := range st.Fields {
+		names = append(names, f.Name)
+		values = append(values, sv.GetPointerToInt(m.Store, i).Deref())
+	}
+	return names, values, true
+}
+
+// debugFrame is a Gno function call frame, as seen by the debugger.
+type debugFrame struct {
+	fn     *FuncValue
+	loc    Location
+	blocks []*Block // from innermost to the function block
+}
+
+// frames returns the call frames of m, innermost first.
+func (d *Debugger) frames(m *Machine) []debugFrame {
+	var frames []debugFrame
+	numBlocks := len(m.Blocks)
+	for i := len(m.Frames) - 1; i >= 0; i-- {
+		fr := m.Frames[i]
+		if fr.Func == nil {
+			continue // not a call, or a native call.
+		}
+		blocks := make([]*Block, 0, numBlocks-fr.NumBlocks)
+		for j := numBlocks - 1; j >= fr.NumBlocks; j-- {
+			blocks = append(blocks, m.Blocks[j])
+		}
+		loc := d.loc
+		if len(frames) > 0 && len(blocks) > 0 {
+			loc = debugBlockLocation(m, blocks[0])
+		}
+		frames = append(frames, debugFrame{fn: fr.Func, loc: loc, blocks: blocks})
+		numBlocks = fr.NumBlocks
+	}
+	return frames
+}
+
+func (d *Debugger) selectedFrame(m *Machine) debugFrame {
+	frames := d.frames(m)
+	if d.frame < len(frames) {
+		return frames[d.frame]
+	}
+	return debugFrame{loc: d.loc}
+}
+
+// stmtLocation returns the location of s, executed in the last block.
+func (d *Debugger) stmtLocation(m *Machine, s Stmt) Location {
+	src := m.LastBlock().GetSource(m.Store)
+	if src == nil {
+		return Location{}
+	}
+	loc := src.GetLocation()
+	loc.Line = s.GetLine()
+	loc.Nonce = 0
+	return loc
+}
+
+// debugBlockLocation returns the location of the statement being
+// executed in b.
+func debugBlockLocation(m *Machine, b *Block) Location {
+	loc := b.GetSource(m.Store).GetLocation()
+	if active := b.GetBodyStmt().Active; active != nil {
+		loc.Line = active.GetLine()
+	}
+	loc.Nonce = 0
+	return loc
+}
+
+// debugValueString is like tv.String(), but prints the value pointed
+// to by a non-nil pointer, instead of its address.
+func debugValueString(tv TypedValue) string {
+	if _, ok := baseOf(tv.T).(*PointerType); ok && tv.V != nil {
+		if pv, ok := tv.V.(PointerValue); ok {
+			return "&" + pv.Deref().String()
+		}
+	}
+	return tv.String()
+}
+
+func debugCallDepth(m *Machine) (depth int) {
+	for _, fr := range m.Frames {
+		if fr.Func != nil {
+			depth++
+		}
+	}
+	return depth
+}
+
+func debugFuncName(m *Machine, fv *FuncValue) string {
+	if fv == nil {
+		return "?"
+	}
+	pkgName := fv.PkgPath[strings.LastIndexByte(fv.PkgPath, '/')+1:]
+	if pv := fv.GetPackage(m.Store); pv != nil {
+		pkgName = string(pv.PkgName)
+	}
+	if fv.Name == "" {
+		return pkgName + ".func"
+	}
+	return pkgName + "." + string(fv.Name)
+}
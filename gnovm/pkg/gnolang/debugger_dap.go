@@ -0,0 +1,504 @@
+package gnolang
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/textproto"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// NewDAPDebugger returns a debugger speaking the Debug Adapter Protocol
+// (https://microsoft.github.io/debug-adapter-protocol/) with the client
+// at rw, typically an editor.
+//
+// The program is already running when the client connects, so launch
+// and attach requests are handled alike: the program waits at its first
+// statement until the configurationDone request, then runs until a
+// breakpoint unless stopOnEntry is set. Only struct values, and pointers
+// to them, can be expanded in the variables view.
+func NewDAPDebugger(rw io.ReadWriter) *Debugger {
+	return &Debugger{
+		In:      rw,
+		Out:     io.Discard,
+		state:   debugStep,
+		sources: make(map[string][]string),
+		dap:     newDAPSession(rw),
+	}
+}
+
+// End tells the client that the program ended, and waits for it to
+// disconnect, for a little while. It must be called once the program
+// ends; it does nothing unless d speaks the Debug Adapter Protocol.
+func (d *Debugger) End() {
+	if d.dap != nil {
+		d.dap.end(d)
+	}
+}
+
+// dapEndTimeout is how long End waits for the client to disconnect.
+const dapEndTimeout = 5 * time.Second
+
+// dapThreadID is the id of the only thread of a Gno program.
+const dapThreadID = 1
+
+type dapRequest struct {
+	Seq       int             `json:"seq"`
+	Type      string          `json:"type"`
+	Command   string          `json:"command"`
+	Arguments json.RawMessage `json:"arguments"`
+}
+
+type dapResponse struct {
+	Seq        int         `json:"seq"`
+	Type       string      `json:"type"`
+	RequestSeq int         `json:"request_seq"`
+	Success    bool        `json:"success"`
+	Command    string      `json:"command"`
+	Message    string      `json:"message,omitempty"`
+	Body       interface{} `json:"body,omitempty"`
+}
+
+type dapEvent struct {
+	Seq   int         `json:"seq"`
+	Type  string      `json:"type"`
+	Event string      `json:"event"`
+	Body  interface{} `json:"body,omitempty"`
+}
+
+type dapSource struct {
+	Name            string `json:"name"`
+	Path            string `json:"path,omitempty"`
+	SourceReference int    `json:"sourceReference,omitempty"`
+}
+
+type dapVariable struct {
+	Name               string `json:"name"`
+	Value              string `json:"value"`
+	Type               string `json:"type,omitempty"`
+	VariablesReference int    `json:"variablesReference"`
+}
+
+// dapRef is what a variablesReference refers to: the locals of a frame,
+// or the fields of a value.
+type dapRef struct {
+	frame int
+	tv    *TypedValue
+}
+
+// dapSession is the state of a Debugger speaking the Debug Adapter
+// Protocol.
+type dapSession struct {
+	w            io.Writer
+	reqs         chan dapRequest // closed when the client is gone.
+	seq          int
+	configured   bool
+	stopOnEntry  bool
+	stopped      bool
+	pausing      bool
+	disconnected bool
+	paths        []string   // source paths of the client, from setBreakpoints.
+	refs         []dapRef   // variable references of the current stop, from 1.
+	sourceRefs   []Location // source references, from 1.
+}
+
+func newDAPSession(rw io.ReadWriter) *dapSession {
+	s := &dapSession{
+		w:    rw,
+		reqs: make(chan dapRequest, 16),
+	}
+	go s.read(rw)
+	return s
+}
+
+// read reads the requests of the client, until an error.
+func (s *dapSession) read(r io.Reader) {
+	defer close(s.reqs)
+	tr := textproto.NewReader(bufio.NewReader(r))
+	for {
+		header, err := tr.ReadMIMEHeader()
+		if err != nil {
+			return
+		}
+		n, err := strconv.Atoi(header.Get("Content-Length"))
+		if err != nil || n < 0 {
+			return
+		}
+		body := make([]byte, n)
+		if _, err := io.ReadFull(tr.R, body); err != nil {
+			return
+		}
+		var req dapRequest
+		if err := json.Unmarshal(body, &req); err != nil || req.Type != "request" {
+			return
+		}
+		s.reqs <- req
+	}
+}
+
+func (s *dapSession) send(msg interface{}) {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	// write errors show up as the end of the requests.
+	fmt.Fprintf(s.w, "Content-Length: %d\r\n\r\n%s", len(bz), bz)
+}
+
+func (s *dapSession) event(event string, body interface{}) {
+	s.seq++
+	s.send(dapEvent{Seq: s.seq, Type: "event", Event: event, Body: body})
+}
+
+func (s *dapSession) respond(req dapRequest, body interface{}) {
+	s.seq++
+	s.send(dapResponse{
+		Seq: s.seq, Type: "response", RequestSeq: req.Seq,
+		Success: true, Command: req.Command, Body: body,
+	})
+}
+
+func (s *dapSession) fail(req dapRequest, format string, args ...interface{}) {
+	s.seq++
+	s.send(dapResponse{
+		Seq: s.seq, Type: "response", RequestSeq: req.Seq,
+		Success: false, Command: req.Command, Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// poll handles the requests received while the program runs, like
+// setBreakpoints or pause.
+func (s *dapSession) poll(d *Debugger, m *Machine) {
+	for d.state != debugDetached {
+		select {
+		case req, ok := <-s.reqs:
+			if !ok {
+				d.state = debugDetached
+				return
+			}
+			s.handle(d, m, req)
+		default:
+			return
+		}
+	}
+}
+
+// stop tells the client that the program stopped, and handles its
+// requests until the program is resumed. The first stop waits for the
+// configuration of the client instead.
+func (s *dapSession) stop(d *Debugger, m *Machine, reason string) {
+	s.refs = nil
+	if s.configured {
+		if s.pausing {
+			reason, s.pausing = "pause", false
+		}
+		s.stopped = true
+		s.event("stopped", map[string]interface{}{
+			"reason": reason, "threadId": dapThreadID, "allThreadsStopped": true,
+		})
+	}
+	for req := range s.reqs {
+		if s.handle(d, m, req) {
+			s.stopped = false
+			return
+		}
+	}
+	// the client is gone: run the program to completion.
+	s.stopped = false
+	d.state = debugDetached
+}
+
+func (s *dapSession) end(d *Debugger) {
+	d.state = debugDetached
+	if s.disconnected {
+		return
+	}
+	s.event("terminated", nil)
+	timeout := time.NewTimer(dapEndTimeout)
+	defer timeout.Stop()
+	for {
+		select {
+		case req, ok := <-s.reqs:
+			if !ok || s.handle(d, nil, req) {
+				return
+			}
+		case <-timeout.C:
+			return
+		}
+	}
+}
+
+// handle handles a request of the client with the program at m, which
+// is nil once the program ended, and returns true if the program should
+// be resumed.
+func (s *dapSession) handle(d *Debugger, m *Machine, req dapRequest) (resume bool) {
+	switch req.Command {
+	case "initialize":
+		s.respond(req, map[string]interface{}{
+			"supportsConfigurationDoneRequest": true,
+			"supportsEvaluateForHovers":        true,
+		})
+		s.event("initialized", nil)
+	case "launch", "attach":
+		var args struct {
+			StopOnEntry bool `json:"stopOnEntry"`
+		}
+		json.Unmarshal(req.Arguments, &args)
+		s.stopOnEntry = args.StopOnEntry
+		s.respond(req, nil)
+	case "setBreakpoints":
+		s.setBreakpoints(d, req)
+	case "configurationDone":
+		s.respond(req, nil)
+		if s.configured || m == nil {
+			return false
+		}
+		s.configured = true
+		switch {
+		case s.stopOnEntry:
+			s.stop(d, m, "entry")
+			return true
+		case d.breakpointAt(d.loc) >= 0:
+			s.stop(d, m, "breakpoint")
+			return true
+		}
+		d.state = debugContinue
+		return true
+	case "threads":
+		s.respond(req, map[string]interface{}{
+			"threads": []map[string]interface{}{{"id": dapThreadID, "name": "main"}},
+		})
+	case "pause":
+		if m != nil && !s.stopped {
+			d.state = debugStep
+			s.pausing = true
+		}
+		s.respond(req, nil)
+	case "disconnect":
+		var args struct {
+			TerminateDebuggee bool `json:"terminateDebuggee"`
+		}
+		json.Unmarshal(req.Arguments, &args)
+		s.respond(req, nil)
+		s.disconnected = true
+		d.state = debugDetached
+		if args.TerminateDebuggee && m != nil {
+			panic(ErrDebuggerQuit)
+		}
+		return true
+	case "source":
+		var args struct {
+			SourceReference int `json:"sourceReference"`
+		}
+		json.Unmarshal(req.Arguments, &args)
+		if args.SourceReference < 1 || args.SourceReference > len(s.sourceRefs) || m == nil {
+			s.fail(req, "unknown source %d", args.SourceReference)
+			return false
+		}
+		lines := d.source(m, s.sourceRefs[args.SourceReference-1])
+		s.respond(req, map[string]interface{}{"content": strings.Join(lines, "\n")})
+	case "continue", "next", "stepIn", "stepOut",
+		"stackTrace", "scopes", "variables", "evaluate":
+		if m == nil || !s.stopped {
+			s.fail(req, "the program is not stopped")
+			return false
+		}
+		return s.handleStopped(d, m, req)
+	default:
+		s.fail(req, "unsupported request %q", req.Command)
+	}
+	return false
+}
+
+// handleStopped handles the requests which are valid only while the
+// program is stopped.
+func (s *dapSession) handleStopped(d *Debugger, m *Machine, req dapRequest) (resume bool) {
+	switch req.Command {
+	case "continue":
+		d.state = debugContinue
+		s.respond(req, map[string]interface{}{"allThreadsContinued": true})
+		return true
+	case "next":
+		d.state = debugNext
+		s.respond(req, nil)
+		return true
+	case "stepIn":
+		d.state = debugStep
+		s.respond(req, nil)
+		return true
+	case "stepOut":
+		d.state = debugStepOut
+		s.respond(req, nil)
+		return true
+	case "stackTrace":
+		frames := dapFrames(d, m)
+		stackFrames := make([]map[string]interface{}, len(frames))
+		for i, fr := range frames {
+			stackFrames[i] = map[string]interface{}{
+				"id":     i + 1,
+				"name":   debugFuncName(m, fr.fn),
+				"source": s.source(fr.loc),
+				"line":   fr.loc.Line,
+				"column": 1,
+			}
+		}
+		s.respond(req, map[string]interface{}{
+			"stackFrames": stackFrames,
+			"totalFrames": len(frames),
+		})
+	case "scopes":
+		var args struct {
+			FrameID int `json:"frameId"`
+		}
+		json.Unmarshal(req.Arguments, &args)
+		if args.FrameID < 1 || args.FrameID > len(dapFrames(d, m)) {
+			s.fail(req, "unknown frame %d", args.FrameID)
+			return false
+		}
+		s.respond(req, map[string]interface{}{
+			"scopes": []map[string]interface{}{{
+				"name":               "Locals",
+				"variablesReference": s.ref(dapRef{frame: args.FrameID - 1}),
+				"expensive":          false,
+			}},
+		})
+	case "variables":
+		var args struct {
+			VariablesReference int `json:"variablesReference"`
+		}
+		json.Unmarshal(req.Arguments, &args)
+		if args.VariablesReference < 1 || args.VariablesReference > len(s.refs) {
+			s.fail(req, "unknown variables reference %d", args.VariablesReference)
+			return false
+		}
+		var names []Name
+		var values []TypedValue
+		if ref := s.refs[args.VariablesReference-1]; ref.tv == nil {
+			d.frame = ref.frame
+			names, values = debugLocals(m, d.selectedFrame(m))
+		} else {
+			names, values, _ = debugFields(m, *ref.tv)
+		}
+		vars := make([]dapVariable, len(names))
+		for i := range names {
+			vars[i] = s.variable(m, string(names[i]), values[i])
+		}
+		s.respond(req, map[string]interface{}{"variables": vars})
+	case "evaluate":
+		var args struct {
+			Expression string `json:"expression"`
+			FrameID    int    `json:"frameId"`
+		}
+		json.Unmarshal(req.Arguments, &args)
+		d.frame = 0
+		if args.FrameID > 0 {
+			d.frame = args.FrameID - 1
+		}
+		tv, err := d.lookup(m, strings.TrimSpace(args.Expression))
+		if err != nil {
+			s.fail(req, "%v", err)
+			return false
+		}
+		v := s.variable(m, args.Expression, tv)
+		s.respond(req, map[string]interface{}{
+			"result":             v.Value,
+			"type":               v.Type,
+			"variablesReference": v.VariablesReference,
+		})
+	}
+	return false
+}
+
+// dapFrames returns the call frames of m, or a frame of the current
+// location outside of calls, like package level declarations, since
+// clients expect a frame where the program stops.
+func dapFrames(d *Debugger, m *Machine) []debugFrame {
+	if frames := d.frames(m); len(frames) > 0 {
+		return frames
+	}
+	return []debugFrame{{loc: d.loc}}
+}
+
+func (s *dapSession) setBreakpoints(d *Debugger, req dapRequest) {
+	var args struct {
+		Source struct {
+			Path string `json:"path"`
+		} `json:"source"`
+		Breakpoints []struct {
+			Line int `json:"line"`
+		} `json:"breakpoints"`
+	}
+	if err := json.Unmarshal(req.Arguments, &args); err != nil || args.Source.Path == "" {
+		s.fail(req, "invalid arguments")
+		return
+	}
+	file := filepath.ToSlash(args.Source.Path)
+	known := false
+	for _, p := range s.paths {
+		known = known || p == file
+	}
+	if !known {
+		s.paths = append(s.paths, file)
+	}
+	// the breakpoints of the request replace those of the source.
+	for i, bp := range d.breakpoints {
+		if bp.File == file {
+			d.breakpoints[i] = Location{}
+		}
+	}
+	bps := make([]map[string]interface{}, len(args.Breakpoints))
+	for i, bp := range args.Breakpoints {
+		d.breakpoints = append(d.breakpoints, Location{File: file, Line: bp.Line})
+		bps[i] = map[string]interface{}{
+			"id":       len(d.breakpoints) - 1,
+			"verified": true,
+			"line":     bp.Line,
+		}
+	}
+	s.respond(req, map[string]interface{}{"breakpoints": bps})
+}
+
+// source returns the source of loc for the client: the path of the file
+// if the client set breakpoints in it, or else a reference with which
+// the client requests its content.
+func (s *dapSession) source(loc Location) dapSource {
+	file := path.Clean(filepath.ToSlash(loc.File))
+	src := dapSource{Name: path.Base(file)}
+	for _, p := range s.paths {
+		if p == file || strings.HasSuffix(p, "/"+file) {
+			src.Path = p
+			return src
+		}
+	}
+	loc.Line = 0
+	for i, ref := range s.sourceRefs {
+		if ref == loc {
+			src.SourceReference = i + 1
+			return src
+		}
+	}
+	s.sourceRefs = append(s.sourceRefs, loc)
+	src.SourceReference = len(s.sourceRefs)
+	return src
+}
+
+func (s *dapSession) ref(ref dapRef) int {
+	s.refs = append(s.refs, ref)
+	return len(s.refs)
+}
+
+func (s *dapSession) variable(m *Machine, name string, tv TypedValue) dapVariable {
+	v := dapVariable{Name: name, Value: debugValueString(tv)}
+	if tv.T != nil {
+		v.Type = tv.T.String()
+	}
+	if _, _, ok := debugFields(m, tv); ok {
+		v.VariablesReference = s.ref(dapRef{tv: &tv})
+	}
+	return v
+}
@@ -0,0 +1,305 @@
+package gnolang
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/textproto"
+	"runtime"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gnolang/gno/tm2/pkg/std"
+)
+
+// dapClient is a DAP client, for tests.
+type dapClient struct {
+	t    *testing.T
+	conn net.Conn
+	r    *textproto.Reader
+	seq  int
+}
+
+type dapMessage struct {
+	Type       string          `json:"type"`
+	Command    string          `json:"command"`
+	Event      string          `json:"event"`
+	RequestSeq int             `json:"request_seq"`
+	Success    bool            `json:"success"`
+	Message    string          `json:"message"`
+	Body       json.RawMessage `json:"body"`
+}
+
+// fatalf fails the test, and stops the client, which ends the debugging
+// session: the program then runs to completion.
+func (c *dapClient) fatalf(format string, args ...interface{}) {
+	c.t.Errorf(format, args...)
+	runtime.Goexit()
+}
+
+func (c *dapClient) request(command string, args interface{}) {
+	c.seq++
+	bz, _ := json.Marshal(map[string]interface{}{
+		"seq": c.seq, "type": "request", "command": command, "arguments": args,
+	})
+	fmt.Fprintf(c.conn, "Content-Length: %d\r\n\r\n%s", len(bz), bz)
+}
+
+func (c *dapClient) read() dapMessage {
+	header, err := c.r.ReadMIMEHeader()
+	if err != nil {
+		c.fatalf("reading header: %v", err)
+	}
+	n, _ := strconv.Atoi(header.Get("Content-Length"))
+	body := make([]byte, n)
+	if _, err := io.ReadFull(c.r.R, body); err != nil {
+		c.fatalf("reading body: %v", err)
+	}
+	var msg dapMessage
+	if err := json.Unmarshal(body, &msg); err != nil {
+		c.fatalf("decoding %s: %v", body, err)
+	}
+	return msg
+}
+
+// expect reads a message, which must be the response to command or the
+// event named event, and decodes its body into body.
+func (c *dapClient) expect(typ, name string, body interface{}) dapMessage {
+	msg := c.read()
+	if msg.Type != typ || (msg.Command != name && msg.Event != name) {
+		c.fatalf("expected %s %s, got %+v", typ, name, msg)
+	}
+	if typ == "response" && !msg.Success {
+		c.fatalf("%s failed: %s", name, msg.Message)
+	}
+	if body != nil {
+		if err := json.Unmarshal(msg.Body, body); err != nil {
+			c.fatalf("decoding %s body: %v", name, err)
+		}
+	}
+	return msg
+}
+
+// runDAP runs src with a DAP debugger, whose client is driven by
+// client, and returns the program output.
+func runDAP(t *testing.T, src string, client func(c *dapClient)) string {
+	t.Helper()
+
+	sconn, cconn := net.Pipe()
+	defer sconn.Close()
+	cconn.SetDeadline(time.Now().Add(10 * time.Second))
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer cconn.Close()
+		client(&dapClient{t: t, conn: cconn, r: textproto.NewReader(bufio.NewReader(cconn))})
+	}()
+
+	out := new(bytes.Buffer)
+	d := NewDAPDebugger(sconn)
+	d.AddMemPackage(&std.MemPackage{
+		Name:  "main",
+		Path:  "main",
+		Files: []*std.MemFile{{Name: "main.gno", Body: src}},
+	})
+	m := NewMachineWithOptions(MachineOptions{
+		PkgPath:  "main",
+		Output:   out,
+		Debugger: d,
+	})
+	m.RunFiles(MustParseFile("main.gno", src))
+	m.RunMain()
+	d.End()
+	<-done
+	return out.String()
+}
+
+func TestDAPDebuggerBreakpoint(t *testing.T) {
+	out := runDAP(t, debugSource, func(c *dapClient) {
+		c.request("initialize", map[string]interface{}{"adapterID": "gno"})
+		c.expect("response", "initialize", nil)
+		c.expect("event", "initialized", nil)
+		c.request("attach", nil)
+		c.expect("response", "attach", nil)
+
+		var bps struct {
+			Breakpoints []struct {
+				Verified bool `json:"verified"`
+				Line     int  `json:"line"`
+			} `json:"breakpoints"`
+		}
+		c.request("setBreakpoints", map[string]interface{}{
+			"source":      map[string]interface{}{"path": "/home/gnome/proj/main.gno"},
+			"breakpoints": []map[string]interface{}{{"line": 8}},
+		})
+		c.expect("response", "setBreakpoints", &bps)
+		if len(bps.Breakpoints) != 1 || !bps.Breakpoints[0].Verified || bps.Breakpoints[0].Line != 8 {
+			t.Errorf("unexpected breakpoints %+v", bps)
+		}
+		c.request("configurationDone", nil)
+		c.expect("response", "configurationDone", nil)
+
+		var stopped struct {
+			Reason   string `json:"reason"`
+			ThreadID int    `json:"threadId"`
+		}
+		c.expect("event", "stopped", &stopped)
+		if stopped.Reason != "breakpoint" || stopped.ThreadID != 1 {
+			t.Errorf("unexpected stopped event %+v", stopped)
+		}
+
+		var trace struct {
+			StackFrames []struct {
+				ID     int    `json:"id"`
+				Name   string `json:"name"`
+				Line   int    `json:"line"`
+				Source struct {
+					Path string `json:"path"`
+				} `json:"source"`
+			} `json:"stackFrames"`
+		}
+		c.request("stackTrace", map[string]interface{}{"threadId": 1})
+		c.expect("response", "stackTrace", &trace)
+		if len(trace.StackFrames) != 2 {
+			c.fatalf("expected 2 frames, got %+v", trace)
+		}
+		if f := trace.StackFrames[0]; f.Name != "main.add" || f.Line != 8 || f.Source.Path != "/home/gnome/proj/main.gno" {
+			t.Errorf("unexpected frame %+v", f)
+		}
+		if f := trace.StackFrames[1]; f.Name != "main.main" || f.Line != 14 {
+			t.Errorf("unexpected frame %+v", f)
+		}
+
+		// the locals of main, and the fields of p.
+		var scopes struct {
+			Scopes []struct {
+				VariablesReference int `json:"variablesReference"`
+			} `json:"scopes"`
+		}
+		c.request("scopes", map[string]interface{}{"frameId": trace.StackFrames[1].ID})
+		c.expect("response", "scopes", &scopes)
+		var vars struct {
+			Variables []dapVariable `json:"variables"`
+		}
+		c.request("variables", map[string]interface{}{"variablesReference": scopes.Scopes[0].VariablesReference})
+		c.expect("response", "variables", &vars)
+		if len(vars.Variables) == 0 || vars.Variables[0].Name != "p" || vars.Variables[0].VariablesReference == 0 {
+			c.fatalf("unexpected variables %+v", vars)
+		}
+		c.request("variables", map[string]interface{}{"variablesReference": vars.Variables[0].VariablesReference})
+		c.expect("response", "variables", &vars)
+		if len(vars.Variables) != 2 || vars.Variables[1].Name != "Y" || vars.Variables[1].Value != "(2 int)" {
+			t.Errorf("unexpected fields %+v", vars)
+		}
+
+		var eval struct {
+			Result string `json:"result"`
+		}
+		c.request("evaluate", map[string]interface{}{"expression": "a", "frameId": 1})
+		c.expect("response", "evaluate", &eval)
+		if eval.Result != "(1 int)" {
+			t.Errorf("unexpected evaluation of a: %q", eval.Result)
+		}
+
+		c.request("next", map[string]interface{}{"threadId": 1})
+		c.expect("response", "next", nil)
+		c.expect("event", "stopped", &stopped)
+		if stopped.Reason != "step" {
+			t.Errorf("unexpected stopped event %+v", stopped)
+		}
+		c.request("stackTrace", map[string]interface{}{"threadId": 1})
+		c.expect("response", "stackTrace", &trace)
+		if f := trace.StackFrames[0]; f.Line != 9 {
+			t.Errorf("expected to stop at line 9, got %+v", f)
+		}
+
+		c.request("continue", map[string]interface{}{"threadId": 1})
+		c.expect("response", "continue", nil)
+		c.expect("event", "terminated", nil)
+		c.request("disconnect", nil)
+		c.expect("response", "disconnect", nil)
+	})
+	if out != "3\n" {
+		t.Errorf("unexpected program output %q", out)
+	}
+}
+
+func TestDAPDebuggerStopOnEntry(t *testing.T) {
+	out := runDAP(t, debugSource, func(c *dapClient) {
+		c.request("initialize", nil)
+		c.expect("response", "initialize", nil)
+		c.expect("event", "initialized", nil)
+		c.request("launch", map[string]interface{}{"stopOnEntry": true})
+		c.expect("response", "launch", nil)
+		c.request("configurationDone", nil)
+		c.expect("response", "configurationDone", nil)
+
+		var stopped struct {
+			Reason string `json:"reason"`
+		}
+		c.expect("event", "stopped", &stopped)
+		if stopped.Reason != "entry" {
+			t.Errorf("unexpected stopped event %+v", stopped)
+		}
+
+		// the source of main.gno is unknown to the client.
+		var trace struct {
+			StackFrames []struct {
+				Source dapSource `json:"source"`
+			} `json:"stackFrames"`
+		}
+		c.request("stackTrace", map[string]interface{}{"threadId": 1})
+		c.expect("response", "stackTrace", &trace)
+		ref := trace.StackFrames[0].Source.SourceReference
+		if ref == 0 {
+			c.fatalf("expected a source reference, got %+v", trace)
+		}
+		var source struct {
+			Content string `json:"content"`
+		}
+		c.request("source", map[string]interface{}{"sourceReference": ref})
+		c.expect("response", "source", &source)
+		if source.Content != debugSource {
+			t.Errorf("unexpected source %q", source.Content)
+		}
+
+		// the requests on the program are refused once it ended.
+		c.request("continue", map[string]interface{}{"threadId": 1})
+		c.expect("response", "continue", nil)
+		c.expect("event", "terminated", nil)
+		c.request("stackTrace", map[string]interface{}{"threadId": 1})
+		if msg := c.read(); msg.Success {
+			t.Errorf("stackTrace should fail once the program ended")
+		}
+		c.request("disconnect", nil)
+		c.expect("response", "disconnect", nil)
+	})
+	if out != "3\n" {
+		t.Errorf("unexpected program output %q", out)
+	}
+}
+
+func TestDAPDebuggerTerminate(t *testing.T) {
+	defer func() {
+		if r := recover(); r != ErrDebuggerQuit {
+			t.Errorf("expected panic with ErrDebuggerQuit, got %v", r)
+		}
+	}()
+	out := runDAP(t, debugSource, func(c *dapClient) {
+		c.request("initialize", nil)
+		c.expect("response", "initialize", nil)
+		c.expect("event", "initialized", nil)
+		c.request("launch", map[string]interface{}{"stopOnEntry": true})
+		c.expect("response", "launch", nil)
+		c.request("configurationDone", nil)
+		c.expect("response", "configurationDone", nil)
+		c.expect("event", "stopped", nil)
+		c.request("disconnect", map[string]interface{}{"terminateDebuggee": true})
+		c.expect("response", "disconnect", nil)
+	})
+	t.Errorf("program should not complete, got output %q", out)
+}
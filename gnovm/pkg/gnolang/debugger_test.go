@@ -0,0 +1,96 @@
+package gnolang
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/gnolang/gno/tm2/pkg/std"
+)
+
+func runDebugger(t *testing.T, src, cmds string) (string, string) {
+	t.Helper()
+
+	out := new(bytes.Buffer)
+	dout := new(bytes.Buffer)
+	d := NewDebugger(strings.NewReader(cmds), dout)
+	d.AddMemPackage(&std.MemPackage{
+		Name:  "main",
+		Path:  "main",
+		Files: []*std.MemFile{{Name: "main.gno", Body: src}},
+	})
+	m := NewMachineWithOptions(MachineOptions{
+		PkgPath:  "main",
+		Output:   out,
+		Debugger: d,
+	})
+	n := MustParseFile("main.gno", src)
+	m.RunFiles(n)
+	m.RunMain()
+	return out.String(), dout.String()
+}
+
+const debugSource = `package main
+
+type point struct {
+	X, Y int
+}
+
+func add(a, b int) int {
+	c := a + b
+	return c
+}
+
+func main() {
+	p := point{X: 1, Y: 2}
+	r := add(p.X, p.Y)
+	println(r)
+}
+`
+
+func TestDebuggerBreakpoint(t *testing.T) {
+	cmds := strings.Join([]string{
+		"break 8",
+		"continue",
+		"print a",
+		"print b",
+		"stack",
+		"up",
+		"print p.Y",
+		"continue",
+	}, "\n")
+	out, dout := runDebugger(t, debugSource, cmds)
+
+	if out != "3\n" {
+		t.Errorf("unexpected program output %q", out)
+	}
+	for _, want := range []string{
+		"Breakpoint 0 at main.gno:8",
+		"> main.add() main.gno:8",
+		"=>    8: \tc := a + b",
+		"(1 int)",
+		"(2 int)",
+		"main.main() main.gno:14",
+	} {
+		if !strings.Contains(dout, want) {
+			t.Errorf("debugger output should contain %q, got:\n%s", want, dout)
+		}
+	}
+}
+
+func TestDebuggerDetachOnEOF(t *testing.T) {
+	out, _ := runDebugger(t, debugSource, "")
+	if out != "3\n" {
+		t.Errorf("unexpected program output %q", out)
+	}
+}
+
+func TestDebuggerQuit(t *testing.T) {
+	defer func() {
+		if r := recover(); r != ErrDebuggerQuit {
+			t.Errorf("expected panic with ErrDebuggerQuit, got %v", r)
+		}
+	}()
+	out, _ := runDebugger(t, debugSource, "quit")
+	t.Errorf("program should not complete, got output %q", out)
+}
@@ -40,9 +40,10 @@ type Machine struct {
 	ReadOnly   bool
 	MaxCycles  int64
 
-	Output  io.Writer
-	Store   Store
-	Context interface{}
+	Output   io.Writer
+	Store    Store
+	Context  interface{}
+	Debugger *Debugger // or nil.
 }
 
 // machine.Release() must be called on objects
@@ -71,6 +72,7 @@ type MachineOptions struct {
 	Alloc         *Allocator // or see MaxAllocBytes.
 	MaxAllocBytes int64      // or 0 for no limit.
 	MaxCycles     int64      // or 0 for no limit.
+	Debugger      *Debugger  // or nil.
 }
 
 // the machine constructor gets spammed
@@ -129,6 +131,7 @@ func NewMachineWithOptions(opts MachineOptions) *Machine {
 		Output:     output,
 		Store:      store,
 		Context:    context,
+		Debugger:   opts.Debugger,
 	}
 
 	if pv != nil {
@@ -183,27 +186,34 @@ func (m *Machine) SetActivePackage(pv *PackageValue) {
 func (m *Machine) PreprocessAllFilesAndSaveBlockNodes() {
 	ch := m.Store.IterMemPackage()
 	for memPkg := range ch {
-		fset := ParseMemPackage(memPkg)
-		pn := NewPackageNode(Name(memPkg.Name), memPkg.Path, fset)
-		m.Store.SetBlockNode(pn)
-		PredefineFileSet(m.Store, pn, fset)
-		for _, fn := range fset.Files {
-			// Save Types to m.Store (while preprocessing).
-			fn = Preprocess(m.Store, pn, fn).(*FileNode)
-			// Save BlockNodes to m.Store.
-			SaveBlockNodes(m.Store, fn)
-		}
-		// Normally, the fileset would be added onto the
-		// package node only after runFiles(), but we cannot
-		// run files upon restart (only preprocess them).
-		// So, add them here instead.
-		// TODO: is this right?
-		if pn.FileSet == nil {
-			pn.FileSet = fset
-		} else {
-			// This happens for non-realm file tests.
-			// TODO ensure the files are the same.
-		}
+		m.PreprocessFilesAndSaveBlockNodes(memPkg)
+	}
+}
+
+// Preprocesses the files of a package already in the store, and saves
+// the resulting block nodes, without running the files. This restores
+// the package nodes, which are not persisted.
+func (m *Machine) PreprocessFilesAndSaveBlockNodes(memPkg *std.MemPackage) {
+	fset := ParseMemPackage(memPkg)
+	pn := NewPackageNode(Name(memPkg.Name), memPkg.Path, fset)
+	m.Store.SetBlockNode(pn)
+	PredefineFileSet(m.Store, pn, fset)
+	for _, fn := range fset.Files {
+		// Save Types to m.Store (while preprocessing).
+		fn = Preprocess(m.Store, pn, fn).(*FileNode)
+		// Save BlockNodes to m.Store.
+		SaveBlockNodes(m.Store, fn)
+	}
+	// Normally, the fileset would be added onto the
+	// package node only after runFiles(), but we cannot
+	// run files upon restart (only preprocess them).
+	// So, add them here instead.
+	// TODO: is this right?
+	if pn.FileSet == nil {
+		pn.FileSet = fset
+	} else {
+		// This happens for non-realm file tests.
+		// TODO ensure the files are the same.
 	}
 }
 
@@ -557,8 +567,10 @@ func (m *Machine) savePackageValuesAndTypes() {
 func (m *Machine) RunFunc(fn Name) {
 	defer func() {
 		if r := recover(); r != nil {
-			fmt.Printf("Machine.RunFunc(%q) panic: %v\n%s\n",
-				fn, r, m.String())
+			if r != ErrDebuggerQuit {
+				fmt.Printf("Machine.RunFunc(%q) panic: %v\n%s\n",
+					fn, r, m.String())
+			}
 			panic(r)
 		}
 	}()
@@ -568,8 +580,10 @@ func (m *Machine) RunFunc(fn Name) {
 func (m *Machine) RunMain() {
 	defer func() {
 		if r := recover(); r != nil {
-			fmt.Printf("Machine.RunMain() panic: %v\n%s\n",
-				r, m.String())
+			if r != ErrDebuggerQuit {
+				fmt.Printf("Machine.RunMain() panic: %v\n%s\n",
+					r, m.String())
+			}
 			panic(r)
 		}
 	}()
@@ -870,6 +884,13 @@ func (m *Machine) incrCPU(cycles int64) {
 	}
 }
 
+// IncrCPU charges a fixed number of cycles on behalf of a native function
+// whose cost isn't covered by OpCPUCallNativeBody, such as signature
+// verification.
+func (m *Machine) IncrCPU(cycles int64) {
+	m.incrCPU(cycles)
+}
+
 const (
 	/* Control operators */
 	OpCPUInvalid             = 1
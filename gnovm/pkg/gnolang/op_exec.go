@@ -431,6 +431,9 @@ EXEC_SWITCH:
 	if debug {
 		debug.Printf("EXEC: %v\n", s)
 	}
+	if m.Debugger != nil {
+		m.Debugger.atStmt(m, s)
+	}
 	switch cs := s.(type) {
 	case *AssignStmt:
 		switch cs.Op {
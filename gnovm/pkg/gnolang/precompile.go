@@ -34,6 +34,7 @@ var stdlibWhitelist = []string{
 	"bytes",
 	"compress/gzip",
 	"context",
+	"crypto/ed25519",
 	"crypto/md5",
 	"crypto/sha1",
 	"encoding/json",
@@ -66,6 +67,22 @@ var importPrefixWhitelist = []string{
 
 const ImportPrefix = "github.com/gnolang/gno"
 
+// IsStdlibWhitelisted returns whether importPath is a standard library
+// which may be imported by non-test gno files.
+func IsStdlibWhitelisted(importPath string) bool {
+	for _, whitelisted := range stdlibWhitelist {
+		if importPath == whitelisted {
+			return true
+		}
+	}
+	for _, whitelisted := range importPrefixWhitelist {
+		if strings.HasPrefix(importPath, whitelisted) {
+			return true
+		}
+	}
+	return false
+}
+
 type precompileResult struct {
 	Imports    []*ast.ImportSpec
 	Translated string
@@ -108,7 +125,7 @@ func GetPrecompileFilenameAndTags(gnoFilePath string) (targetFilename, tags stri
 }
 
 func PrecompileAndCheckMempkg(mempkg *std.MemPackage) error {
-	gofmt := "gofmt"
+	gofmt := "" // check syntax in-process.
 
 	tmpDir, err := ioutil.TempDir("", mempkg.Name)
 	if err != nil {
@@ -180,10 +197,17 @@ func Precompile(source string, tags string, filename string) (*precompileResult,
 }
 
 // PrecompileVerifyFile tries to run `go fmt` against a precompiled .go file.
+// If gofmtBinary is empty, the file is parsed in-process instead.
 //
 // This is fast and won't look the imports.
 func PrecompileVerifyFile(path string, gofmtBinary string) error {
-	// TODO: use cmd/parser instead of exec?
+	if gofmtBinary == "" {
+		_, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.AllErrors)
+		if err != nil {
+			return fmt.Errorf("parse: %w", err)
+		}
+		return nil
+	}
 
 	args := strings.Split(gofmtBinary, " ")
 	args = append(args, []string{"-l", "-e", path}...)
@@ -271,24 +295,7 @@ func precompileAST(fset *token.FileSet, f *ast.File, checkWhitelist bool) (ast.N
 					continue
 				}
 
-				valid := false
-				for _, whitelisted := range stdlibWhitelist {
-					if importPath == whitelisted {
-						valid = true
-						break
-					}
-				}
-				if valid {
-					continue
-				}
-
-				for _, whitelisted := range importPrefixWhitelist {
-					if strings.HasPrefix(importPath, whitelisted) {
-						valid = true
-						break
-					}
-				}
-				if valid {
+				if IsStdlibWhitelisted(importPath) {
 					continue
 				}
 
@@ -6,6 +6,8 @@ import (
 	"go/format"
 	"go/parser"
 	"go/token"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -88,3 +90,18 @@ func TestPrecompile(t *testing.T) {
 		})
 	}
 }
+
+func TestPrecompileVerifyFileBuiltin(t *testing.T) {
+	dir := t.TempDir()
+
+	valid := filepath.Join(dir, "valid.go")
+	assert.NoError(t, os.WriteFile(valid, []byte("package foo\n\nfunc Foo() {}\n"), 0o644))
+	assert.NoError(t, PrecompileVerifyFile(valid, ""))
+
+	invalid := filepath.Join(dir, "invalid.go")
+	assert.NoError(t, os.WriteFile(invalid, []byte("package foo\n\nfunc Foo() {\n"), 0o644))
+	err := PrecompileVerifyFile(invalid, "")
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), invalid+":3:")
+	}
+}
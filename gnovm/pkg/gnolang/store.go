@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/gnolang/gno/tm2/pkg/amino"
+	"github.com/gnolang/gno/tm2/pkg/crypto"
 	"github.com/gnolang/gno/tm2/pkg/std"
 	"github.com/gnolang/gno/tm2/pkg/store"
 )
@@ -49,6 +50,8 @@ type Store interface {
 	AddMemPackage(memPkg *std.MemPackage)
 	GetMemPackage(path string) *std.MemPackage
 	GetMemFile(path string, name string) *std.MemFile
+	GetRealmPathByAddr(addr crypto.Address) string
+	IndexRealmPaths() // for realms added before GetRealmPathByAddr.
 	IterMemPackage() <-chan *std.MemPackage
 	ClearObjectCache()                           // for each delivertx.
 	Fork() Store                                 // for checktx, simulate, and queries.
@@ -529,14 +532,26 @@ func (ds *defaultStore) AddMemPackage(memPkg *std.MemPackage) {
 	ds.baseStore.Set(idxkey, []byte(memPkg.Path))
 	pathkey := []byte(backendPackagePathKey(memPkg.Path))
 	ds.iavlStore.Set(pathkey, bz)
+	ds.indexRealmPath(memPkg.Path)
 }
 
+// The realm address index is kept in the base store, like the
+// package index, so that it doesn't change the merkle root and can
+// be rebuilt by IndexRealmPaths.
+func (ds *defaultStore) indexRealmPath(path string) {
+	if !IsRealmPath(path) {
+		return
+	}
+	addrkey := []byte(backendRealmAddrKey(DerivePkgAddr(path)))
+	ds.baseStore.Set(addrkey, []byte(path))
+}
+
+// Returns the package at path, or nil if there is no such package.
 func (ds *defaultStore) GetMemPackage(path string) *std.MemPackage {
 	pathkey := []byte(backendPackagePathKey(path))
 	bz := ds.iavlStore.Get(pathkey)
 	if bz == nil {
-		panic(fmt.Sprintf(
-			"missing package at path %s", string(pathkey)))
+		return nil
 	}
 	var memPkg *std.MemPackage
 	amino.MustUnmarshal(bz, &memPkg)
@@ -545,10 +560,35 @@ func (ds *defaultStore) GetMemPackage(path string) *std.MemPackage {
 
 func (ds *defaultStore) GetMemFile(path string, name string) *std.MemFile {
 	memPkg := ds.GetMemPackage(path)
+	if memPkg == nil {
+		return nil
+	}
 	memFile := memPkg.GetFile(name)
 	return memFile
 }
 
+// Returns the path of the realm package whose package address is
+// addr (see DerivePkgAddr), or "" if there is no such realm.
+func (ds *defaultStore) GetRealmPathByAddr(addr crypto.Address) string {
+	addrkey := []byte(backendRealmAddrKey(addr))
+	return string(ds.baseStore.Get(addrkey))
+}
+
+// Indexes the addresses of all the realm packages, including those
+// added before the index existed.
+func (ds *defaultStore) IndexRealmPaths() {
+	ctr := ds.NumMemPackages()
+	for i := uint64(1); i <= uint64(ctr); i++ {
+		idxkey := []byte(backendPackageIndexKey(i))
+		path := ds.baseStore.Get(idxkey)
+		if path == nil {
+			panic(fmt.Sprintf(
+				"missing package index %d", i))
+		}
+		ds.indexRealmPath(string(path))
+	}
+}
+
 func (ds *defaultStore) IterMemPackage() <-chan *std.MemPackage {
 	ctrkey := []byte(backendPackageIndexCtrKey())
 	ctrbz := ds.baseStore.Get(ctrkey)
@@ -569,6 +609,10 @@ func (ds *defaultStore) IterMemPackage() <-chan *std.MemPackage {
 						"missing package index %d", i))
 				}
 				memPkg := ds.GetMemPackage(string(path))
+				if memPkg == nil {
+					panic(fmt.Sprintf(
+						"missing package at path %s", string(path)))
+				}
 				ch <- memPkg
 			}
 			close(ch)
@@ -755,6 +799,10 @@ func backendPackagePathKey(path string) string {
 	return fmt.Sprintf("pkg:" + path)
 }
 
+func backendRealmAddrKey(addr crypto.Address) string {
+	return "pkgaddr:" + addr.String()
+}
+
 //----------------------------------------
 // builtin types and packages
 
@@ -33,8 +33,9 @@ func (f *File) Validate() error {
 }
 
 // FetchDeps fetches and writes gno.mod packages
-// in GOPATH/pkg/gnomod/
-func (f *File) FetchDeps(path string, remote string) error {
+// in GOPATH/pkg/gnomod/, verifying their hash
+// against sum, and recording the missing ones.
+func (f *File) FetchDeps(path string, remote string, sum *Sum) error {
 	for _, r := range f.Require {
 		mod, replaced := isReplaced(r.Mod, f.Replace)
 		if replaced {
@@ -48,13 +49,32 @@ func (f *File) FetchDeps(path string, remote string) error {
 			indirect = "// indirect"
 		}
 
-		_, err := os.Stat(filepath.Join(path, r.Mod.Path))
-		if !os.IsNotExist(err) {
+		if memPkg, err := readPackage(path, r.Mod.Path); err == nil {
+			// The cache could have been modified since it was
+			// written: hash its files again on every use.
 			log.Println("cached", r.Mod.Path, indirect)
+			hash, err := PackageHash(memPkg)
+			if err != nil {
+				return fmt.Errorf("hash %q: %w", r.Mod.Path, err)
+			}
+			if err := sum.Verify(r.Mod, hash); err != nil {
+				return fmt.Errorf("verify cached %q: %w", r.Mod.Path, err)
+			}
 			continue
 		}
 		log.Println("fetching", r.Mod.Path, indirect)
-		requirements, err := writePackage(remote, path, r.Mod.Path)
+		memPkg, err := fetchPackage(remote, r.Mod.Path)
+		if err != nil {
+			return fmt.Errorf("fetchpackage: %w", err)
+		}
+		hash, err := PackageHash(memPkg)
+		if err != nil {
+			return fmt.Errorf("hash %q: %w", r.Mod.Path, err)
+		}
+		if err := sum.Verify(r.Mod, hash); err != nil {
+			return err
+		}
+		requirements, err := writePackage(path, memPkg)
 		if err != nil {
 			return fmt.Errorf("writepackage: %w", err)
 		}
@@ -88,7 +108,7 @@ func (f *File) FetchDeps(path string, remote string) error {
 			}
 		}
 
-		err = modFile.FetchDeps(path, remote)
+		err = modFile.FetchDeps(path, remote, sum)
 		if err != nil {
 			return err
 		}
@@ -113,6 +133,31 @@ func (f *File) WriteToPath(absPath string) error {
 		return errors.New("writing go.mod: module not found")
 	}
 
+	modPath := filepath.Join(absPath, "go.mod")
+	err := os.WriteFile(modPath, []byte(f.format()), 0o644)
+	if err != nil {
+		return fmt.Errorf("writefile %q: %w", modPath, err)
+	}
+
+	return nil
+}
+
+// WriteGnoMod writes gno.mod file in the given absolute path.
+func (f *File) WriteGnoMod(absPath string) error {
+	if f.Module == nil {
+		return errors.New("writing gno.mod: module not found")
+	}
+
+	modPath := filepath.Join(absPath, "gno.mod")
+	err := os.WriteFile(modPath, []byte(f.format()), 0o644)
+	if err != nil {
+		return fmt.Errorf("writefile %q: %w", modPath, err)
+	}
+
+	return nil
+}
+
+func (f *File) format() string {
 	data := "module " + f.Module.Mod.Path + "\n"
 
 	if f.Go != nil {
@@ -122,7 +167,11 @@ func (f *File) WriteToPath(absPath string) error {
 	if f.Require != nil {
 		data += "\nrequire (" + "\n"
 		for _, req := range f.Require {
-			data += "\t" + req.Mod.Path + " " + req.Mod.Version + "\n"
+			data += "\t" + req.Mod.Path + " " + req.Mod.Version
+			if req.Indirect {
+				data += " // indirect"
+			}
+			data += "\n"
 		}
 		data += ")\n"
 	}
@@ -130,19 +179,20 @@ func (f *File) WriteToPath(absPath string) error {
 	if f.Replace != nil {
 		data += "\nreplace (" + "\n"
 		for _, rep := range f.Replace {
-			data += "\t" + rep.Old.Path + " " + rep.Old.Version +
-				" => " + rep.New.Path + "\n"
+			data += "\t" + formatVersion(rep.Old) +
+				" => " + formatVersion(rep.New) + "\n"
 		}
 		data += ")\n"
 	}
 
-	modPath := filepath.Join(absPath, "go.mod")
-	err := os.WriteFile(modPath, []byte(data), 0o644)
-	if err != nil {
-		return fmt.Errorf("writefile %q: %w", modPath, err)
-	}
+	return data
+}
 
-	return nil
+func formatVersion(mod module.Version) string {
+	if mod.Version == "" {
+		return mod.Path
+	}
+	return mod.Path + " " + mod.Version
 }
 
 func (f *File) Sanitize() {
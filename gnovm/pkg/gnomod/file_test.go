@@ -89,7 +89,8 @@ func TestFetchDeps(t *testing.T) {
 			defer cleanUpFn()
 
 			// Fetching dependencies
-			tc.modFile.FetchDeps(dirPath, testRemote)
+			sum := NewSum()
+			tc.modFile.FetchDeps(dirPath, testRemote, sum)
 
 			// Read dir
 			entries, err := os.ReadDir(filepath.Join(dirPath, "gno.land", "p", "demo"))
@@ -109,7 +110,9 @@ func TestFetchDeps(t *testing.T) {
 			buf.Reset()
 
 			// Try fetching again. Should be cached
-			tc.modFile.FetchDeps(dirPath, testRemote)
+			// and match the recorded hashes.
+			err = tc.modFile.FetchDeps(dirPath, testRemote, sum)
+			require.Nil(t, err)
 			for _, c := range tc.cachedStdOutContains {
 				assert.Contains(t, buf.String(), c)
 			}
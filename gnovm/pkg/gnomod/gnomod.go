@@ -20,37 +20,50 @@ func GetGnoModPath() string {
 	return filepath.Join(client.HomeDir(), "pkg", "mod")
 }
 
-func writePackage(remote, basePath, pkgPath string) (requirements []string, err error) {
+// fetchPackage fetches the files of the package at pkgPath.
+func fetchPackage(remote, pkgPath string) (*std.MemPackage, error) {
 	res, err := queryChain(remote, queryPathFile, []byte(pkgPath))
 	if err != nil {
 		return nil, fmt.Errorf("querychain: %w", err)
 	}
 
-	dirPath, fileName := std.SplitFilepath(pkgPath)
-	if fileName == "" {
-		// Is Dir
-		// Create Dir if not exists
-		dirPath := filepath.Join(basePath, dirPath)
-		if _, err = os.Stat(dirPath); os.IsNotExist(err) {
-			if err = os.MkdirAll(dirPath, 0o755); err != nil {
-				return nil, fmt.Errorf("mkdir %q: %w", dirPath, err)
-			}
+	memPkg := &std.MemPackage{Path: pkgPath}
+	files := strings.Split(string(res.Data), "\n")
+	for _, file := range files {
+		res, err := queryChain(remote, queryPathFile, []byte(pkgPath+"/"+file))
+		if err != nil {
+			return nil, fmt.Errorf("querychain: %w", err)
 		}
+		memPkg.Files = append(memPkg.Files, &std.MemFile{
+			Name: file,
+			Body: string(res.Data),
+		})
+	}
 
-		files := strings.Split(string(res.Data), "\n")
-		for _, file := range files {
-			reqs, err := writePackage(remote, basePath, filepath.Join(pkgPath, file))
-			if err != nil {
-				return nil, fmt.Errorf("writepackage: %w", err)
-			}
-			requirements = append(requirements, reqs...)
+	return memPkg, nil
+}
+
+// writePackage writes the files of memPkg in basePath, along with their
+// precompiled version. It returns the imports of memPkg.
+func writePackage(basePath string, memPkg *std.MemPackage) (requirements []string, err error) {
+	// Create Dir if not exists
+	dirPath := filepath.Join(basePath, memPkg.Path)
+	if _, err = os.Stat(dirPath); os.IsNotExist(err) {
+		if err = os.MkdirAll(dirPath, 0o755); err != nil {
+			return nil, fmt.Errorf("mkdir %q: %w", dirPath, err)
+		}
+	}
+
+	for _, file := range memPkg.Files {
+		// Keep the source, to verify the package on later uses.
+		filePath := filepath.Join(dirPath, file.Name)
+		if err := os.WriteFile(filePath, []byte(file.Body), 0o644); err != nil {
+			return nil, fmt.Errorf("writefile %q: %w", filePath, err)
 		}
-	} else {
-		// Is File
+
 		// Precompile
-		filePath := filepath.Join(basePath, pkgPath)
 		targetFilename, _ := gnolang.GetPrecompileFilenameAndTags(filePath)
-		precompileRes, err := gnolang.Precompile(string(res.Data), "", fileName)
+		precompileRes, err := gnolang.Precompile(file.Body, "", file.Name)
 		if err != nil {
 			return nil, fmt.Errorf("precompile: %w", err)
 		}
@@ -59,7 +72,7 @@ func writePackage(remote, basePath, pkgPath string) (requirements []string, err
 			requirements = append(requirements, i.Path.Value)
 		}
 
-		fileNameWithPath := filepath.Join(basePath, dirPath, targetFilename)
+		fileNameWithPath := filepath.Join(dirPath, targetFilename)
 		err = os.WriteFile(fileNameWithPath, []byte(precompileRes.Translated), 0o644)
 		if err != nil {
 			return nil, fmt.Errorf("writefile %q: %w", fileNameWithPath, err)
@@ -69,6 +82,39 @@ func writePackage(remote, basePath, pkgPath string) (requirements []string, err
 	return removeDuplicateStr(requirements), nil
 }
 
+// readPackage reads the source files of a package written in basePath by
+// writePackage, leaving out the precompiled files and the go.mod file.
+func readPackage(basePath, pkgPath string) (*std.MemPackage, error) {
+	dirPath := filepath.Join(basePath, pkgPath)
+	entries, err := os.ReadDir(dirPath)
+	if err != nil {
+		return nil, fmt.Errorf("readdir %q: %w", dirPath, err)
+	}
+
+	memPkg := &std.MemPackage{Path: pkgPath}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || strings.HasPrefix(name, ".") ||
+			strings.HasSuffix(name, ".gno.gen.go") || name == "go.mod" {
+			continue
+		}
+		filePath := filepath.Join(dirPath, name)
+		bz, err := os.ReadFile(filePath)
+		if err != nil {
+			return nil, fmt.Errorf("readfile %q: %w", filePath, err)
+		}
+		memPkg.Files = append(memPkg.Files, &std.MemFile{
+			Name: name,
+			Body: string(bz),
+		})
+	}
+	if len(memPkg.Files) == 0 {
+		return nil, fmt.Errorf("no source files in %q", dirPath)
+	}
+
+	return memPkg, nil
+}
+
 // GnoToGoMod make necessary modifications in the gno.mod
 // and return go.mod file.
 func GnoToGoMod(f File) (*File, error) {
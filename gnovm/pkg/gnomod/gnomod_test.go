@@ -0,0 +1,50 @@
+package gnomod
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gnolang/gno/tm2/pkg/std"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/module"
+)
+
+func TestCachedPackageVerify(t *testing.T) {
+	dir := t.TempDir()
+	memPkg := &std.MemPackage{
+		Path: "gno.land/p/demo/foo",
+		Files: []*std.MemFile{
+			{Name: "foo.gno", Body: "package foo\n\nfunc Foo() int { return 1 }\n"},
+		},
+	}
+	_, err := writePackage(dir, memPkg)
+	require.NoError(t, err)
+
+	cached, err := readPackage(dir, memPkg.Path)
+	require.NoError(t, err)
+	assert.Equal(t, memPkg.Files, cached.Files)
+
+	mod := module.Version{Path: memPkg.Path, Version: "v0.0.0"}
+	hash, err := PackageHash(memPkg)
+	require.NoError(t, err)
+	sum := NewSum()
+	require.NoError(t, sum.Verify(mod, hash))
+	f := &File{
+		Module:  &modfile.Module{Mod: module.Version{Path: "test"}},
+		Require: []*modfile.Require{{Mod: mod}},
+	}
+
+	// the cached package matches gno.sum.
+	require.NoError(t, f.FetchDeps(dir, "", sum))
+
+	// a modified cached package doesn't.
+	fooPath := filepath.Join(dir, memPkg.Path, "foo.gno")
+	err = os.WriteFile(fooPath, []byte("package foo\n\nfunc Foo() int { return 2 }\n"), 0o644)
+	require.NoError(t, err)
+	err = f.FetchDeps(dir, "", sum)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "checksum mismatch for gno.land/p/demo/foo v0.0.0")
+}
@@ -0,0 +1,119 @@
+package gnomod
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/gnolang/gno/tm2/pkg/std"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/sumdb/dirhash"
+)
+
+// Sum is a parsed gno.sum file, holding the content hash of each
+// downloaded package, as computed by PackageHash.
+type Sum struct {
+	Hashes map[module.Version]string
+}
+
+// NewSum returns an empty gno.sum.
+func NewSum() *Sum {
+	return &Sum{Hashes: make(map[module.Version]string)}
+}
+
+// ParseSum parses a gno.sum file. Each line is of the form
+// `<module path> <version> <hash>`.
+func ParseSum(file string, data []byte) (*Sum, error) {
+	s := NewSum()
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineno := 1; scanner.Scan(); lineno++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("%s:%d: malformed line %q", file, lineno, line)
+		}
+		mod := module.Version{Path: fields[0], Version: fields[1]}
+		if h, ok := s.Hashes[mod]; ok && h != fields[2] {
+			return nil, fmt.Errorf("%s:%d: conflicting hashes for %s %s", file, lineno, mod.Path, mod.Version)
+		}
+		s.Hashes[mod] = fields[2]
+	}
+	return s, scanner.Err()
+}
+
+// ReadSum reads the gno.sum file in the given directory. It returns an
+// empty gno.sum if the file doesn't exist.
+func ReadSum(absPath string) (*Sum, error) {
+	sumPath := filepath.Join(absPath, "gno.sum")
+	data, err := os.ReadFile(sumPath)
+	if os.IsNotExist(err) {
+		return NewSum(), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("readfile %q: %w", sumPath, err)
+	}
+	return ParseSum(sumPath, data)
+}
+
+// Verify checks the hash of a downloaded module against gno.sum,
+// recording it if the module is not in gno.sum yet.
+func (s *Sum) Verify(mod module.Version, hash string) error {
+	want, ok := s.Hashes[mod]
+	if !ok {
+		s.Hashes[mod] = hash
+		return nil
+	}
+	if want != hash {
+		return fmt.Errorf("checksum mismatch for %s %s:\n\tdownloaded: %s\n\tgno.sum:    %s",
+			mod.Path, mod.Version, hash, want)
+	}
+	return nil
+}
+
+// WriteToPath writes gno.sum file in the given absolute path, sorted
+// by module path and version.
+func (s *Sum) WriteToPath(absPath string) error {
+	mods := make([]module.Version, 0, len(s.Hashes))
+	for mod := range s.Hashes {
+		mods = append(mods, mod)
+	}
+	sort.Slice(mods, func(i, j int) bool {
+		if mods[i].Path != mods[j].Path {
+			return mods[i].Path < mods[j].Path
+		}
+		return mods[i].Version < mods[j].Version
+	})
+
+	var data strings.Builder
+	for _, mod := range mods {
+		fmt.Fprintf(&data, "%s %s %s\n", mod.Path, mod.Version, s.Hashes[mod])
+	}
+
+	sumPath := filepath.Join(absPath, "gno.sum")
+	if err := os.WriteFile(sumPath, []byte(data.String()), 0o644); err != nil {
+		return fmt.Errorf("writefile %q: %w", sumPath, err)
+	}
+	return nil
+}
+
+// PackageHash returns the content hash of the files of memPkg, in the
+// "h1:" format of go.sum.
+func PackageHash(memPkg *std.MemPackage) (string, error) {
+	names := make([]string, 0, len(memPkg.Files))
+	bodies := make(map[string]string, len(memPkg.Files))
+	for _, file := range memPkg.Files {
+		names = append(names, file.Name)
+		bodies[file.Name] = file.Body
+	}
+	return dirhash.Hash1(names, func(name string) (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader(bodies[name])), nil
+	})
+}
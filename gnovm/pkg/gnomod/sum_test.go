@@ -0,0 +1,86 @@
+package gnomod
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gnolang/gno/tm2/pkg/std"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/mod/module"
+)
+
+func TestSumReadWrite(t *testing.T) {
+	dir := t.TempDir()
+
+	// missing gno.sum is empty.
+	sum, err := ReadSum(dir)
+	require.NoError(t, err)
+	assert.Empty(t, sum.Hashes)
+
+	avl := module.Version{Path: "gno.land/p/demo/avl", Version: "v0.0.0"}
+	ufmt := module.Version{Path: "gno.land/p/demo/ufmt", Version: "v0.0.0"}
+	require.NoError(t, sum.Verify(ufmt, "h1:ufmt="))
+	require.NoError(t, sum.Verify(avl, "h1:avl="))
+	require.NoError(t, sum.WriteToPath(dir))
+
+	bz, err := os.ReadFile(filepath.Join(dir, "gno.sum"))
+	require.NoError(t, err)
+	assert.Equal(t, "gno.land/p/demo/avl v0.0.0 h1:avl=\ngno.land/p/demo/ufmt v0.0.0 h1:ufmt=\n", string(bz))
+
+	sum, err = ReadSum(dir)
+	require.NoError(t, err)
+	assert.Equal(t, map[module.Version]string{avl: "h1:avl=", ufmt: "h1:ufmt="}, sum.Hashes)
+}
+
+func TestSumVerify(t *testing.T) {
+	mod := module.Version{Path: "gno.land/p/demo/avl", Version: "v0.0.0"}
+	sum := NewSum()
+
+	require.NoError(t, sum.Verify(mod, "h1:a="))
+	require.NoError(t, sum.Verify(mod, "h1:a="))
+	err := sum.Verify(mod, "h1:b=")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "checksum mismatch for gno.land/p/demo/avl v0.0.0")
+	assert.Equal(t, "h1:a=", sum.Hashes[mod])
+}
+
+func TestParseSumErrors(t *testing.T) {
+	for _, tc := range []struct {
+		desc string
+		data string
+		err  string
+	}{
+		{"malformed", "gno.land/p/demo/avl v0.0.0\n", "gno.sum:1: malformed line"},
+		{"conflicting", "gno.land/p/demo/avl v0.0.0 h1:a=\ngno.land/p/demo/avl v0.0.0 h1:b=\n", "gno.sum:2: conflicting hashes"},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := ParseSum("gno.sum", []byte(tc.data))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.err)
+		})
+	}
+}
+
+func TestPackageHash(t *testing.T) {
+	pkg := func(files ...*std.MemFile) *std.MemPackage {
+		return &std.MemPackage{Name: "avl", Path: "gno.land/p/demo/avl", Files: files}
+	}
+	a := &std.MemFile{Name: "a.gno", Body: "package avl\n"}
+	b := &std.MemFile{Name: "b.gno", Body: "package avl\n\nvar x int\n"}
+
+	h1, err := PackageHash(pkg(a, b))
+	require.NoError(t, err)
+	assert.Regexp(t, "^h1:", h1)
+
+	// file order doesn't matter.
+	h2, err := PackageHash(pkg(b, a))
+	require.NoError(t, err)
+	assert.Equal(t, h1, h2)
+
+	// file contents do.
+	h3, err := PackageHash(pkg(a, &std.MemFile{Name: "b.gno", Body: "package avl\n"}))
+	require.NoError(t, err)
+	assert.NotEqual(t, h1, h3)
+}
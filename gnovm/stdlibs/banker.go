@@ -3,6 +3,7 @@ package stdlibs
 import (
 	"fmt"
 
+	gno "github.com/gnolang/gno/gnovm/pkg/gnolang"
 	"github.com/gnolang/gno/tm2/pkg/crypto"
 	"github.com/gnolang/gno/tm2/pkg/std"
 )
@@ -160,3 +161,30 @@ func (rsb RealmSendBanker) IssueCoin(addr crypto.Bech32Address, denom string, am
 func (rsb RealmSendBanker) RemoveCoin(addr crypto.Bech32Address, denom string, amount int64) {
 	panic("RealmSendBanker cannot remove coins")
 }
+
+//----------------------------------------
+// onReceive hook
+
+// getOnReceive returns the onReceive function declared by the
+// realm whose package address is addr, if any. The caller is
+// responsible for checking its type. The hook is unexported so
+// that other realms can't call it directly; only the banker
+// reaches it through here.
+func getOnReceive(store gno.Store, addr crypto.Address) (gno.TypedValue, bool) {
+	pkgPath := store.GetRealmPathByAddr(addr)
+	if pkgPath == "" {
+		return gno.TypedValue{}, false
+	}
+	pl := gno.PackageNodeLocation(pkgPath)
+	pn := store.GetBlockNode(pl).(*gno.PackageNode)
+	idx, ok := pn.GetLocalIndex("onReceive")
+	if !ok {
+		return gno.TypedValue{}, false
+	}
+	pv := store.GetPackage(pkgPath, false)
+	tv := pv.GetBlock(store).GetPointerToInt(store, int(idx)).Deref()
+	if _, ok := tv.V.(*gno.FuncValue); !ok {
+		return gno.TypedValue{}, false
+	}
+	return tv, true
+}
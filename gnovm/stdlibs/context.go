@@ -17,4 +17,6 @@ type ExecContext struct {
 	OrigSend      std.Coins
 	OrigSendSpent *std.Coins // mutable
 	Banker        Banker
+	Params        Params
+	Upgrader      Upgrader
 }
@@ -0,0 +1,1023 @@
+package stdlibs
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	gno "github.com/gnolang/gno/gnovm/pkg/gnolang"
+)
+
+// CPU cycles charged by the fmt natives, per formatted
+// argument, per value printed within and per byte of output.
+const (
+	cpuFmtArg  = 20
+	cpuFmtElem = 5
+	cpuFmtByte = 1
+)
+
+// fmtMaxDepth is the maximum depth of nested values printed;
+// deeper values print as <max depth>.
+const fmtMaxDepth = 64
+
+// Interfaces recognized by the printer. Only the method sets
+// matter, so they needn't be declared types.
+var (
+	fmtErrorType = &gno.InterfaceType{
+		PkgPath: "fmt",
+		Methods: []gno.FieldType{{
+			Name: "Error",
+			Type: &gno.FuncType{Results: []gno.FieldType{{Type: gno.StringType}}},
+		}},
+	}
+	fmtStringerType = &gno.InterfaceType{
+		PkgPath: "fmt",
+		Methods: []gno.FieldType{{
+			Name: "String",
+			Type: &gno.FuncType{Results: []gno.FieldType{{Type: gno.StringType}}},
+		}},
+	}
+)
+
+// gnoPrinter is a deterministic port of Go's fmt printer, which
+// formats Gno values by walking their TypedValues instead of using
+// package reflect. Primitive values are handed over to Go's fmt
+// with the same directive, so that they render exactly as in Go.
+//
+// Pointer and function values are never printed as addresses:
+// a non-nil pointer which is not expanded (as in &{...}) prints
+// as its type in parentheses, e.g. (*main.Node), and %p is not
+// supported. Unlike Go, a map or slice which contains itself
+// prints as <cycle> where it recurs, instead of overflowing the
+// stack.
+type gnoPrinter struct {
+	m   *gno.Machine
+	buf strings.Builder
+
+	// maps, arrays and structs being printed.
+	visiting map[gno.Value]struct{}
+
+	// directive state; see fmt.fmtFlags.
+	plus, minus, sharp, space, zero bool
+	plusV, sharpV                   bool
+	wid, prec                       int
+	widPresent, precPresent         bool
+
+	reordered     bool
+	goodArgNum    bool
+	erroring      bool
+	wrapErrs      bool
+	wrappedArgNum int
+}
+
+func newGnoPrinter(m *gno.Machine) *gnoPrinter {
+	return &gnoPrinter{
+		m:             m,
+		visiting:      make(map[gno.Value]struct{}),
+		wrappedArgNum: -1,
+	}
+}
+
+// enter marks v as being printed; it reports false if v
+// already is, as v then contains itself.
+func (p *gnoPrinter) enter(v gno.Value) bool {
+	if _, ok := p.visiting[v]; ok {
+		p.buf.WriteString("<cycle>")
+		return false
+	}
+	p.visiting[v] = struct{}{}
+	return true
+}
+
+func (p *gnoPrinter) leave(v gno.Value) {
+	delete(p.visiting, v)
+}
+
+func (p *gnoPrinter) clearFlags() {
+	p.plus, p.minus, p.sharp, p.space, p.zero = false, false, false, false, false
+	p.plusV, p.sharpV = false, false
+	p.wid, p.prec = 0, 0
+	p.widPresent, p.precPresent = false, false
+}
+
+// directive reconstructs the Go format directive for verb
+// from the current flags, width and precision.
+func (p *gnoPrinter) directive(verb rune) string {
+	var sb strings.Builder
+	sb.WriteByte('%')
+	if p.plus || p.plusV {
+		sb.WriteByte('+')
+	}
+	if p.minus {
+		sb.WriteByte('-')
+	}
+	if p.sharp || p.sharpV {
+		sb.WriteByte('#')
+	}
+	if p.space {
+		sb.WriteByte(' ')
+	}
+	if p.zero {
+		sb.WriteByte('0')
+	}
+	if p.widPresent {
+		sb.WriteString(strconv.Itoa(p.wid))
+	}
+	if p.precPresent {
+		sb.WriteByte('.')
+		sb.WriteString(strconv.Itoa(p.prec))
+	}
+	sb.WriteRune(verb)
+	return sb.String()
+}
+
+// fmtGo formats a primitive Go value with the current directive.
+func (p *gnoPrinter) fmtGo(verb rune, v interface{}) {
+	p.buf.WriteString(fmt.Sprintf(p.directive(verb), v))
+}
+
+// padString writes s, padded to the current width.
+func (p *gnoPrinter) padString(s string) {
+	if !p.widPresent || p.wid <= utf8.RuneCountInString(s) {
+		p.buf.WriteString(s)
+		return
+	}
+	pad := strings.Repeat(" ", p.wid-utf8.RuneCountInString(s))
+	if p.minus {
+		p.buf.WriteString(s + pad)
+	} else {
+		p.buf.WriteString(pad + s)
+	}
+}
+
+// fmtString formats a string obtained from a String or Error method.
+func (p *gnoPrinter) fmtString(verb rune, s string) {
+	switch verb {
+	case 'v':
+		if p.sharpV {
+			p.fmtGo('q', s)
+		} else {
+			p.fmtGo('s', s)
+		}
+	case 's', 'q', 'x', 'X':
+		p.fmtGo(verb, s)
+	default:
+		p.badVerb(verb, nil)
+	}
+}
+
+func (p *gnoPrinter) badVerb(verb rune, tv *gno.TypedValue) {
+	p.erroring = true
+	p.buf.WriteString("%!")
+	p.buf.WriteRune(verb)
+	p.buf.WriteByte('(')
+	if tv != nil && !isNilInterface(tv) {
+		p.buf.WriteString(fmtTypeString(tv.T))
+		p.buf.WriteByte('=')
+		p.printArg(tv, 'v')
+	} else {
+		p.buf.WriteString("<nil>")
+	}
+	p.buf.WriteByte(')')
+	p.erroring = false
+}
+
+// callString calls the niladic string method name of tv.
+func (p *gnoPrinter) callString(tv *gno.TypedValue, name string) string {
+	res := p.m.Eval(gno.Call(gno.Sel(&gno.ConstExpr{TypedValue: *tv}, name)))
+	return res[0].GetString()
+}
+
+// handleMethods formats tv using its Error or String method, if it
+// has one and the verb accepts strings; it reports whether it did.
+func (p *gnoPrinter) handleMethods(tv *gno.TypedValue, verb rune) bool {
+	if p.erroring || p.sharpV {
+		return false
+	}
+	switch verb {
+	case 'v', 's', 'x', 'X', 'q':
+	default:
+		return false
+	}
+	var name string
+	switch {
+	case gno.IsImplementedBy(fmtErrorType, tv.T):
+		name = "Error"
+	case gno.IsImplementedBy(fmtStringerType, tv.T):
+		name = "String"
+	default:
+		return false
+	}
+	if tv.T.Kind() == gno.PointerKind && tv.V == nil {
+		// Go recovers from the nil dereference; we
+		// just don't make the call.
+		p.padString("<nil>")
+		return true
+	}
+	p.fmtString(verb, p.callString(tv, name))
+	return true
+}
+
+func (p *gnoPrinter) printArg(tv *gno.TypedValue, verb rune) {
+	p.m.IncrCPU(cpuFmtArg)
+	if isNilInterface(tv) {
+		switch verb {
+		case 'T', 'v':
+			p.padString("<nil>")
+		default:
+			p.badVerb(verb, nil)
+		}
+		return
+	}
+	if verb == 'T' {
+		p.fmtGo('s', fmtTypeString(tv.T))
+		return
+	}
+	if verb == 'p' {
+		p.badVerb(verb, tv)
+		return
+	}
+	if p.handleMethods(tv, verb) {
+		return
+	}
+	p.printValue(tv, verb, 0)
+}
+
+// printElem prints tv, or the nil value of static type t.
+func (p *gnoPrinter) printElem(tv *gno.TypedValue, t gno.Type, verb rune, depth int) {
+	if isNilInterface(tv) {
+		if p.sharpV {
+			p.buf.WriteString(fmtTypeString(t))
+			p.buf.WriteString("(nil)")
+		} else {
+			p.buf.WriteString("<nil>")
+		}
+		return
+	}
+	p.printValue(tv, verb, depth)
+}
+
+func (p *gnoPrinter) printValue(tv *gno.TypedValue, verb rune, depth int) {
+	p.m.IncrCPU(cpuFmtElem)
+	if depth > fmtMaxDepth {
+		p.buf.WriteString("<max depth>")
+		return
+	}
+	if depth > 0 && p.handleMethods(tv, verb) {
+		return
+	}
+	store := p.m.Store
+	if ref, ok := tv.V.(gno.RefValue); ok && ref.PkgPath == "" {
+		// e.g. the target of a pointer persisted in a realm.
+		tv.V = store.GetObject(ref.ObjectID)
+	}
+	switch bt := gno.BaseOf(tv.T).(type) {
+	case gno.PrimitiveType:
+		p.printPrimitive(tv, bt, verb)
+	case *gno.StructType:
+		if p.sharpV {
+			p.buf.WriteString(fmtTypeString(tv.T))
+		}
+		sv := tv.V.(*gno.StructValue)
+		if !p.enter(sv) {
+			return
+		}
+		defer p.leave(sv)
+		p.buf.WriteByte('{')
+		for i, f := range bt.Fields {
+			if i > 0 {
+				if p.sharpV {
+					p.buf.WriteString(", ")
+				} else {
+					p.buf.WriteByte(' ')
+				}
+			}
+			if p.plusV || p.sharpV {
+				p.buf.WriteString(string(f.Name))
+				p.buf.WriteByte(':')
+			}
+			fv := sv.GetPointerToInt(store, i).Deref()
+			p.printElem(&fv, f.Type, verb, depth+1)
+		}
+		p.buf.WriteByte('}')
+	case *gno.MapType:
+		mv, _ := tv.V.(*gno.MapValue)
+		if mv != nil {
+			if !p.enter(mv) {
+				return
+			}
+			defer p.leave(mv)
+		}
+		if p.sharpV {
+			p.buf.WriteString(fmtTypeString(tv.T))
+			if mv == nil {
+				p.buf.WriteString("(nil)")
+				return
+			}
+			p.buf.WriteByte('{')
+		} else {
+			p.buf.WriteString("map[")
+		}
+		if mv != nil && mv.List != nil {
+			items := make([]*gno.MapListItem, 0, mv.GetLength())
+			for item := mv.List.Head; item != nil; item = item.Next {
+				items = append(items, item)
+			}
+			sortMapItems(items)
+			for i, item := range items {
+				if i > 0 {
+					if p.sharpV {
+						p.buf.WriteString(", ")
+					} else {
+						p.buf.WriteByte(' ')
+					}
+				}
+				key, val := item.Key, item.Value
+				p.printElem(&key, bt.Key, verb, depth+1)
+				p.buf.WriteByte(':')
+				p.printElem(&val, bt.Value, verb, depth+1)
+			}
+		}
+		if p.sharpV {
+			p.buf.WriteByte('}')
+		} else {
+			p.buf.WriteByte(']')
+		}
+	case *gno.ArrayType:
+		av, _ := tv.V.(*gno.ArrayValue)
+		p.printList(tv, av, 0, bt.Len, bt.Elt, verb, depth)
+	case *gno.SliceType:
+		if tv.V == nil {
+			if p.sharpV {
+				p.buf.WriteString(fmtTypeString(tv.T))
+				p.buf.WriteString("(nil)")
+				return
+			}
+			p.printList(tv, nil, 0, 0, bt.Elt, verb, depth)
+			return
+		}
+		sv := tv.V.(*gno.SliceValue)
+		p.printList(tv, sv.GetBase(store), sv.Offset, sv.Length, bt.Elt, verb, depth)
+	case *gno.PointerType:
+		if tv.V != nil && depth == 0 {
+			ev := tv.V.(gno.PointerValue).Deref()
+			switch bt.Elt.Kind() {
+			case gno.StructKind, gno.ArrayKind, gno.SliceKind, gno.MapKind:
+				p.buf.WriteByte('&')
+				p.printValue(&ev, verb, depth+1)
+				return
+			}
+		}
+		p.printOpaque(tv, verb)
+	case *gno.FuncType:
+		p.printOpaque(tv, verb)
+	case *gno.NativeType:
+		p.fmtGo(verb, tv.V.(*gno.NativeValue).Value.Interface())
+	default:
+		p.padString(tv.String())
+	}
+}
+
+// printOpaque prints a pointer or func value, whose address is
+// not exposed.
+func (p *gnoPrinter) printOpaque(tv *gno.TypedValue, verb rune) {
+	switch verb {
+	case 'v', 's':
+	default:
+		p.badVerb(verb, tv)
+		return
+	}
+	if tv.V == nil {
+		if p.sharpV {
+			p.padString("(" + fmtTypeString(tv.T) + ")(nil)")
+		} else {
+			p.padString("<nil>")
+		}
+		return
+	}
+	p.padString("(" + fmtTypeString(tv.T) + ")")
+}
+
+// printList prints length elements of av, starting at offset.
+// av may be nil if length is zero.
+func (p *gnoPrinter) printList(tv *gno.TypedValue, av *gno.ArrayValue, offset, length int, et gno.Type, verb rune, depth int) {
+	store := p.m.Store
+	if av != nil {
+		if !p.enter(av) {
+			return
+		}
+		defer p.leave(av)
+	}
+	if gno.BaseOf(et) == gno.Uint8Type {
+		switch verb {
+		case 's', 'q', 'x', 'X':
+			bz := make([]byte, length)
+			for i := 0; i < length; i++ {
+				ev := av.GetPointerAtIndexInt2(store, offset+i, et).Deref()
+				bz[i] = ev.GetUint8()
+			}
+			p.fmtGo(verb, bz)
+			return
+		}
+	}
+	if p.sharpV {
+		ts := fmtTypeString(tv.T)
+		if ts == "[]uint8" {
+			ts = "[]byte"
+		}
+		p.buf.WriteString(ts)
+		p.buf.WriteByte('{')
+	} else {
+		p.buf.WriteByte('[')
+	}
+	for i := 0; i < length; i++ {
+		if i > 0 {
+			if p.sharpV {
+				p.buf.WriteString(", ")
+			} else {
+				p.buf.WriteByte(' ')
+			}
+		}
+		ev := av.GetPointerAtIndexInt2(store, offset+i, et).Deref()
+		p.printElem(&ev, et, verb, depth+1)
+	}
+	if p.sharpV {
+		p.buf.WriteByte('}')
+	} else {
+		p.buf.WriteByte(']')
+	}
+}
+
+// Verbs accepted by each kind of primitive value.
+const (
+	fmtBoolVerbs   = "tv"
+	fmtIntVerbs    = "bcdoOqxXUv"
+	fmtFloatVerbs  = "beEfFgGxXv"
+	fmtStringVerbs = "sqxXv"
+)
+
+func (p *gnoPrinter) printPrimitive(tv *gno.TypedValue, bt gno.PrimitiveType, verb rune) {
+	var v interface{}
+	var verbs string
+	switch bt {
+	case gno.BoolType, gno.UntypedBoolType:
+		v, verbs = tv.GetBool(), fmtBoolVerbs
+	case gno.StringType, gno.UntypedStringType:
+		v, verbs = tv.GetString(), fmtStringVerbs
+	case gno.IntType:
+		v, verbs = tv.GetInt(), fmtIntVerbs
+	case gno.Int8Type:
+		v, verbs = tv.GetInt8(), fmtIntVerbs
+	case gno.Int16Type:
+		v, verbs = tv.GetInt16(), fmtIntVerbs
+	case gno.Int32Type, gno.UntypedRuneType:
+		v, verbs = tv.GetInt32(), fmtIntVerbs
+	case gno.Int64Type:
+		v, verbs = tv.GetInt64(), fmtIntVerbs
+	case gno.UintType:
+		v, verbs = tv.GetUint(), fmtIntVerbs
+	case gno.Uint8Type:
+		v, verbs = tv.GetUint8(), fmtIntVerbs
+	case gno.Uint16Type:
+		v, verbs = tv.GetUint16(), fmtIntVerbs
+	case gno.Uint32Type:
+		v, verbs = tv.GetUint32(), fmtIntVerbs
+	case gno.Uint64Type:
+		v, verbs = tv.GetUint64(), fmtIntVerbs
+	case gno.Float32Type:
+		v, verbs = tv.GetFloat32(), fmtFloatVerbs
+	case gno.Float64Type:
+		v, verbs = tv.GetFloat64(), fmtFloatVerbs
+	default:
+		p.padString(tv.String())
+		return
+	}
+	if !strings.ContainsRune(verbs, verb) {
+		p.badVerb(verb, tv)
+		return
+	}
+	p.fmtGo(verb, v)
+}
+
+// sortMapItems sorts map items by key like Go's fmt does, for keys
+// of primitive types. Other keys keep their insertion order.
+func sortMapItems(items []*gno.MapListItem) {
+	sort.SliceStable(items, func(i, j int) bool {
+		a, b := &items[i].Key, &items[j].Key
+		pa, ok1 := gno.BaseOf(a.T).(gno.PrimitiveType)
+		pb, ok2 := gno.BaseOf(b.T).(gno.PrimitiveType)
+		if !ok1 || !ok2 || pa != pb {
+			return false
+		}
+		switch pa {
+		case gno.BoolType:
+			return !a.GetBool() && b.GetBool()
+		case gno.StringType:
+			return a.GetString() < b.GetString()
+		case gno.IntType:
+			return a.GetInt() < b.GetInt()
+		case gno.Int8Type:
+			return a.GetInt8() < b.GetInt8()
+		case gno.Int16Type:
+			return a.GetInt16() < b.GetInt16()
+		case gno.Int32Type:
+			return a.GetInt32() < b.GetInt32()
+		case gno.Int64Type:
+			return a.GetInt64() < b.GetInt64()
+		case gno.UintType:
+			return a.GetUint() < b.GetUint()
+		case gno.Uint8Type:
+			return a.GetUint8() < b.GetUint8()
+		case gno.Uint16Type:
+			return a.GetUint16() < b.GetUint16()
+		case gno.Uint32Type:
+			return a.GetUint32() < b.GetUint32()
+		case gno.Uint64Type:
+			return a.GetUint64() < b.GetUint64()
+		case gno.Float32Type:
+			return a.GetFloat32() < b.GetFloat32()
+		case gno.Float64Type:
+			return a.GetFloat64() < b.GetFloat64()
+		}
+		return false
+	})
+}
+
+// fmtTypeString returns the Go-style name of t, as printed by %T;
+// declared types are qualified by their package name.
+func fmtTypeString(t gno.Type) string {
+	switch ct := t.(type) {
+	case nil:
+		return "<nil>"
+	case *gno.DeclaredType:
+		pkgName := ct.PkgPath
+		if i := strings.LastIndexByte(pkgName, '/'); i >= 0 {
+			pkgName = pkgName[i+1:]
+		}
+		if pkgName == "" || pkgName == ".uverse" {
+			return string(ct.Name)
+		}
+		return pkgName + "." + string(ct.Name)
+	case gno.PrimitiveType:
+		return ct.String()
+	case *gno.PointerType:
+		return "*" + fmtTypeString(ct.Elt)
+	case *gno.SliceType:
+		return "[]" + fmtTypeString(ct.Elt)
+	case *gno.ArrayType:
+		return "[" + strconv.Itoa(ct.Len) + "]" + fmtTypeString(ct.Elt)
+	case *gno.MapType:
+		return "map[" + fmtTypeString(ct.Key) + "]" + fmtTypeString(ct.Value)
+	case *gno.FuncType:
+		s := "func(" + fmtFieldTypes(ct.Params, ", ") + ")"
+		switch len(ct.Results) {
+		case 0:
+		case 1:
+			s += " " + fmtTypeString(ct.Results[0].Type)
+		default:
+			s += " (" + fmtFieldTypes(ct.Results, ", ") + ")"
+		}
+		return s
+	case *gno.StructType:
+		if len(ct.Fields) == 0 {
+			return "struct {}"
+		}
+		fs := make([]string, len(ct.Fields))
+		for i, f := range ct.Fields {
+			if f.Embedded {
+				fs[i] = fmtTypeString(f.Type)
+			} else {
+				fs[i] = string(f.Name) + " " + fmtTypeString(f.Type)
+			}
+		}
+		return "struct { " + strings.Join(fs, "; ") + " }"
+	case *gno.InterfaceType:
+		if len(ct.Methods) == 0 {
+			return "interface {}"
+		}
+		ms := make([]string, len(ct.Methods))
+		for i, im := range ct.Methods {
+			ms[i] = string(im.Name) + strings.TrimPrefix(fmtTypeString(im.Type), "func")
+		}
+		return "interface { " + strings.Join(ms, "; ") + " }"
+	case *gno.NativeType:
+		return ct.Type.String()
+	default:
+		return t.String()
+	}
+}
+
+func fmtFieldTypes(fts []gno.FieldType, sep string) string {
+	ss := make([]string, len(fts))
+	for i, ft := range fts {
+		ss[i] = fmtTypeString(ft.Type)
+	}
+	return strings.Join(ss, sep)
+}
+
+// Sprint formats the elements of a, a []interface{} value, like
+// fmt.Sprint. Besides the natives of package fmt, it is used by
+// test environments which define printing functions in fmt.
+func Sprint(m *gno.Machine, a *gno.TypedValue) string {
+	p := newGnoPrinter(m)
+	p.doPrint(fmtArgs(m, a))
+	return p.result()
+}
+
+// Sprintln formats the elements of a like fmt.Sprintln.
+func Sprintln(m *gno.Machine, a *gno.TypedValue) string {
+	p := newGnoPrinter(m)
+	p.doPrintln(fmtArgs(m, a))
+	return p.result()
+}
+
+// Sprintf formats the elements of a like fmt.Sprintf.
+func Sprintf(m *gno.Machine, format string, a *gno.TypedValue) string {
+	p := newGnoPrinter(m)
+	p.doPrintf(format, fmtArgs(m, a))
+	return p.result()
+}
+
+// fmtArgs returns the elements of a []interface{} argument.
+func fmtArgs(m *gno.Machine, tv *gno.TypedValue) []gno.TypedValue {
+	if tv.V == nil {
+		return nil
+	}
+	sv := tv.V.(*gno.SliceValue)
+	av := sv.GetBase(m.Store)
+	args := make([]gno.TypedValue, sv.Length)
+	for i := range args {
+		args[i] = av.GetPointerAtIndexInt2(m.Store, sv.Offset+i, nil).Deref()
+	}
+	return args
+}
+
+func (p *gnoPrinter) result() string {
+	s := p.buf.String()
+	p.m.IncrCPU(cpuFmtByte * int64(len(s)))
+	return s
+}
+
+func isStringArg(tv *gno.TypedValue) bool {
+	return tv.T != nil && tv.T.Kind() == gno.StringKind
+}
+
+// isNilInterface reports whether tv is a nil interface value,
+// which may still carry its static interface type.
+func isNilInterface(tv *gno.TypedValue) bool {
+	return tv.T == nil || tv.T.Kind() == gno.InterfaceKind
+}
+
+// doPrint is like fmt.Sprint: spaces are added between
+// operands when neither is a string.
+func (p *gnoPrinter) doPrint(args []gno.TypedValue) {
+	prevString := false
+	for i := range args {
+		isString := isStringArg(&args[i])
+		if i > 0 && !isString && !prevString {
+			p.buf.WriteByte(' ')
+		}
+		p.printArg(&args[i], 'v')
+		prevString = isString
+	}
+}
+
+// doPrintln is like fmt.Sprintln: spaces are always added
+// between operands and a newline is appended.
+func (p *gnoPrinter) doPrintln(args []gno.TypedValue) {
+	for i := range args {
+		if i > 0 {
+			p.buf.WriteByte(' ')
+		}
+		p.printArg(&args[i], 'v')
+	}
+	p.buf.WriteByte('\n')
+}
+
+// tooLarge reports whether the magnitude of the integer is
+// too large to be used as a formatting width or precision.
+func tooLarge(x int) bool {
+	const max int = 1e6
+	return x > max || x < -max
+}
+
+// parsenum converts ASCII to integer. num is 0 (and isnum is false)
+// if no number present.
+func parsenum(s string, start, end int) (num int, isnum bool, newi int) {
+	if start >= end {
+		return 0, false, end
+	}
+	for newi = start; newi < end && '0' <= s[newi] && s[newi] <= '9'; newi++ {
+		if tooLarge(num) {
+			return 0, false, end // Overflow; crazy long number most likely.
+		}
+		num = num*10 + int(s[newi]-'0')
+		isnum = true
+	}
+	return
+}
+
+// intFromArg gets the argNumth element of args. On return, isInt
+// reports whether the argument has integer type.
+func intFromArg(args []gno.TypedValue, argNum int) (num int, isInt bool, newArgNum int) {
+	newArgNum = argNum
+	if argNum < len(args) {
+		tv := &args[argNum]
+		if tv.T != nil {
+			if pt, ok := gno.BaseOf(tv.T).(gno.PrimitiveType); ok {
+				isInt = true
+				switch pt {
+				case gno.IntType:
+					num = tv.GetInt()
+				case gno.Int8Type:
+					num = int(tv.GetInt8())
+				case gno.Int16Type:
+					num = int(tv.GetInt16())
+				case gno.Int32Type:
+					num = int(tv.GetInt32())
+				case gno.Int64Type:
+					n := tv.GetInt64()
+					num = int(n)
+					isInt = int64(num) == n
+				case gno.UintType, gno.Uint8Type, gno.Uint16Type, gno.Uint32Type, gno.Uint64Type:
+					var n uint64
+					switch pt {
+					case gno.UintType:
+						n = uint64(tv.GetUint())
+					case gno.Uint8Type:
+						n = uint64(tv.GetUint8())
+					case gno.Uint16Type:
+						n = uint64(tv.GetUint16())
+					case gno.Uint32Type:
+						n = uint64(tv.GetUint32())
+					default:
+						n = tv.GetUint64()
+					}
+					if int64(n) >= 0 && uint64(int(n)) == n {
+						num = int(n)
+					} else {
+						isInt = false
+					}
+				default:
+					isInt = false
+				}
+			}
+		}
+		newArgNum = argNum + 1
+		if tooLarge(num) {
+			num = 0
+			isInt = false
+		}
+	}
+	return
+}
+
+// parseArgNumber returns the value of the bracketed number, minus 1
+// (explicit argument numbers are one-indexed but we want zero-indexed).
+// The opening bracket is known to be present at format[0].
+// The returned values are the index, the number of bytes to consume
+// up to the closing paren, if present, and whether the number parsed
+// ok. The bytes to consume will be 1 if no closing paren is present.
+func parseArgNumber(format string) (index int, wid int, ok bool) {
+	// There must be at least 3 bytes: [n].
+	if len(format) < 3 {
+		return 0, 1, false
+	}
+	// Find closing bracket.
+	for i := 1; i < len(format); i++ {
+		if format[i] == ']' {
+			width, ok, newi := parsenum(format, 1, i)
+			if !ok || newi != i {
+				return 0, i + 1, false
+			}
+			return width - 1, i + 1, true // arg numbers are one-indexed and skip paren.
+		}
+	}
+	return 0, 1, false
+}
+
+// argNumber returns the next argument to evaluate, which is either the
+// value of the passed-in argNum or the value of the bracketed integer
+// that begins format[i:]. It also returns the new value of i, that is,
+// the index of the next byte of the format to process.
+func (p *gnoPrinter) argNumber(argNum int, format string, i int, numArgs int) (newArgNum, newi int, found bool) {
+	if len(format) <= i || format[i] != '[' {
+		return argNum, i, false
+	}
+	p.reordered = true
+	index, wid, ok := parseArgNumber(format[i:])
+	if ok && 0 <= index && index < numArgs {
+		return index, i + wid, true
+	}
+	p.goodArgNum = false
+	return argNum, i + wid, ok
+}
+
+func (p *gnoPrinter) badArgNum(verb rune) {
+	p.buf.WriteString("%!")
+	p.buf.WriteRune(verb)
+	p.buf.WriteString("(BADINDEX)")
+}
+
+func (p *gnoPrinter) missingArg(verb rune) {
+	p.buf.WriteString("%!")
+	p.buf.WriteRune(verb)
+	p.buf.WriteString("(MISSING)")
+}
+
+// doPrintf is a port of Go's (*pp).doPrintf.
+func (p *gnoPrinter) doPrintf(format string, args []gno.TypedValue) {
+	end := len(format)
+	argNum := 0         // we process one argument per non-trivial format
+	afterIndex := false // previous item in format was an index like [3].
+	p.reordered = false
+formatLoop:
+	for i := 0; i < end; {
+		p.goodArgNum = true
+		lasti := i
+		for i < end && format[i] != '%' {
+			i++
+		}
+		if i > lasti {
+			p.buf.WriteString(format[lasti:i])
+		}
+		if i >= end {
+			// done processing format string
+			break
+		}
+
+		// Process one verb
+		i++
+
+		// Do we have flags?
+		p.clearFlags()
+	simpleFormat:
+		for ; i < end; i++ {
+			c := format[i]
+			switch c {
+			case '#':
+				p.sharp = true
+			case '0':
+				p.zero = !p.minus // Only allow zero padding to the left.
+			case '+':
+				p.plus = true
+			case '-':
+				p.minus = true
+				p.zero = false // Do not pad with zeros to the right.
+			case ' ':
+				p.space = true
+			default:
+				// Fast path for common case of ascii lower case simple verbs
+				// without precision or width or argument indices.
+				if 'a' <= c && c <= 'z' && argNum < len(args) {
+					if c == 'v' {
+						// Go syntax
+						p.sharpV = p.sharp
+						p.sharp = false
+						// Struct-field syntax
+						p.plusV = p.plus
+						p.plus = false
+					}
+					p.printVerbArg(&args[argNum], argNum, rune(c))
+					argNum++
+					i++
+					continue formatLoop
+				}
+				// Format is more complex than simple flags and a verb or is malformed.
+				break simpleFormat
+			}
+		}
+
+		// Do we have an explicit argument index?
+		argNum, i, afterIndex = p.argNumber(argNum, format, i, len(args))
+
+		// Do we have width?
+		if i < end && format[i] == '*' {
+			i++
+			p.wid, p.widPresent, argNum = intFromArg(args, argNum)
+
+			if !p.widPresent {
+				p.buf.WriteString("%!(BADWIDTH)")
+			}
+
+			// We have a negative width, so take its value and ensure
+			// that the minus flag is set
+			if p.wid < 0 {
+				p.wid = -p.wid
+				p.minus = true
+				p.zero = false // Do not pad with zeros to the right.
+			}
+			afterIndex = false
+		} else {
+			p.wid, p.widPresent, i = parsenum(format, i, end)
+			if afterIndex && p.widPresent { // "%[3]2d"
+				p.goodArgNum = false
+			}
+		}
+
+		// Do we have precision?
+		if i+1 < end && format[i] == '.' {
+			i++
+			if afterIndex { // "%[3].2d"
+				p.goodArgNum = false
+			}
+			argNum, i, afterIndex = p.argNumber(argNum, format, i, len(args))
+			if i < end && format[i] == '*' {
+				i++
+				p.prec, p.precPresent, argNum = intFromArg(args, argNum)
+				// Negative precision arguments don't make sense
+				if p.prec < 0 {
+					p.prec = 0
+					p.precPresent = false
+				}
+				if !p.precPresent {
+					p.buf.WriteString("%!(BADPREC)")
+				}
+				afterIndex = false
+			} else {
+				p.prec, p.precPresent, i = parsenum(format, i, end)
+				if !p.precPresent {
+					p.prec = 0
+					p.precPresent = true
+				}
+			}
+		}
+
+		if !afterIndex {
+			argNum, i, afterIndex = p.argNumber(argNum, format, i, len(args))
+		}
+
+		if i >= end {
+			p.buf.WriteString("%!(NOVERB)")
+			break
+		}
+
+		verb, size := rune(format[i]), 1
+		if verb >= utf8.RuneSelf {
+			verb, size = utf8.DecodeRuneInString(format[i:])
+		}
+		i += size
+
+		switch {
+		case verb == '%': // Percent does not absorb operands and ignores f.wid and f.prec.
+			p.buf.WriteByte('%')
+		case !p.goodArgNum:
+			p.badArgNum(verb)
+		case argNum >= len(args): // No argument left over to print for the current verb.
+			p.missingArg(verb)
+		case verb == 'v':
+			// Go syntax
+			p.sharpV = p.sharp
+			p.sharp = false
+			// Struct-field syntax
+			p.plusV = p.plus
+			p.plus = false
+			fallthrough
+		default:
+			p.printVerbArg(&args[argNum], argNum, verb)
+			argNum++
+		}
+	}
+
+	// Check for extra arguments unless the call accessed the arguments
+	// out of order, in which case it's too expensive to detect if they've all
+	// been used and arguably OK if they're not.
+	if !p.reordered && argNum < len(args) {
+		p.clearFlags()
+		p.buf.WriteString("%!(EXTRA ")
+		for i := argNum; i < len(args); i++ {
+			if i > argNum {
+				p.buf.WriteString(", ")
+			}
+			tv := &args[i]
+			if isNilInterface(tv) {
+				p.buf.WriteString("<nil>")
+			} else {
+				p.buf.WriteString(fmtTypeString(tv.T))
+				p.buf.WriteByte('=')
+				p.printArg(tv, 'v')
+			}
+		}
+		p.buf.WriteByte(')')
+	}
+}
+
+// printVerbArg prints the argNumth argument, handling %w.
+func (p *gnoPrinter) printVerbArg(tv *gno.TypedValue, argNum int, verb rune) {
+	if verb == 'w' {
+		// It is invalid to use %w other than with Errorf, more than
+		// once, or with a non-error arg.
+		if !p.wrapErrs || p.wrappedArgNum >= 0 ||
+			isNilInterface(tv) || !gno.IsImplementedBy(fmtErrorType, tv.T) {
+			p.wrappedArgNum = -1
+			p.wrapErrs = false
+			p.badVerb(verb, tv)
+			return
+		}
+		p.wrappedArgNum = argNum
+		verb = 'v'
+	}
+	p.printArg(tv, verb)
+}
@@ -0,0 +1,9 @@
+package stdlibs
+
+// Params is the native hook through which an authorized realm
+// updates on-chain module parameters, see std.SetParam.
+// Implementations panic if the realm is not authorized,
+// or if the param update is invalid.
+type Params interface {
+	SetParam(realmPath, module, key, value string)
+}
@@ -10,9 +10,20 @@ import (
 	gno "github.com/gnolang/gno/gnovm/pkg/gnolang"
 	"github.com/gnolang/gno/tm2/pkg/bech32"
 	"github.com/gnolang/gno/tm2/pkg/crypto"
+	"github.com/gnolang/gno/tm2/pkg/crypto/ed25519"
+	"github.com/gnolang/gno/tm2/pkg/crypto/secp256k1"
 	"github.com/gnolang/gno/tm2/pkg/std"
 )
 
+// Fixed CPU cycle costs of the signature verification natives.
+const (
+	cpuVerifyEd25519      = 600
+	cpuVerifySecp256k1    = 1000
+	cpuRecoverSecp256k1   = 1500
+	cpuVerifyOtherPubKey  = 3000 // e.g. multisig.
+	cpuDecodeBech32PubKey = 100
+)
+
 func InjectNativeMappings(store gno.Store) {
 	store.AddGo2GnoMapping(reflect.TypeOf(crypto.Bech32Address("")), "std", "Address")
 	store.AddGo2GnoMapping(reflect.TypeOf(std.Coins{}), "std", "Coins")
@@ -48,6 +59,143 @@ func InjectPackage(store gno.Store, pn *gno.PackageNode) {
 				m.PushValue(res0)
 			},
 		)
+	case "internal/fmt":
+		pn.DefineNative("Sprint",
+			gno.Flds( // params
+				"a", gno.SliceT(gno.AnyT()),
+			),
+			gno.Flds( // results
+				"s", "string",
+			),
+			func(m *gno.Machine) {
+				arg0 := m.LastBlock().GetParams1()
+				m.PushValue(typedString(m.Alloc.NewString(Sprint(m, arg0.TV))))
+			},
+		)
+		pn.DefineNative("Sprintln",
+			gno.Flds( // params
+				"a", gno.SliceT(gno.AnyT()),
+			),
+			gno.Flds( // results
+				"s", "string",
+			),
+			func(m *gno.Machine) {
+				arg0 := m.LastBlock().GetParams1()
+				m.PushValue(typedString(m.Alloc.NewString(Sprintln(m, arg0.TV))))
+			},
+		)
+		pn.DefineNative("Sprintf",
+			gno.Flds( // params
+				"format", "string",
+				"a", gno.SliceT(gno.AnyT()),
+			),
+			gno.Flds( // results
+				"s", "string",
+			),
+			func(m *gno.Machine) {
+				arg0, arg1 := m.LastBlock().GetParams2()
+				s := Sprintf(m, arg0.TV.GetString(), arg1.TV)
+				m.PushValue(typedString(m.Alloc.NewString(s)))
+			},
+		)
+		// Like Sprintf, but also accepts a single %w verb
+		// with an error operand, whose index is returned
+		// (or -1) for Errorf to wrap.
+		pn.DefineNative("Errorf",
+			gno.Flds( // params
+				"format", "string",
+				"a", gno.SliceT(gno.AnyT()),
+			),
+			gno.Flds( // results
+				"s", "string",
+				"wrapped", "int",
+			),
+			func(m *gno.Machine) {
+				arg0, arg1 := m.LastBlock().GetParams2()
+				p := newGnoPrinter(m)
+				p.wrapErrs = true
+				p.doPrintf(arg0.TV.GetString(), fmtArgs(m, arg1.TV))
+				m.PushValue(typedString(m.Alloc.NewString(p.result())))
+				m.PushValue(typedInt(p.wrappedArgNum))
+			},
+		)
+	case "internal/crypto/ed25519":
+		pn.DefineNative("Verify",
+			gno.Flds( // params
+				"publicKey", "[]byte",
+				"message", "[]byte",
+				"sig", "[]byte",
+			),
+			gno.Flds( // results
+				"ok", "bool",
+			),
+			func(m *gno.Machine) {
+				m.IncrCPU(cpuVerifyEd25519)
+				arg0, arg1, arg2 := m.LastBlock().GetParams3()
+				pub := readonlyBytes(m, arg0.TV)
+				msg := readonlyBytes(m, arg1.TV)
+				sig := readonlyBytes(m, arg2.TV)
+
+				ok := false
+				if len(pub) == ed25519.PubKeyEd25519Size {
+					var pubKey ed25519.PubKeyEd25519
+					copy(pubKey[:], pub)
+					ok = pubKey.VerifyBytes(msg, sig)
+				}
+				m.PushValue(typedBool(ok))
+			},
+		)
+	case "internal/crypto/secp256k1":
+		pn.DefineNative("Verify",
+			gno.Flds( // params
+				"publicKey", "[]byte",
+				"message", "[]byte",
+				"sig", "[]byte",
+			),
+			gno.Flds( // results
+				"ok", "bool",
+			),
+			func(m *gno.Machine) {
+				m.IncrCPU(cpuVerifySecp256k1)
+				arg0, arg1, arg2 := m.LastBlock().GetParams3()
+				pub := readonlyBytes(m, arg0.TV)
+				msg := readonlyBytes(m, arg1.TV)
+				sig := readonlyBytes(m, arg2.TV)
+
+				ok := false
+				if len(pub) == secp256k1.PubKeySecp256k1Size {
+					var pubKey secp256k1.PubKeySecp256k1
+					copy(pubKey[:], pub)
+					ok = pubKey.VerifyBytes(msg, sig)
+				}
+				m.PushValue(typedBool(ok))
+			},
+		)
+		pn.DefineNative("RecoverPubKey",
+			gno.Flds( // params
+				"message", "[]byte",
+				"sig", "[]byte",
+			),
+			gno.Flds( // results
+				"publicKey", "[]byte",
+				"ok", "bool",
+			),
+			func(m *gno.Machine) {
+				m.IncrCPU(cpuRecoverSecp256k1)
+				arg0, arg1 := m.LastBlock().GetParams2()
+				msg := readonlyBytes(m, arg0.TV)
+				sig := readonlyBytes(m, arg1.TV)
+
+				pubKey, err := secp256k1.RecoverPubKey(msg, sig)
+				if err != nil {
+					m.PushValue(typedByteSlice(nil))
+					m.PushValue(typedBool(false))
+				} else {
+					m.PushValue(typedByteSlice(m.Alloc.NewSliceFromData(pubKey[:])))
+					m.PushValue(typedBool(true))
+				}
+			},
+		)
 	case "internal/math":
 		pn.DefineNative("Float32bits",
 			gno.Flds( // params
@@ -129,6 +277,50 @@ func InjectPackage(store gno.Store, pn *gno.PackageNode) {
 				}
 			},
 		)
+	case "internal/std":
+		// Used by std.bankAdapter.SendCoins to notify the
+		// receiving realm, which must type check the result.
+		pn.DefineNative("GetOnReceive",
+			gno.Flds( // params
+				"addr", "string",
+			),
+			gno.Flds( // results
+				"", gno.AnyT(),
+			),
+			func(m *gno.Machine) {
+				arg0 := m.LastBlock().GetParams1().TV
+				res0 := gno.TypedValue{} // nil
+				addr, err := crypto.AddressFromBech32(arg0.GetString())
+				if err == nil {
+					if hook, ok := getOnReceive(m.Store, addr); ok {
+						res0 = hook
+					}
+				}
+				m.PushValue(res0)
+			},
+		)
+		// Used by std.bankAdapter.SendCoins to give coins back
+		// to the sender when the receiving realm rejects them.
+		pn.DefineNative("RevertSend",
+			gno.Flds( // params
+				"from", "string",
+				"to", "string",
+				"amt", "string",
+			),
+			gno.Flds( // results
+			),
+			func(m *gno.Machine) {
+				arg0, arg1, arg2 := m.LastBlock().GetParams3()
+				from := crypto.Bech32Address(arg0.TV.GetString())
+				to := crypto.Bech32Address(arg1.TV.GetString())
+				amt, err := std.ParseCoins(arg2.TV.GetString())
+				if err != nil {
+					panic(err)
+				}
+				ctx := m.Context.(ExecContext)
+				ctx.Banker.SendCoins(to, from, amt)
+			},
+		)
 	// case "internal/os_test":
 	// XXX defined in tests/imports.go
 	case "strconv":
@@ -376,6 +568,71 @@ func InjectPackage(store gno.Store, pn *gno.PackageNode) {
 				m.PushValue(res0)
 			},
 		)
+		pn.DefineNative("SetParam",
+			gno.Flds( // params
+				"module", "string",
+				"key", "string",
+				"value", "string",
+			),
+			gno.Flds( // results
+			),
+			func(m *gno.Machine) {
+				ctx := m.Context.(ExecContext)
+				if ctx.Params == nil {
+					panic("params are not available in this context")
+				}
+				realmPath := ""
+				if m.Realm != nil {
+					realmPath = m.Realm.Path
+				}
+				arg0, arg1, arg2 := m.LastBlock().GetParams3()
+				module := arg0.TV.GetString()
+				key := arg1.TV.GetString()
+				value := arg2.TV.GetString()
+				ctx.Params.SetParam(realmPath, module, key, value)
+			},
+		)
+		pn.DefineNative("ScheduleUpgrade",
+			gno.Flds( // params
+				"name", "string",
+				"height", "int64",
+				"info", "string",
+			),
+			gno.Flds( // results
+			),
+			func(m *gno.Machine) {
+				ctx := m.Context.(ExecContext)
+				if ctx.Upgrader == nil {
+					panic("upgrades are not available in this context")
+				}
+				realmPath := ""
+				if m.Realm != nil {
+					realmPath = m.Realm.Path
+				}
+				arg0, arg1, arg2 := m.LastBlock().GetParams3()
+				name := arg0.TV.GetString()
+				height := arg1.TV.GetInt64()
+				info := arg2.TV.GetString()
+				ctx.Upgrader.ScheduleUpgrade(realmPath, name, height, info)
+			},
+		)
+		pn.DefineNative("CancelUpgrade",
+			gno.Flds( // params
+			),
+			gno.Flds( // results
+			),
+			func(m *gno.Machine) {
+				ctx := m.Context.(ExecContext)
+				if ctx.Upgrader == nil {
+					panic("upgrades are not available in this context")
+				}
+				realmPath := ""
+				if m.Realm != nil {
+					realmPath = m.Realm.Path
+				}
+				ctx.Upgrader.CancelUpgrade(realmPath)
+			},
+		)
 		// XXX DEPRECATED, use stdlibs/time instead
 		pn.DefineNative("GetTimestamp",
 			gno.Flds( // params
@@ -483,9 +740,56 @@ func InjectPackage(store gno.Store, pn *gno.PackageNode) {
 				m.PushValue(res0)
 			},
 		)
+		pn.DefineNative("VerifySignature",
+			gno.Flds( // params
+				"pubKey", "string",
+				"msg", "[]byte",
+				"sig", "[]byte",
+			),
+			gno.Flds( // results
+				"ok", "bool",
+			),
+			func(m *gno.Machine) {
+				m.IncrCPU(cpuDecodeBech32PubKey)
+				arg0, arg1, arg2 := m.LastBlock().GetParams3()
+				pubKey, err := crypto.PubKeyFromBech32(arg0.TV.GetString())
+				if err != nil {
+					m.PushValue(typedBool(false))
+					return
+				}
+				switch pubKey.(type) {
+				case ed25519.PubKeyEd25519:
+					m.IncrCPU(cpuVerifyEd25519)
+				case secp256k1.PubKeySecp256k1:
+					m.IncrCPU(cpuVerifySecp256k1)
+				default:
+					m.IncrCPU(cpuVerifyOtherPubKey)
+				}
+				msg := readonlyBytes(m, arg1.TV)
+				sig := readonlyBytes(m, arg2.TV)
+				m.PushValue(typedBool(pubKey.VerifyBytes(msg, sig)))
+			},
+		)
 	}
 }
 
+// readonlyBytes returns the bytes of a []byte typed value,
+// which must not be modified.
+func readonlyBytes(m *gno.Machine, tv *gno.TypedValue) []byte {
+	if tv.V == nil {
+		return nil
+	}
+	slice := tv.V.(*gno.SliceValue)
+	array := slice.GetBase(m.Store)
+	return array.GetReadonlyBytes()[slice.Offset : slice.Offset+slice.Length]
+}
+
+func typedInt(i int) gno.TypedValue {
+	tv := gno.TypedValue{T: gno.IntType}
+	tv.SetInt(i)
+	return tv
+}
+
 func typedInt32(i32 int32) gno.TypedValue {
 	tv := gno.TypedValue{T: gno.Int32Type}
 	tv.SetInt32(i32)
@@ -0,0 +1,10 @@
+package stdlibs
+
+// Upgrader is the native hook through which an authorized realm
+// schedules and cancels software upgrades, see std.ScheduleUpgrade.
+// Implementations panic if the realm is not authorized,
+// or if the plan is invalid.
+type Upgrader interface {
+	ScheduleUpgrade(realmPath, name string, height int64, info string)
+	CancelUpgrade(realmPath string)
+}
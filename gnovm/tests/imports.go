@@ -110,8 +110,7 @@ func TestStore(rootDir, filesPath string, stdin io.Reader, stdout, stderr io.Wri
 
 		// if native package is allowed, return it.
 		if pkgPath == "os" || // special cases even when StdlibsOnly (for tests).
-			pkgPath == "fmt" || // TODO: try to minimize these exceptions over time.
-			pkgPath == "log" ||
+			pkgPath == "log" || // TODO: try to minimize these exceptions over time.
 			pkgPath == "crypto/rand" ||
 			pkgPath == "crypto/md5" ||
 			pkgPath == "crypto/sha1" ||
@@ -441,7 +440,9 @@ func TestStore(rootDir, filesPath string, stdin io.Reader, stdout, stderr io.Wri
 	iavlStore := iavl.StoreConstructor(db, stypes.StoreOptions{})
 	store = gno.NewStore(nil, baseStore, iavlStore)
 	store.SetPackageGetter(getPackage)
-	store.SetPackageInjector(testPackageInjector)
+	store.SetPackageInjector(func(store gno.Store, pn *gno.PackageNode) {
+		testPackageInjector(store, pn, stdout)
+	})
 	store.SetStrictGo2GnoMapping(false)
 	// native mappings
 	stdlibs.InjectNativeMappings(store)
@@ -453,11 +454,58 @@ func TestStore(rootDir, filesPath string, stdin io.Reader, stdout, stderr io.Wri
 // analogous to stdlibs.InjectNatives, but with
 // native methods suitable for the testing environment.
 
-func testPackageInjector(store gno.Store, pn *gno.PackageNode) {
+func testPackageInjector(store gno.Store, pn *gno.PackageNode, stdout io.Writer) {
 	// Also inject stdlibs native functions.
 	stdlibs.InjectPackage(store, pn)
 	// Test specific injections:
 	switch pn.PkgPath {
+	case "fmt":
+		if pn.FileSet == nil {
+			break // native fmt, see testStore.
+		}
+		// NOTE: stdlibs/fmt has no functions printing
+		// to stdout, which only make sense in tests.
+		pn.DefineGoNativeType(reflect.TypeOf((*fmt.Formatter)(nil)).Elem())
+		pn.DefineNative("Println",
+			gno.Flds( // params
+				"a", gno.Vrd(gno.AnyT()),
+			),
+			gno.Flds( // results
+				"n", "int",
+				"err", "error",
+			),
+			func(m *gno.Machine) {
+				arg0 := m.LastBlock().GetParams1()
+				testPrint(m, stdout, stdlibs.Sprintln(m, arg0.TV))
+			},
+		)
+		pn.DefineNative("Print",
+			gno.Flds( // params
+				"a", gno.Vrd(gno.AnyT()),
+			),
+			gno.Flds( // results
+				"n", "int",
+				"err", "error",
+			),
+			func(m *gno.Machine) {
+				arg0 := m.LastBlock().GetParams1()
+				testPrint(m, stdout, stdlibs.Sprint(m, arg0.TV))
+			},
+		)
+		pn.DefineNative("Printf",
+			gno.Flds( // params
+				"format", "string",
+				"a", gno.Vrd(gno.AnyT()),
+			),
+			gno.Flds( // results
+				"n", "int",
+				"err", "error",
+			),
+			func(m *gno.Machine) {
+				arg0, arg1 := m.LastBlock().GetParams2()
+				testPrint(m, stdout, stdlibs.Sprintf(m, arg0.TV.GetString(), arg1.TV))
+			},
+		)
 	case "strconv":
 		// NOTE: Itoa and Atoi are already injected
 		// from stdlibs.InjectNatives.
@@ -710,3 +758,13 @@ type TestReport struct {
 	Skipped bool
 	Output  string
 }
+
+// testPrint writes s to the test store's stdout, and pushes the
+// results of fmt.Print*.
+func testPrint(m *gno.Machine, stdout io.Writer, s string) {
+	n, _ := stdout.Write([]byte(s))
+	res0 := gno.TypedValue{T: gno.IntType}
+	res0.SetInt(n)
+	m.PushValue(res0)
+	m.PushValue(gno.TypedValue{}) // nil error
+}
@@ -0,0 +1 @@
+module gno.land/p/test/pure
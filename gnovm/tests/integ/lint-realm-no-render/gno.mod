@@ -0,0 +1 @@
+module gno.land/r/test/norender
@@ -4,18 +4,22 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"reflect"
 
 	"github.com/gnolang/gno/tm2/pkg/amino"
+	"github.com/gnolang/gno/tm2/pkg/amino/gengo"
 	"github.com/gnolang/gno/tm2/pkg/amino/genproto"
 	"github.com/gnolang/gno/tm2/pkg/commands"
 
 	// TODO: move these out.
+	"github.com/gnolang/gno/gno.land/pkg/gnoland"
 	gno "github.com/gnolang/gno/gnovm/pkg/gnolang"
 	abci "github.com/gnolang/gno/tm2/pkg/bft/abci/types"
 	"github.com/gnolang/gno/tm2/pkg/bft/blockchain"
 	"github.com/gnolang/gno/tm2/pkg/bft/consensus"
 	ctypes "github.com/gnolang/gno/tm2/pkg/bft/consensus/types"
 	"github.com/gnolang/gno/tm2/pkg/bft/mempool"
+	coregrpc "github.com/gnolang/gno/tm2/pkg/bft/rpc/grpc"
 	btypes "github.com/gnolang/gno/tm2/pkg/bft/types"
 	"github.com/gnolang/gno/tm2/pkg/bitarray"
 	"github.com/gnolang/gno/tm2/pkg/crypto/ed25519"
@@ -23,7 +27,10 @@ import (
 	"github.com/gnolang/gno/tm2/pkg/crypto/merkle"
 	"github.com/gnolang/gno/tm2/pkg/crypto/multisig"
 	"github.com/gnolang/gno/tm2/pkg/sdk"
+	"github.com/gnolang/gno/tm2/pkg/sdk/auth"
 	"github.com/gnolang/gno/tm2/pkg/sdk/bank"
+	"github.com/gnolang/gno/tm2/pkg/sdk/params"
+	"github.com/gnolang/gno/tm2/pkg/sdk/upgrade"
 	"github.com/gnolang/gno/tm2/pkg/sdk/vm"
 	"github.com/gnolang/gno/tm2/pkg/std"
 )
@@ -31,7 +38,7 @@ import (
 func main() {
 	cmd := commands.NewCommand(
 		commands.Metadata{
-			LongHelp: "Generates proto bindings for Amino packages",
+			LongHelp: "Generates proto bindings and binary2 methods for Amino packages",
 		},
 		commands.NewEmptyConfig(),
 		execGen,
@@ -44,8 +51,9 @@ func main() {
 	}
 }
 
-func execGen(_ context.Context, _ []string) error {
-	pkgs := []*amino.Package{
+// Packages to generate code for.
+func packages() []*amino.Package {
+	return []*amino.Package{
 		bitarray.Package,
 		merkle.Package,
 		abci.Package,
@@ -55,24 +63,60 @@ func execGen(_ context.Context, _ []string) error {
 		mempool.Package,
 		ed25519.Package,
 		blockchain.Package,
+		coregrpc.Package,
 		hd.Package,
 		multisig.Package,
 		std.Package,
 		sdk.Package,
+		auth.Package,
 		bank.Package,
+		params.Package,
+		upgrade.Package,
 		vm.Package,
 		gno.Package,
 	}
+}
+
+// Packages to generate binary2 methods for, without proto3 schemas, as
+// they have fields of unregistered types (e.g. std.Tx).
+func binary2Packages() []*amino.Package {
+	return []*amino.Package{
+		gnoland.Package,
+	}
+}
+
+// Types to generate binary2 methods for besides the registered ones, as
+// they are encoded on hot paths.
+func binary2Types(pkg *amino.Package) []reflect.Type {
+	switch pkg {
+	case std.Package:
+		return []reflect.Type{
+			reflect.TypeOf(std.Tx{}),
+			reflect.TypeOf(std.Fee{}),
+			reflect.TypeOf(std.Signature{}),
+		}
+	default:
+		return nil
+	}
+}
+
+func execGen(_ context.Context, _ []string) error {
+	pkgs := packages()
 
 	for _, pkg := range pkgs {
 		genproto.WriteProto3Schema(pkg)
 		genproto.WriteProtoBindings(pkg)
 		genproto.MakeProtoFolder(pkg, "proto")
+		gengo.WriteBinary2(pkg, binary2Types(pkg)...)
 	}
 
 	for _, pkg := range pkgs {
 		genproto.RunProtoc(pkg, "proto")
 	}
 
+	for _, pkg := range binary2Packages() {
+		gengo.WriteBinary2(pkg, binary2Types(pkg)...)
+	}
+
 	return nil
 }
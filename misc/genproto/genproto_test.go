@@ -0,0 +1,31 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnolang/gno/tm2/pkg/amino/gengo"
+)
+
+// The generated binary2 methods must be regenerated when types change,
+// or new fields would silently not be encoded.
+func TestBinary2UpToDate(t *testing.T) {
+	t.Parallel()
+
+	for _, pkg := range append(packages(), binary2Packages()...) {
+		bz, err := gengo.GenerateBinary2ForTypes(pkg, append(pkg.ReflectTypes(), binary2Types(pkg)...)...)
+		require.NoError(t, err)
+		existing, err := os.ReadFile(filepath.Join(pkg.DirName, "binary2.go"))
+		if bz == nil {
+			assert.True(t, os.IsNotExist(err), "unexpected binary2.go for package %v", pkg)
+			continue
+		}
+		require.NoError(t, err, "missing binary2.go for package %v", pkg)
+		assert.Equal(t, string(bz), string(existing),
+			"binary2.go for package %v is out of date, run `go run ./misc/genproto`", pkg)
+	}
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/gnolang/gno/tm2/pkg/amino"
+	"github.com/gnolang/gno/tm2/pkg/bft/privval"
+	"github.com/gnolang/gno/tm2/pkg/commands"
+	"github.com/gnolang/gno/tm2/pkg/crypto"
+	"github.com/gnolang/gno/tm2/pkg/crypto/ed25519"
+	"github.com/gnolang/gno/tm2/pkg/crypto/keys/armor"
+	dbm "github.com/gnolang/gno/tm2/pkg/db"
+	"github.com/gnolang/gno/tm2/pkg/errors"
+	osm "github.com/gnolang/gno/tm2/pkg/os"
+)
+
+type initCfg struct {
+	rootCfg *config
+
+	importKey   string
+	importState string
+}
+
+func newInitCommand(rootCfg *config) *commands.Command {
+	cfg := &initCfg{
+		rootCfg: rootCfg,
+	}
+
+	return commands.NewCommand(
+		commands.Metadata{
+			Name:       "init",
+			ShortUsage: "init [flags]",
+			ShortHelp:  "Create the encrypted validator key",
+			LongHelp: "Generates a new validator key, or imports the priv_validator_key.json of a node " +
+				"with -import-key, and writes it to the -home directory encrypted with a password. " +
+				"With -import-state, the priv_validator_state.json of the node is recorded in the " +
+				"signing history, so that nothing before it gets signed.",
+		},
+		cfg,
+		func(_ context.Context, _ []string) error {
+			return execInit(cfg, commands.NewDefaultIO())
+		},
+	)
+}
+
+func (c *initCfg) RegisterFlags(fs *flag.FlagSet) {
+	fs.StringVar(&c.importKey, "import-key", "", "priv_validator_key.json file to import (optional)")
+	fs.StringVar(&c.importState, "import-state", "", "priv_validator_state.json file to import (optional)")
+}
+
+func execInit(c *initCfg, io *commands.IO) error {
+	keyFile := c.rootCfg.keyFile()
+	if osm.FileExists(keyFile) {
+		return fmt.Errorf("key file %s already exists", keyFile)
+	}
+
+	var privKey crypto.PrivKey
+	if c.importKey != "" {
+		var pvKey privval.FilePVKey
+		if err := readJSONFile(c.importKey, &pvKey); err != nil {
+			return errors.Wrap(err, "reading key")
+		}
+		privKey = pvKey.PrivKey
+	} else {
+		privKey = ed25519.GenPrivKey()
+	}
+
+	pass, err := io.GetCheckPassword(
+		[2]string{
+			fmt.Sprintf("Enter a passphrase to encrypt the %s:", passwordName),
+			"Repeat the passphrase:",
+		},
+		c.rootCfg.insecurePasswordStdin,
+	)
+	if err != nil {
+		return err
+	}
+	if pass == "" {
+		return errors.New("empty passphrase")
+	}
+
+	if err := osm.EnsureDir(c.rootCfg.home, 0o700); err != nil {
+		return err
+	}
+
+	if c.importState != "" {
+		var lss privval.FilePVLastSignState
+		if err := readJSONFile(c.importState, &lss); err != nil {
+			return errors.Wrap(err, "reading state")
+		}
+		db := dbm.NewDB(dbName, dbm.GoLevelDBBackend, c.rootCfg.home)
+		defer db.Close()
+		if err := privval.NewDBPV(privKey, db).ImportLastSignState(lss); err != nil {
+			return errors.Wrap(err, "importing state")
+		}
+	}
+
+	armored := armor.EncryptArmorPrivKey(privKey, pass)
+	if err := osm.WriteFileAtomic(keyFile, []byte(armored), 0o600); err != nil {
+		return err
+	}
+
+	io.Printfln("Validator address: %s", privKey.PubKey().Address())
+	io.Printfln("Validator pubkey: %s", privKey.PubKey())
+	io.Printfln("Key written to %s", keyFile)
+	return nil
+}
+
+func readJSONFile(path string, ptr interface{}) error {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return amino.UnmarshalJSON(bz, ptr)
+}
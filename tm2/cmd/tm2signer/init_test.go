@@ -0,0 +1,75 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnolang/gno/tm2/pkg/bft/privval"
+	"github.com/gnolang/gno/tm2/pkg/commands"
+	"github.com/gnolang/gno/tm2/pkg/crypto/keys/armor"
+	dbm "github.com/gnolang/gno/tm2/pkg/db"
+)
+
+func newTestIO(in string) *commands.IO {
+	io := commands.NewTestIO()
+	io.SetIn(strings.NewReader(in))
+	io.SetOut(commands.WriteNopCloser(new(bytes.Buffer)))
+	io.SetErr(commands.WriteNopCloser(new(bytes.Buffer)))
+	return io
+}
+
+func TestInitImport(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	keyFile := filepath.Join(dir, "priv_validator_key.json")
+	stateFile := filepath.Join(dir, "priv_validator_state.json")
+	filePV := privval.GenFilePV(keyFile, stateFile)
+	filePV.Save()
+
+	cfg := &initCfg{
+		rootCfg: &config{
+			home:                  filepath.Join(dir, "signer"),
+			insecurePasswordStdin: true,
+		},
+		importKey:   keyFile,
+		importState: stateFile,
+	}
+	require.NoError(t, execInit(cfg, newTestIO("pass\npass\n")))
+
+	// The key is encrypted with the password.
+	armored, err := os.ReadFile(cfg.rootCfg.keyFile())
+	require.NoError(t, err)
+	_, err = armor.UnarmorDecryptPrivKey(string(armored), "wrong")
+	assert.Error(t, err)
+	privKey, err := armor.UnarmorDecryptPrivKey(string(armored), "pass")
+	require.NoError(t, err)
+	assert.Equal(t, filePV.Key.PrivKey, privKey)
+
+	// An existing key is not overwritten.
+	assert.Error(t, execInit(cfg, newTestIO("pass\npass\n")))
+
+	// The state of a validator which didn't sign yet is empty.
+	db := dbm.NewDB(dbName, dbm.GoLevelDBBackend, cfg.rootCfg.home)
+	defer db.Close()
+	assert.Nil(t, privval.NewDBPV(privKey, db).LastSignState())
+}
+
+func TestInitPasswordMismatch(t *testing.T) {
+	t.Parallel()
+
+	cfg := &initCfg{
+		rootCfg: &config{
+			home:                  t.TempDir(),
+			insecurePasswordStdin: true,
+		},
+	}
+	assert.Error(t, execInit(cfg, newTestIO("pass\nother\n")))
+	assert.NoFileExists(t, cfg.rootCfg.keyFile())
+}
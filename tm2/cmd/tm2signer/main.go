@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/gnolang/gno/tm2/pkg/commands"
+)
+
+// config is the shared config for tm2signer, and its subcommands
+type config struct {
+	home                  string
+	insecurePasswordStdin bool
+}
+
+const (
+	defaultHome  = "./signer"
+	keyFileName  = "signer_key.armor"
+	dbName       = "signer_state"
+	passwordName = "signer key"
+)
+
+func main() {
+	cfg := &config{}
+
+	cmd := commands.NewCommand(
+		commands.Metadata{
+			ShortUsage: "<subcommand> [flags] [<arg>...]",
+			LongHelp:   "Remote signer holding a validator key, with a slashing-protection database",
+		},
+		cfg,
+		commands.HelpExec,
+	)
+
+	cmd.AddSubCommands(
+		newInitCommand(cfg),
+		newStartCommand(cfg),
+	)
+
+	if err := cmd.ParseAndRun(context.Background(), os.Args[1:]); err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "%+v", err)
+
+		os.Exit(1)
+	}
+}
+
+func (c *config) RegisterFlags(fs *flag.FlagSet) {
+	fs.StringVar(
+		&c.home,
+		"home",
+		defaultHome,
+		"directory of the encrypted key and of the signing history",
+	)
+
+	fs.BoolVar(
+		&c.insecurePasswordStdin,
+		"insecure-password-stdin",
+		false,
+		"WARNING! take password from stdin",
+	)
+}
+
+// keyFile returns the path of the encrypted validator key.
+func (c *config) keyFile() string {
+	return filepath.Join(c.home, keyFileName)
+}
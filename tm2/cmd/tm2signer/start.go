@@ -0,0 +1,130 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gnolang/gno/tm2/pkg/bft/privval"
+	"github.com/gnolang/gno/tm2/pkg/commands"
+	"github.com/gnolang/gno/tm2/pkg/crypto/ed25519"
+	"github.com/gnolang/gno/tm2/pkg/crypto/keys/armor"
+	dbm "github.com/gnolang/gno/tm2/pkg/db"
+	"github.com/gnolang/gno/tm2/pkg/errors"
+	"github.com/gnolang/gno/tm2/pkg/log"
+	osm "github.com/gnolang/gno/tm2/pkg/os"
+)
+
+type startCfg struct {
+	rootCfg *config
+
+	chainID string
+	remotes string
+	timeout time.Duration
+}
+
+func newStartCommand(rootCfg *config) *commands.Command {
+	cfg := &startCfg{
+		rootCfg: rootCfg,
+	}
+
+	return commands.NewCommand(
+		commands.Metadata{
+			Name:       "start",
+			ShortUsage: "start [flags]",
+			ShortHelp:  "Serve signature requests of nodes",
+			LongHelp: "Dials the priv_validator_laddr of each -remote node, and signs their votes and " +
+				"proposals with the validator key. Every signature is recorded in the signing " +
+				"history of the -home directory, and conflicting requests are refused, across " +
+				"restarts and across nodes.",
+		},
+		cfg,
+		func(_ context.Context, _ []string) error {
+			return execStart(cfg, commands.NewDefaultIO())
+		},
+	)
+}
+
+func (c *startCfg) RegisterFlags(fs *flag.FlagSet) {
+	fs.StringVar(&c.chainID, "chain-id", "", "chain id of the signed votes and proposals")
+	fs.StringVar(
+		&c.remotes,
+		"remote",
+		"",
+		"comma-separated priv_validator_laddr of the nodes, as tcp://<host:port> or unix://<path>",
+	)
+	fs.DurationVar(&c.timeout, "timeout", 3*time.Second, "read and write timeout of the connections")
+}
+
+func execStart(c *startCfg, io *commands.IO) error {
+	if c.chainID == "" {
+		return errors.New("chain-id not specified")
+	}
+	if c.remotes == "" {
+		return errors.New("remote not specified")
+	}
+
+	armored, err := os.ReadFile(c.rootCfg.keyFile())
+	if err != nil {
+		return errors.Wrap(err, "reading key, run `tm2signer init` first")
+	}
+	pass, err := io.GetPassword(
+		fmt.Sprintf("Enter the passphrase of the %s:", passwordName),
+		c.rootCfg.insecurePasswordStdin,
+	)
+	if err != nil {
+		return err
+	}
+	privKey, err := armor.UnarmorDecryptPrivKey(string(armored), pass)
+	if err != nil {
+		return errors.Wrap(err, "decrypting key")
+	}
+
+	logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout))
+	db := dbm.NewDB(dbName, dbm.GoLevelDBBackend, c.rootCfg.home)
+	defer db.Close()
+	pv := privval.NewDBPV(privKey, db)
+	logger.Info("Loaded validator", "pv", pv)
+
+	// The key of the secret connections; nodes don't authenticate it.
+	connKey := ed25519.GenPrivKey()
+
+	var servers []*privval.SignerServer
+	for _, remote := range strings.Split(c.remotes, ",") {
+		remote = strings.TrimSpace(remote)
+		var dialer privval.SocketDialer
+		switch protocol, address := osm.ProtocolAndAddress(remote); protocol {
+		case "tcp":
+			dialer = privval.DialTCPFn(address, c.timeout, connKey)
+		case "unix":
+			dialer = privval.DialUnixFn(address)
+		default:
+			return fmt.Errorf("invalid remote %q: expected either 'tcp' or 'unix' protocols", remote)
+		}
+
+		endpoint := privval.NewSignerDialerEndpoint(logger.With("remote", remote), dialer)
+		privval.SignerDialerEndpointTimeoutReadWrite(c.timeout)(endpoint)
+		// Keep dialing nodes which are down.
+		privval.SignerDialerEndpointConnRetries(math.MaxInt32)(endpoint)
+
+		server := privval.NewSignerServer(endpoint, c.chainID, pv)
+		if err := server.Start(); err != nil {
+			return errors.Wrap(err, "starting signer server for %s", remote)
+		}
+		servers = append(servers, server)
+	}
+
+	// run forever
+	osm.TrapSignal(func() {
+		for _, server := range servers {
+			_ = server.Stop()
+		}
+		db.Close()
+	})
+
+	select {} // run forever
+}
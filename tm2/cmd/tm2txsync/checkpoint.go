@@ -0,0 +1,41 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/gnolang/gno/tm2/pkg/errors"
+	osm "github.com/gnolang/gno/tm2/pkg/os"
+)
+
+// checkpoint records the progress of an export: the last exported height,
+// and the size of the output file once its txs were written. Resuming
+// truncates the output to Offset, dropping any partially written block.
+type checkpoint struct {
+	Height int64 `json:"height"`
+	Offset int64 `json:"offset"`
+}
+
+// loadCheckpoint reads the checkpoint file at path. A missing file is an
+// empty checkpoint.
+func loadCheckpoint(path string) (*checkpoint, error) {
+	bz, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return &checkpoint{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	cp := &checkpoint{}
+	if err := json.Unmarshal(bz, cp); err != nil {
+		return nil, errors.Wrap(err, "invalid checkpoint file %s", path)
+	}
+	return cp, nil
+}
+
+func (cp *checkpoint) save(path string) error {
+	bz, err := json.Marshal(cp)
+	if err != nil {
+		return err
+	}
+	return osm.WriteFileAtomic(path, bz, 0o644)
+}
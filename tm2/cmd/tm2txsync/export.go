@@ -7,12 +7,12 @@ import (
 	"io"
 	"log"
 	"os"
-	"strings"
 	"time"
 
 	"github.com/gnolang/gno/tm2/pkg/amino"
 	"github.com/gnolang/gno/tm2/pkg/bft/rpc/client"
 	"github.com/gnolang/gno/tm2/pkg/commands"
+	"github.com/gnolang/gno/tm2/pkg/errors"
 	"github.com/gnolang/gno/tm2/pkg/std"
 
 	_ "github.com/gnolang/gno/tm2/pkg/sdk/auth" // XXX better way?
@@ -23,12 +23,18 @@ import (
 type exportCfg struct {
 	rootCfg *config
 
-	startHeight int64
-	tailHeight  int64
-	endHeight   int64
-	outFile     string
-	quiet       bool
-	follow      bool
+	startHeight    int64
+	tailHeight     int64
+	endHeight      int64
+	includeEnd     bool
+	outFile        string
+	quiet          bool
+	follow         bool
+	dataDir        string
+	dbBackend      string
+	workers        int
+	checkpoint     string
+	onlySuccessful bool
 }
 
 func newExportCommand(rootCfg *config) *commands.Command {
@@ -41,10 +47,13 @@ func newExportCommand(rootCfg *config) *commands.Command {
 			Name:       "export",
 			ShortUsage: "export [flags] <file>",
 			ShortHelp:  "Export transactions to file",
+			LongHelp: "Exports the transactions of a range of blocks, as JSON lines. " +
+				"Blocks are fetched from the -remote node, or read from the database of a " +
+				"stopped node with -data-dir.",
 		},
 		cfg,
-		func(_ context.Context, _ []string) error {
-			return execExport(cfg)
+		func(ctx context.Context, _ []string) error {
+			return execExport(ctx, cfg)
 		},
 	)
 }
@@ -52,18 +61,47 @@ func newExportCommand(rootCfg *config) *commands.Command {
 func (c *exportCfg) RegisterFlags(fs *flag.FlagSet) {
 	fs.Int64Var(&c.startHeight, "start", 1, "start height")
 	fs.Int64Var(&c.tailHeight, "tail", 0, "start at LAST - N")
-	fs.Int64Var(&c.endHeight, "end", 0, "end height (optional)")
+	fs.Int64Var(&c.endHeight, "end", 0, "end height, exclusive (optional)")
+	fs.BoolVar(&c.includeEnd, "include-end", false, "export the block at the end height too")
 	fs.StringVar(&c.outFile, "out", defaultFilePath, "output file path")
 	fs.BoolVar(&c.quiet, "quiet", false, "omit console output during execution")
 	fs.BoolVar(&c.follow, "follow", false, "keep attached and follow new events")
+	fs.StringVar(&c.dataDir, "data-dir", "", "data directory of a stopped node, to read blocks from instead of the remote")
+	fs.StringVar(&c.dbBackend, "db-backend", "goleveldb", "database backend of the -data-dir node")
+	fs.IntVar(&c.workers, "workers", 8, "number of blocks fetched in parallel from the remote")
+	fs.StringVar(&c.checkpoint, "checkpoint", "", "checkpoint file, to resume an interrupted export (optional)")
+	fs.BoolVar(&c.onlySuccessful, "only-successful", false, "omit the transactions whose delivery failed")
 }
 
-func execExport(c *exportCfg) error {
-	node := client.NewHTTP(c.rootCfg.remote, "/websocket")
+func execExport(ctx context.Context, c *exportCfg) error {
+	if c.workers < 1 {
+		return errors.New("invalid number of workers %d", c.workers)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var src txSource
+	if c.dataDir != "" {
+		if c.follow {
+			return errors.New("cannot follow a stopped node")
+		}
+		dbSrc, err := newDBSource(c.dataDir, c.dbBackend, c.onlySuccessful)
+		if err != nil {
+			return err
+		}
+		defer dbSrc.Close()
+		src = dbSrc
+	} else {
+		src = rpcSource{
+			node:           client.NewHTTP(c.rootCfg.remote, "/websocket"),
+			onlySuccessful: c.onlySuccessful,
+		}
+	}
 
-	status, err := node.Status()
+	latest, err := src.LatestHeight()
 	if err != nil {
-		return fmt.Errorf("unable to fetch node status, %w", err)
+		return fmt.Errorf("unable to fetch latest height, %w", err)
 	}
 
 	var (
@@ -73,71 +111,179 @@ func execExport(c *exportCfg) error {
 	)
 
 	if end == 0 { // take last block height
-		end = status.SyncInfo.LatestBlockHeight
+		end = latest
 	}
 	if tail > 0 {
 		start = end - tail
 	}
+	if !c.includeEnd {
+		end--
+	}
+	if c.follow {
+		end = 0
+	}
 
-	var out io.Writer
+	var (
+		out  io.Writer
+		file *os.File
+		cp   *checkpoint
+	)
 	switch c.outFile {
 	case "-", "STDOUT":
+		if c.checkpoint != "" {
+			return errors.New("cannot checkpoint an export to stdout")
+		}
 		out = os.Stdout
 	default:
-		out, err = os.OpenFile(c.outFile, os.O_RDWR|os.O_CREATE, 0o755)
+		file, err = os.OpenFile(c.outFile, os.O_RDWR|os.O_CREATE, 0o644)
 		if err != nil {
 			return err
 		}
-	}
-
-	for height := start; ; height++ {
-		if !c.follow && height >= end {
-			break
-		}
-
-	getBlock:
-		block, err := node.Block(&height)
-		if err != nil {
-			if c.follow && strings.Contains(err.Error(), "") {
-				time.Sleep(time.Second)
+		defer file.Close()
 
-				goto getBlock
+		var offset int64
+		if c.checkpoint != "" {
+			cp, err = loadCheckpoint(c.checkpoint)
+			if err != nil {
+				return err
+			}
+			if cp.Height >= start {
+				start = cp.Height + 1
+				offset = cp.Offset
+				if !c.quiet {
+					log.Printf("resuming from checkpoint h=%d", cp.Height)
+				}
 			}
-
-			return fmt.Errorf("encountered error while fetching block, %w", err)
 		}
+		// drop what was written after the checkpoint, or the content of
+		// a previous export.
+		if err := file.Truncate(offset); err != nil {
+			return err
+		}
+		if _, err := file.Seek(offset, io.SeekStart); err != nil {
+			return err
+		}
+		out = file
+	}
 
-		txs := block.Block.Data.Txs
-		if len(txs) == 0 {
-			continue
+	blocks := fetchBlocks(ctx, src, start, end, c.workers, c.follow)
+	for block := range blocks {
+		if block.err != nil {
+			return fmt.Errorf("encountered error while fetching block %d, %w", block.height, block.err)
 		}
 
-		_, err = node.BlockResults(&height)
-		if err != nil {
-			if c.follow && strings.Contains(err.Error(), "") {
-				time.Sleep(time.Second)
+		for _, tx := range block.txs {
+			_, _ = fmt.Fprintln(out, string(amino.MustMarshalJSON(tx)))
+		}
 
-				goto getBlock
+		if cp != nil && (len(block.txs) > 0 || block.height == end) {
+			if err := file.Sync(); err != nil {
+				return err
+			}
+			offset, err := file.Seek(0, io.SeekCurrent)
+			if err != nil {
+				return err
+			}
+			cp.Height, cp.Offset = block.height, offset
+			if err := cp.save(c.checkpoint); err != nil {
+				return err
 			}
+		}
 
-			return fmt.Errorf("encountered error while fetching block results, %w", err)
+		if !c.quiet && len(block.txs) > 0 {
+			log.Printf("h=%d/%d (txs=%d)", block.height, end, len(block.txs))
 		}
+	}
 
-		for i := 0; i < len(txs); i++ {
-			tx := txs[i]
-			stdtx := std.Tx{}
+	return ctx.Err()
+}
 
-			amino.MustUnmarshal(tx, &stdtx)
+// fetchedBlock holds the txs of the block at height, or the error
+// fetching them.
+type fetchedBlock struct {
+	height int64
+	txs    []std.Tx
+	err    error
+}
 
-			bz := amino.MustMarshalJSON(stdtx)
+// fetchBlocks fetches the blocks from start to end with workers in
+// parallel, and sends them in order on the returned channel, which is
+// closed after the end block or the first error. If follow is set, end is
+// ignored and blocks not produced yet are waited for.
+func fetchBlocks(ctx context.Context, src txSource, start, end int64, workers int, follow bool) <-chan fetchedBlock {
+	type job struct {
+		height int64
+		res    chan fetchedBlock
+	}
+	var (
+		jobs    = make(chan job)
+		ordered = make(chan chan fetchedBlock, 2*workers)
+		out     = make(chan fetchedBlock)
+	)
 
-			_, _ = fmt.Fprintln(out, string(bz))
-		}
+	ctx, cancel := context.WithCancel(ctx)
 
-		if !c.quiet {
-			log.Printf("h=%d/%d (txs=%d)", height, end, len(txs))
+	// schedule the heights in order.
+	go func() {
+		defer close(jobs)
+		defer close(ordered)
+		for height := start; follow || height <= end; height++ {
+			res := make(chan fetchedBlock, 1)
+			select {
+			case ordered <- res:
+			case <-ctx.Done():
+				return
+			}
+			select {
+			case jobs <- job{height, res}:
+			case <-ctx.Done():
+				return
+			}
 		}
+	}()
+
+	for i := 0; i < workers; i++ {
+		go func() {
+			for j := range jobs {
+				block := fetchedBlock{height: j.height}
+				for {
+					block.txs, block.err = src.BlockTxs(j.height)
+					if block.err == nil || !follow {
+						break
+					}
+					// the block is not produced yet.
+					select {
+					case <-time.After(time.Second):
+					case <-ctx.Done():
+						return
+					}
+				}
+				j.res <- block
+			}
+		}()
 	}
 
-	return nil
+	// forward the blocks in order.
+	go func() {
+		defer close(out)
+		defer cancel()
+		for res := range ordered {
+			var block fetchedBlock
+			select {
+			case block = <-res:
+			case <-ctx.Done():
+				return
+			}
+			select {
+			case out <- block:
+			case <-ctx.Done():
+				return
+			}
+			if block.err != nil {
+				return
+			}
+		}
+	}()
+
+	return out
 }
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnolang/gno/tm2/pkg/amino"
+	abci "github.com/gnolang/gno/tm2/pkg/bft/abci/types"
+	"github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/gnolang/gno/tm2/pkg/std"
+)
+
+// fakeSource has one tx per block, whose memo is the block height.
+type fakeSource struct {
+	latest  int64
+	failing int64
+}
+
+func (s fakeSource) LatestHeight() (int64, error) {
+	return s.latest, nil
+}
+
+func (s fakeSource) BlockTxs(height int64) ([]std.Tx, error) {
+	if height == s.failing || height > s.latest {
+		return nil, errors.New("no block")
+	}
+	return []std.Tx{{Memo: strconv.FormatInt(height, 10)}}, nil
+}
+
+func TestFetchBlocks(t *testing.T) {
+	t.Parallel()
+
+	src := fakeSource{latest: 100}
+	var heights []int64
+	for block := range fetchBlocks(context.Background(), src, 3, 100, 8, false) {
+		require.NoError(t, block.err)
+		require.Len(t, block.txs, 1)
+		assert.Equal(t, strconv.FormatInt(block.height, 10), block.txs[0].Memo)
+		heights = append(heights, block.height)
+	}
+	require.Len(t, heights, 98)
+	for i, height := range heights {
+		assert.Equal(t, int64(i+3), height)
+	}
+
+	// blocks are sent until the first error.
+	src.failing = 50
+	var last fetchedBlock
+	for block := range fetchBlocks(context.Background(), src, 1, 100, 8, false) {
+		last = block
+	}
+	assert.Equal(t, int64(50), last.height)
+	assert.Error(t, last.err)
+}
+
+func TestDecodeTxs(t *testing.T) {
+	t.Parallel()
+
+	txs := types.Txs{
+		amino.MustMarshal(std.Tx{Memo: "ok"}),
+		amino.MustMarshal(std.Tx{Memo: "failed"}),
+	}
+	results := []abci.ResponseDeliverTx{
+		{},
+		{ResponseBase: abci.ResponseBase{Error: abci.StringError("failed")}},
+	}
+
+	// all txs by default.
+	stdtxs, err := decodeTxs(txs, nil, false)
+	require.NoError(t, err)
+	require.Len(t, stdtxs, 2)
+	assert.Equal(t, "ok", stdtxs[0].Memo)
+	assert.Equal(t, "failed", stdtxs[1].Memo)
+
+	// or only the successful ones.
+	stdtxs, err = decodeTxs(txs, results, true)
+	require.NoError(t, err)
+	require.Len(t, stdtxs, 1)
+	assert.Equal(t, "ok", stdtxs[0].Memo)
+
+	_, err = decodeTxs(txs, results[:1], true)
+	assert.Error(t, err)
+}
+
+func TestCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "checkpoint.json")
+	cp, err := loadCheckpoint(path)
+	require.NoError(t, err)
+	assert.Equal(t, checkpoint{}, *cp)
+
+	cp.Height, cp.Offset = 42, 1234
+	require.NoError(t, cp.save(path))
+	loaded, err := loadCheckpoint(path)
+	require.NoError(t, err)
+	assert.Equal(t, *cp, *loaded)
+}
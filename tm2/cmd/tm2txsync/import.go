@@ -6,12 +6,14 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/gnolang/gno/tm2/pkg/amino"
 	"github.com/gnolang/gno/tm2/pkg/bft/rpc/client"
 	"github.com/gnolang/gno/tm2/pkg/commands"
 	"github.com/gnolang/gno/tm2/pkg/errors"
+	"github.com/gnolang/gno/tm2/pkg/sdk"
 	"github.com/gnolang/gno/tm2/pkg/std"
 
 	_ "github.com/gnolang/gno/tm2/pkg/sdk/auth" // XXX better way?
@@ -22,7 +24,11 @@ import (
 type importCfg struct {
 	rootCfg *config
 
-	inFile string
+	inFile     string
+	outFile    string
+	validate   bool
+	balances   string
+	stdlibsDir string
 }
 
 func newImportCommand(rootCfg *config) *commands.Command {
@@ -35,6 +41,9 @@ func newImportCommand(rootCfg *config) *commands.Command {
 			Name:       "import",
 			ShortUsage: "import [flags] <file>",
 			ShortHelp:  "Import transactions from file",
+			LongHelp: "Broadcasts the transactions of a file to the -remote node, or writes them " +
+				"to the -out file, like the genesis txs of a new chain. With -validate, they are " +
+				"first delivered to a local in-memory app, and the failing ones are skipped.",
 		},
 		cfg,
 		func(ctx context.Context, _ []string) error {
@@ -45,6 +54,10 @@ func newImportCommand(rootCfg *config) *commands.Command {
 
 func (c *importCfg) RegisterFlags(fs *flag.FlagSet) {
 	fs.StringVar(&c.inFile, "in", defaultFilePath, "input file path")
+	fs.StringVar(&c.outFile, "out", "", "output file path, to write txs to instead of broadcasting them (optional)")
+	fs.BoolVar(&c.validate, "validate", false, "deliver txs to a local in-memory app first, and skip the failing ones")
+	fs.StringVar(&c.balances, "balances", "", "initial balances of the validation app, as <address>=<coins> lines (optional)")
+	fs.StringVar(&c.stdlibsDir, "stdlibs-dir", filepath.Join("..", "gnovm", "stdlibs"), "gno standard libraries directory of the validation app")
 }
 
 func execImport(ctx context.Context, c *importCfg) error {
@@ -61,10 +74,37 @@ func execImport(ctx context.Context, c *importCfg) error {
 
 	defer file.Close()
 
-	// Start the WS connection to the node
-	node := client.NewHTTP(c.rootCfg.remote, "/websocket")
+	var app *sdk.BaseApp
+	if c.validate {
+		var balances []balance
+		if c.balances != "" {
+			balances, err = readBalances(c.balances)
+			if err != nil {
+				return fmt.Errorf("unable to read balances, %w", err)
+			}
+		}
+		app, err = newValidationApp(c.stdlibsDir, balances)
+		if err != nil {
+			return fmt.Errorf("unable to create validation app, %w", err)
+		}
+	}
 
-	index := 0
+	var (
+		node client.Client
+		out  *os.File
+	)
+	if c.outFile != "" {
+		out, err = os.Create(c.outFile)
+		if err != nil {
+			return fmt.Errorf("unable to create output file, %w", err)
+		}
+		defer out.Close()
+	} else {
+		// Start the WS connection to the node
+		node = client.NewHTTP(c.rootCfg.remote, "/websocket")
+	}
+
+	index, skipped := 0, 0
 	scanner := bufio.NewScanner(file)
 	for scanner.Scan() {
 		select {
@@ -82,6 +122,25 @@ func execImport(ctx context.Context, c *importCfg) error {
 
 			var tx std.Tx
 			amino.MustUnmarshalJSON([]byte(line), &tx)
+
+			if app != nil {
+				if res := app.Deliver(tx); res.IsErr() {
+					print("x")
+					fmt.Printf("\nskipping tx %d: %s\n", index, res.Log)
+					index++
+					skipped++
+					continue
+				}
+			}
+
+			if out != nil {
+				if _, err := fmt.Fprintln(out, line); err != nil {
+					return err
+				}
+				index++
+				continue
+			}
+
 			txbz := amino.MustMarshal(tx)
 
 			res, err := node.BroadcastTxSync(txbz)
@@ -114,5 +173,9 @@ func execImport(ctx context.Context, c *importCfg) error {
 		return fmt.Errorf("error encountered while reading file, %w", err)
 	}
 
+	if skipped > 0 {
+		fmt.Printf("\nskipped %d/%d invalid txs\n", skipped, index)
+	}
+
 	return nil
 }
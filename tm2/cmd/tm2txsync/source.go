@@ -0,0 +1,134 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/gnolang/gno/tm2/pkg/amino"
+	abci "github.com/gnolang/gno/tm2/pkg/bft/abci/types"
+	"github.com/gnolang/gno/tm2/pkg/bft/rpc/client"
+	sm "github.com/gnolang/gno/tm2/pkg/bft/state"
+	"github.com/gnolang/gno/tm2/pkg/bft/store"
+	"github.com/gnolang/gno/tm2/pkg/bft/types"
+	dbm "github.com/gnolang/gno/tm2/pkg/db"
+	"github.com/gnolang/gno/tm2/pkg/errors"
+	osm "github.com/gnolang/gno/tm2/pkg/os"
+	"github.com/gnolang/gno/tm2/pkg/std"
+)
+
+// txSource reads the blocks of a chain.
+type txSource interface {
+	// LatestHeight returns the height of the last committed block.
+	LatestHeight() (int64, error)
+	// BlockTxs returns the txs of the block at height, or only the
+	// ones which were delivered successfully if the source is set to.
+	BlockTxs(height int64) ([]std.Tx, error)
+}
+
+// rpcSource fetches blocks from a node with RPC. It is safe for concurrent
+// use.
+type rpcSource struct {
+	node           client.Client
+	onlySuccessful bool
+}
+
+func (s rpcSource) LatestHeight() (int64, error) {
+	status, err := s.node.Status()
+	if err != nil {
+		return 0, err
+	}
+	return status.SyncInfo.LatestBlockHeight, nil
+}
+
+func (s rpcSource) BlockTxs(height int64) ([]std.Tx, error) {
+	block, err := s.node.Block(&height)
+	if err != nil {
+		return nil, err
+	}
+	txs := block.Block.Data.Txs
+	if len(txs) == 0 || !s.onlySuccessful {
+		return decodeTxs(txs, nil, false)
+	}
+	results, err := s.node.BlockResults(&height)
+	if err != nil {
+		return nil, err
+	}
+	return decodeTxs(txs, results.Results.DeliverTxs, true)
+}
+
+// dbSource reads blocks from the databases of a stopped node, which is
+// much faster than RPC. It is safe for concurrent use.
+type dbSource struct {
+	blockStoreDB   dbm.DB
+	stateDB        dbm.DB
+	blockStore     *store.BlockStore
+	onlySuccessful bool
+}
+
+// newDBSource opens the block store and state databases in the data
+// directory of a node. The node must be stopped, as the databases are
+// locked while it runs.
+func newDBSource(dataDir string, backend string, onlySuccessful bool) (src *dbSource, err error) {
+	for _, name := range []string{"blockstore", "state"} {
+		// dbm.NewDB creates missing databases.
+		if !osm.FileExists(filepath.Join(dataDir, name+".db")) {
+			return nil, errors.New("no %s database in %s", name, dataDir)
+		}
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("unable to open node databases, %v", r)
+		}
+	}()
+
+	src = &dbSource{onlySuccessful: onlySuccessful}
+	src.blockStoreDB = dbm.NewDB("blockstore", dbm.BackendType(backend), dataDir)
+	src.blockStore = store.NewBlockStore(src.blockStoreDB)
+	src.stateDB = dbm.NewDB("state", dbm.BackendType(backend), dataDir)
+	return src, nil
+}
+
+func (s *dbSource) Close() {
+	s.blockStoreDB.Close()
+	s.stateDB.Close()
+}
+
+func (s *dbSource) LatestHeight() (int64, error) {
+	return s.blockStore.Height(), nil
+}
+
+func (s *dbSource) BlockTxs(height int64) ([]std.Tx, error) {
+	block := s.blockStore.LoadBlock(height)
+	if block == nil {
+		return nil, errors.New("no block at height %d", height)
+	}
+	txs := block.Data.Txs
+	if len(txs) == 0 || !s.onlySuccessful {
+		return decodeTxs(txs, nil, false)
+	}
+	results, err := sm.LoadABCIResponses(s.stateDB, height)
+	if err != nil {
+		return nil, err
+	}
+	return decodeTxs(txs, results.DeliverTxs, true)
+}
+
+// decodeTxs decodes txs. If onlySuccessful is set, the txs whose
+// delivery failed according to results are left out.
+func decodeTxs(txs types.Txs, results []abci.ResponseDeliverTx, onlySuccessful bool) ([]std.Tx, error) {
+	if onlySuccessful && len(txs) != len(results) {
+		return nil, errors.New("%d txs but %d results", len(txs), len(results))
+	}
+	stdtxs := make([]std.Tx, 0, len(txs))
+	for i, tx := range txs {
+		if onlySuccessful && results[i].IsErr() {
+			continue
+		}
+		var stdtx std.Tx
+		if err := amino.Unmarshal(tx, &stdtx); err != nil {
+			return nil, errors.Wrap(err, "decoding tx %d", i)
+		}
+		stdtxs = append(stdtxs, stdtx)
+	}
+	return stdtxs, nil
+}
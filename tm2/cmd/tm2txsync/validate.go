@@ -0,0 +1,136 @@
+package main
+
+import (
+	"bufio"
+	"os"
+	"strings"
+
+	abci "github.com/gnolang/gno/tm2/pkg/bft/abci/types"
+	"github.com/gnolang/gno/tm2/pkg/crypto"
+	dbm "github.com/gnolang/gno/tm2/pkg/db"
+	"github.com/gnolang/gno/tm2/pkg/errors"
+	"github.com/gnolang/gno/tm2/pkg/log"
+	"github.com/gnolang/gno/tm2/pkg/sdk"
+	"github.com/gnolang/gno/tm2/pkg/sdk/auth"
+	"github.com/gnolang/gno/tm2/pkg/sdk/bank"
+	"github.com/gnolang/gno/tm2/pkg/sdk/params"
+	"github.com/gnolang/gno/tm2/pkg/sdk/vm"
+	"github.com/gnolang/gno/tm2/pkg/std"
+	"github.com/gnolang/gno/tm2/pkg/store"
+	"github.com/gnolang/gno/tm2/pkg/store/dbadapter"
+	"github.com/gnolang/gno/tm2/pkg/store/iavl"
+)
+
+// balance is the initial balance of an account of the validation app.
+type balance struct {
+	address crypto.Address
+	coins   std.Coins
+}
+
+// newValidationApp returns an in-memory app with the auth, bank and vm
+// modules, whose chain is initialized with balances. Txs delivered to it
+// run as genesis txs: their signatures, account numbers and sequences,
+// which are specific to the source chain, are not verified.
+func newValidationApp(stdlibsDir string, balances []balance) (*sdk.BaseApp, error) {
+	db := dbm.NewMemDB()
+
+	// Capabilities keys.
+	mainKey := store.NewStoreKey("main")
+	baseKey := store.NewStoreKey("base")
+
+	// Create BaseApp.
+	baseApp := sdk.NewBaseApp("tm2txsync", log.NewNopLogger(), db, baseKey, mainKey)
+
+	// Set mounts for BaseApp's MultiStore.
+	baseApp.MountStoreWithDB(mainKey, iavl.StoreConstructor, db)
+	baseApp.MountStoreWithDB(baseKey, dbadapter.StoreConstructor, db)
+
+	// Construct keepers.
+	acctKpr := auth.NewAccountKeeper(mainKey, std.ProtoBaseAccount)
+	bankKpr := bank.NewBankKeeper(acctKpr)
+	paramsKpr := params.NewParamsKeeper(mainKey)
+	paramsKpr.Register(auth.ModuleName, auth.DefaultParams())
+	paramsKpr.Register(vm.ModuleName, vm.DefaultParams())
+	vmKpr := vm.NewVMKeeper(baseKey, mainKey, acctKpr, bankKpr, paramsKpr, stdlibsDir)
+
+	baseApp.SetInitChainer(func(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
+		for _, bal := range balances {
+			acc := acctKpr.NewAccountWithAddress(ctx, bal.address)
+			acctKpr.SetAccount(ctx, acc)
+			if err := bankKpr.SetCoins(ctx, bal.address, bal.coins); err != nil {
+				panic(err)
+			}
+		}
+		return abci.ResponseInitChain{}
+	})
+
+	authAnteHandler := auth.NewAnteHandler(
+		acctKpr, bankKpr, auth.DefaultSigVerificationGasConsumer, auth.AnteOptions{})
+	baseApp.SetAnteHandler(
+		func(ctx sdk.Context, tx std.Tx, simulate bool) (
+			newCtx sdk.Context, res sdk.Result, abort bool,
+		) {
+			var authParams auth.Params
+			paramsKpr.GetParams(ctx, auth.ModuleName, &authParams)
+			ctx = ctx.WithValue(
+				auth.AuthParamsContextKey{}, authParams)
+			return authAnteHandler(ctx, tx, simulate)
+		},
+	)
+
+	baseApp.Router().AddRoute("auth", auth.NewHandler(acctKpr))
+	baseApp.Router().AddRoute("bank", bank.NewHandler(bankKpr))
+	baseApp.Router().AddRoute("vm", vm.NewHandler(vmKpr))
+	baseApp.Router().AddRoute("params", params.NewHandler(paramsKpr))
+
+	if err := baseApp.LoadLatestVersion(); err != nil {
+		return nil, err
+	}
+	vmKpr.Initialize(baseApp.GetCacheMultiStore())
+
+	// The deliver state stays at height 0 until the first block, so all
+	// txs are delivered in genesis mode, without gas bounds.
+	baseApp.InitChain(abci.RequestInitChain{
+		ChainID: "tm2txsync",
+		ConsensusParams: &abci.ConsensusParams{
+			Block: &abci.BlockParams{MaxGas: -1},
+		},
+	})
+
+	return baseApp, nil
+}
+
+// readBalances reads a balances file, with lines of the form
+// <address>=<coins> as in genesis balances files.
+func readBalances(path string) ([]balance, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var balances []balance
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		// remove comments.
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		addrStr, coinsStr, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, errors.New("invalid balance %q", line)
+		}
+		addr, err := crypto.AddressFromBech32(addrStr)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid balance address %q", addrStr)
+		}
+		coins, err := std.ParseCoins(coinsStr)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid balance coins %q", coinsStr)
+		}
+		balances = append(balances, balance{addr, coins})
+	}
+	return balances, scanner.Err()
+}
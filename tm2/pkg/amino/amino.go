@@ -23,6 +23,8 @@ import (
 type (
 	Package = pkg.Package
 	Type    = pkg.Type
+	Service = pkg.Service
+	Method  = pkg.Method
 )
 
 var (
@@ -42,7 +44,7 @@ const (
 )
 
 func init() {
-	gcdc = NewCodec().WithPBBindings().Autoseal()
+	gcdc = NewCodec().WithPBBindings().WithBinary2().Autoseal()
 	var err error
 	emptyTime, err = time.Parse(epochFmt, unixEpochStr)
 	if err != nil {
@@ -335,6 +337,15 @@ func (cdc *Codec) Marshal(o interface{}) ([]byte, error) {
 		}
 	}
 
+	if cdc.useBinary2 {
+		bm, ok := cdc.binaryMarshaler2(o)
+		if ok {
+			return cdc.marshalBinary2(bm)
+		} else {
+			// Fall back to using reflection when there is no generated code.
+		}
+	}
+
 	return cdc.MarshalReflect(o)
 }
 
@@ -601,6 +612,15 @@ func (cdc *Codec) Unmarshal(bz []byte, ptr interface{}) error {
 		}
 	}
 
+	if cdc.useBinary2 {
+		bu, ok := cdc.binaryUnmarshaler2(ptr)
+		if ok {
+			return cdc.unmarshalBinary2(bz, bu)
+		} else {
+			// Fall back to using reflection when there is no generated code.
+		}
+	}
+
 	return cdc.unmarshalReflect(bz, ptr)
 }
 
@@ -49,6 +49,21 @@ func BenchmarkBinaryPBBindings(b *testing.B) {
 	}
 }
 
+func BenchmarkBinary2(b *testing.B) {
+	cdc := amino.NewCodec().WithBinary2()
+	for _, ptr := range tests.StructTypes {
+		b.Logf("case %v (binary2)", reflect.TypeOf(ptr))
+		rt := getTypeFromPointer(ptr)
+		name := rt.Name()
+		b.Run(name+":encode:binary2", func(b *testing.B) {
+			_benchmarkBinary(b, cdc, rt, "binary", true)
+		})
+		b.Run(name+":decode:binary2", func(b *testing.B) {
+			_benchmarkBinary(b, cdc, rt, "binary", false)
+		})
+	}
+}
+
 func _benchmarkBinary(b *testing.B, cdc *amino.Codec, rt reflect.Type, codecType string, encode bool) {
 	b.Helper()
 
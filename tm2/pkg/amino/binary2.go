@@ -0,0 +1,185 @@
+package amino
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"reflect"
+	"runtime"
+)
+
+// ----------------------------------------
+// Methods generated by gengo for faster binary encoding.
+
+// BinaryMarshaler2 is implemented by structs with methods generated by
+// gengo.  MarshalBinary2 writes the fields of the struct to buf without
+// reflection, in the same bytes as the reflection encoder, and without
+// length prefix.
+type BinaryMarshaler2 interface {
+	MarshalBinary2(cdc *Codec, buf *bytes.Buffer) error
+}
+
+// BinaryUnmarshaler2 is implemented by pointers to structs with methods
+// generated by gengo.  UnmarshalBinary2 reads the fields of the struct from
+// all of bz, as written by MarshalBinary2.
+type BinaryUnmarshaler2 interface {
+	UnmarshalBinary2(cdc *Codec, bz []byte) error
+}
+
+var (
+	binaryMarshaler2Type   = reflect.TypeOf((*BinaryMarshaler2)(nil)).Elem()
+	binaryUnmarshaler2Type = reflect.TypeOf((*BinaryUnmarshaler2)(nil)).Elem()
+)
+
+// declaresBinary2 returns whether rt has generated methods of its own.  A
+// struct embedding a type with generated methods also implements the
+// interfaces, but the promoted methods would drop its other fields.
+// MarshalBinary2 has a pointer receiver for structs with locks, which
+// must not be copied.
+func declaresBinary2(rt reflect.Type) bool {
+	if rt.Kind() == reflect.Interface {
+		return false
+	}
+	prt := reflect.PtrTo(rt)
+	if !prt.Implements(binaryMarshaler2Type) || !prt.Implements(binaryUnmarshaler2Type) {
+		return false
+	}
+	mrt := rt
+	if !rt.Implements(binaryMarshaler2Type) {
+		mrt = prt
+	}
+	return !isPromotedMethod(mrt, "MarshalBinary2") &&
+		!isPromotedMethod(prt, "UnmarshalBinary2")
+}
+
+// binaryMarshaler2Value returns rv as a BinaryMarshaler2, or its address
+// if MarshalBinary2 has a pointer receiver.  It returns false if rv can't
+// be used, e.g. as it isn't addressable.
+func binaryMarshaler2Value(rv reflect.Value) (BinaryMarshaler2, bool) {
+	if !rv.CanInterface() {
+		return nil, false
+	}
+	if bm, ok := rv.Interface().(BinaryMarshaler2); ok {
+		return bm, true
+	}
+	if !rv.CanAddr() {
+		return nil, false
+	}
+	bm, ok := rv.Addr().Interface().(BinaryMarshaler2)
+	return bm, ok
+}
+
+// isPromotedMethod returns whether the method of rt is promoted from an
+// embedded field, for which the compiler generates a wrapper.
+func isPromotedMethod(rt reflect.Type, name string) bool {
+	m, ok := rt.MethodByName(name)
+	if !ok {
+		return false
+	}
+	pc := m.Func.Pointer()
+	file, _ := runtime.FuncForPC(pc).FileLine(pc)
+	return file == "<autogenerated>"
+}
+
+// binaryMarshaler2 returns o as a BinaryMarshaler2 if its type declares
+// the generated methods.
+func (cdc *Codec) binaryMarshaler2(o interface{}) (BinaryMarshaler2, bool) {
+	bm, ok := o.(BinaryMarshaler2)
+	if !ok || isNilPointer(o) {
+		return nil, false
+	}
+	rt := reflect.TypeOf(o)
+	if rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+	info, err := cdc.getTypeInfoWLock(rt)
+	if err != nil || !info.IsBinaryMarshaler2 {
+		return nil, false
+	}
+	return bm, true
+}
+
+// binaryUnmarshaler2 returns ptr as a BinaryUnmarshaler2 if the type it
+// points to declares the generated methods.
+func (cdc *Codec) binaryUnmarshaler2(ptr interface{}) (BinaryUnmarshaler2, bool) {
+	bu, ok := ptr.(BinaryUnmarshaler2)
+	if !ok {
+		return nil, false
+	}
+	info, err := cdc.getTypeInfoWLock(reflect.TypeOf(ptr).Elem())
+	if err != nil || !info.IsBinaryMarshaler2 {
+		return nil, false
+	}
+	return bu, true
+}
+
+// Use the generated code.
+func (cdc *Codec) marshalBinary2(bm BinaryMarshaler2) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	if err := bm.MarshalBinary2(cdc, buf); err != nil {
+		return nil, err
+	}
+	// If bz is empty, prefer nil.
+	if buf.Len() == 0 {
+		return nil, nil
+	}
+	return buf.Bytes(), nil
+}
+
+// Use the generated code.
+func (cdc *Codec) unmarshalBinary2(bz []byte, bu BinaryUnmarshaler2) error {
+	if err := bu.UnmarshalBinary2(cdc, bz); err != nil {
+		return fmt.Errorf("unmarshal to %v failed (%w): %X",
+			reflect.TypeOf(bu).Elem(), err, bz)
+	}
+	return nil
+}
+
+// ----------------------------------------
+// Helpers for the generated code.
+
+// EncodeFieldNumberAndTyp3 writes a field key.
+func EncodeFieldNumberAndTyp3(w io.Writer, num uint32, typ Typ3) error {
+	return encodeFieldNumberAndTyp3(w, num, typ)
+}
+
+// DecodeFieldNumberAndTyp3 reads a field key.
+func DecodeFieldNumberAndTyp3(bz []byte) (num uint32, typ Typ3, n int, err error) {
+	return decodeFieldNumberAndTyp3(bz)
+}
+
+// ConsumeFields reads the fields remaining in bz after lastFieldNum,
+// which are unknown, and returns the number of bytes read.
+func ConsumeFields(bz []byte, lastFieldNum uint32) (n int, err error) {
+	return consumeFields(bz, lastFieldNum)
+}
+
+// EncodeReflectBinaryField writes the field of the struct rv whose
+// FieldInfo is at index idx, with reflection.  The generated code uses it
+// for the fields it doesn't specialize.
+func (cdc *Codec) EncodeReflectBinaryField(buf *bytes.Buffer, rv reflect.Value, idx int) error {
+	info, err := cdc.getTypeInfoWLock(rv.Type())
+	if err != nil {
+		return err
+	}
+	return cdc.encodeReflectBinaryField(buf, info.Fields[idx], rv, FieldOptions{})
+}
+
+// DecodeReflectBinaryField reads the field of the struct rv whose
+// FieldInfo is at index idx, with reflection, and returns the number of
+// bytes read.  rv must be addressable.  The generated code uses it for the
+// fields it doesn't specialize.
+func (cdc *Codec) DecodeReflectBinaryField(bz []byte, rv reflect.Value, idx int, lastFieldNum *uint32) (n int, err error) {
+	info, err := cdc.getTypeInfoWLock(rv.Type())
+	if err != nil {
+		return 0, err
+	}
+	return cdc.decodeReflectBinaryField(bz, info, info.Fields[idx], rv, lastFieldNum)
+}
+
+// isNilPointer returns whether o is a nil pointer, which can't be
+// marshaled directly.
+func isNilPointer(o interface{}) bool {
+	rv := reflect.ValueOf(o)
+	return rv.Kind() == reflect.Ptr && rv.IsNil()
+}
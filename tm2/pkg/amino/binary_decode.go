@@ -915,85 +915,112 @@ func (cdc *Codec) decodeReflectBinaryStruct(bz []byte, info *TypeInfo, rv reflec
 		return
 	}
 
+	// Use the generated code if any.
+	if cdc.useBinary2 && info.IsBinaryMarshaler2 && rv.Addr().CanInterface() {
+		err = rv.Addr().Interface().(BinaryUnmarshaler2).UnmarshalBinary2(cdc, bz)
+		if err != nil {
+			return
+		}
+		return n + len(bz), nil
+	}
+
 	// Track the last seen field number.
 	var lastFieldNum uint32
 	// Read each field.
 	for _, field := range info.Fields {
-		// Get field rv and info.
-		frv := rv.Field(field.Index)
-		finfo := field.TypeInfo
-
-		// We're done if we've consumed all of bz.
-		if len(bz) == 0 {
-			frv.Set(defaultValue(frv.Type()))
-			continue
+		_n, err = cdc.decodeReflectBinaryField(bz, info, field, rv, &lastFieldNum)
+		if slide(&bz, &n, _n) && err != nil {
+			return
 		}
+	}
 
-		if field.UnpackedList {
-			// Skip unpacked list field if fnum is bigger.
-			var fnum uint32
-			fnum, _, _, err = decodeFieldNumberAndTyp3(bz)
-			if err != nil {
-				return
-			}
-			if field.BinFieldNum < fnum {
-				continue
-			}
-			// This is a list that was encoded unpacked, e.g.
-			// with repeated field entries for each list item.
-			_n, err = cdc.decodeReflectBinary(bz, finfo, frv, field.FieldOptions, true, 0)
-			if slide(&bz, &n, _n) && err != nil {
-				return
-			}
-		} else {
-			// Read field key (number and type).
-			var (
-				fnum uint32
-				typ  Typ3
-			)
-			fnum, typ, _n, err = decodeFieldNumberAndTyp3(bz)
-			if field.BinFieldNum < fnum {
-				// Set zero field value.
-				frv.Set(defaultValue(frv.Type()))
-				continue // before sliding...
-			}
-			if slide(&bz, &n, _n) && err != nil {
-				return
-			}
+	// Consume any remaining fields.
+	_n, err = consumeFields(bz, lastFieldNum)
+	slide(&bz, &n, _n)
+	return n, err
+}
 
-			// Validate fnum and typ.
-			if fnum <= lastFieldNum {
-				err = fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X",
-					fnum, lastFieldNum, bz)
-				return
-			}
-			lastFieldNum = fnum
-			// NOTE: In the future, we'll support upgradeability.
-			// So in the future, this may not match,
-			// so we will need to remove this sanity check.
-			if field.BinFieldNum != fnum {
-				err = errors.New(fmt.Sprintf("expected field # %v of %v, got %v",
-					field.BinFieldNum, info.Type, fnum))
-				return
-			}
-			typWanted := finfo.GetTyp3(field.FieldOptions)
-			if typ != typWanted {
-				err = errors.New(fmt.Sprintf("expected field type %v for # %v of %v, got %v",
-					typWanted, fnum, info.Type, typ))
-				return
-			}
-			// Decode field into frv.
-			_n, err = cdc.decodeReflectBinary(bz, finfo, frv, field.FieldOptions, false, 0)
-			if slide(&bz, &n, _n) && err != nil {
-				return
-			}
+// Read the field of the struct rv, or set it to its default value if
+// absent.
+func (cdc *Codec) decodeReflectBinaryField(bz []byte, info *TypeInfo, field FieldInfo, rv reflect.Value,
+	lastFieldNum *uint32,
+) (n int, err error) {
+	var _n int
+	// Get field rv and info.
+	frv := rv.Field(field.Index)
+	finfo := field.TypeInfo
+
+	// We're done if we've consumed all of bz.
+	if len(bz) == 0 {
+		frv.Set(defaultValue(frv.Type()))
+		return
+	}
+
+	if field.UnpackedList {
+		// Skip unpacked list field if fnum is bigger.
+		var fnum uint32
+		fnum, _, _, err = decodeFieldNumberAndTyp3(bz)
+		if err != nil {
+			return
 		}
+		if field.BinFieldNum < fnum {
+			return
+		}
+		// This is a list that was encoded unpacked, e.g.
+		// with repeated field entries for each list item.
+		_n, err = cdc.decodeReflectBinary(bz, finfo, frv, field.FieldOptions, true, 0)
+		slide(&bz, &n, _n)
+		return
 	}
 
-	// Consume any remaining fields.
+	// Read field key (number and type).
+	var (
+		fnum uint32
+		typ  Typ3
+	)
+	fnum, typ, _n, err = decodeFieldNumberAndTyp3(bz)
+	if field.BinFieldNum < fnum {
+		// Set zero field value.
+		frv.Set(defaultValue(frv.Type()))
+		return 0, nil // before sliding...
+	}
+	if slide(&bz, &n, _n) && err != nil {
+		return
+	}
+
+	// Validate fnum and typ.
+	if fnum <= *lastFieldNum {
+		err = fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X",
+			fnum, *lastFieldNum, bz)
+		return
+	}
+	*lastFieldNum = fnum
+	// NOTE: In the future, we'll support upgradeability.
+	// So in the future, this may not match,
+	// so we will need to remove this sanity check.
+	if field.BinFieldNum != fnum {
+		err = errors.New(fmt.Sprintf("expected field # %v of %v, got %v",
+			field.BinFieldNum, info.Type, fnum))
+		return
+	}
+	typWanted := finfo.GetTyp3(field.FieldOptions)
+	if typ != typWanted {
+		err = errors.New(fmt.Sprintf("expected field type %v for # %v of %v, got %v",
+			typWanted, fnum, info.Type, typ))
+		return
+	}
+	// Decode field into frv.
+	_n, err = cdc.decodeReflectBinary(bz, finfo, frv, field.FieldOptions, false, 0)
+	slide(&bz, &n, _n)
+	return
+}
+
+// Consume the fields after lastFieldNum, which are unknown.
+func consumeFields(bz []byte, lastFieldNum uint32) (n int, err error) {
 	var (
 		fnum uint32
 		typ3 Typ3
+		_n   int
 	)
 	for len(bz) > 0 {
 		fnum, typ3, _n, err = decodeFieldNumberAndTyp3(bz)
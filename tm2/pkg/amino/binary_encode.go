@@ -499,37 +499,51 @@ func (cdc *Codec) encodeReflectBinaryStruct(w io.Writer, info *TypeInfo, rv refl
 	// Here we incur it for root structs as well for ease of dev.
 	buf := bytes.NewBuffer(nil)
 
-	for _, field := range info.Fields {
-		// Get type info for field.
-		finfo := field.TypeInfo
-		// Get dereferenced field value and info.
-		frv := rv.Field(field.Index)
-		dfrv, frvIsPtr, _ := maybeDerefValue(frv)
-		if !field.WriteEmpty && isNonstructDefaultValue(frv) {
-			// Do not encode default value fields
-			// (except when `amino:"write_empty"` is set).
-			continue
-		}
-		// Below, if frv is pointer, it isn't a nil pointer.
-		if field.UnpackedList {
-			// Write repeated field entries for each list item.
-			err = cdc.encodeReflectBinaryList(buf, finfo, dfrv, field.FieldOptions, true)
-			if err != nil {
-				return
-			}
-		} else {
-			// write empty if explicitly set or if this is a non-nil pointer:
-			writeEmpty := field.WriteEmpty || frvIsPtr // (non-nil)
-			err = cdc.writeFieldIfNotEmpty(buf, field.BinFieldNum, finfo, fopts, field.FieldOptions, dfrv, writeEmpty)
+	// Use the generated code if any.
+	if cdc.useBinary2 && info.IsBinaryMarshaler2 {
+		if bm, ok := binaryMarshaler2Value(rv); ok {
+			err = bm.MarshalBinary2(cdc, buf)
 			if err != nil {
 				return
 			}
+			return writeMaybeBare(w, buf.Bytes(), bare)
+		}
+	}
+
+	for _, field := range info.Fields {
+		err = cdc.encodeReflectBinaryField(buf, field, rv, fopts)
+		if err != nil {
+			return
 		}
 	}
 
 	return writeMaybeBare(w, buf.Bytes(), bare)
 }
 
+// Write the field of the struct rv, if not empty.
+func (cdc *Codec) encodeReflectBinaryField(buf *bytes.Buffer, field FieldInfo, rv reflect.Value,
+	fopts FieldOptions,
+) (err error) {
+	// Get type info for field.
+	finfo := field.TypeInfo
+	// Get dereferenced field value and info.
+	frv := rv.Field(field.Index)
+	dfrv, frvIsPtr, _ := maybeDerefValue(frv)
+	if !field.WriteEmpty && isNonstructDefaultValue(frv) {
+		// Do not encode default value fields
+		// (except when `amino:"write_empty"` is set).
+		return
+	}
+	// Below, if frv is pointer, it isn't a nil pointer.
+	if field.UnpackedList {
+		// Write repeated field entries for each list item.
+		return cdc.encodeReflectBinaryList(buf, finfo, dfrv, field.FieldOptions, true)
+	}
+	// write empty if explicitly set or if this is a non-nil pointer:
+	writeEmpty := field.WriteEmpty || frvIsPtr // (non-nil)
+	return cdc.writeFieldIfNotEmpty(buf, field.BinFieldNum, finfo, fopts, field.FieldOptions, dfrv, writeEmpty)
+}
+
 // ----------------------------------------
 // Misc.
 
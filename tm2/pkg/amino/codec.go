@@ -40,6 +40,7 @@ type ConcreteInfo struct {
 	IsBinaryWellKnownType bool      // If true, use built-in functions to encode/decode.
 	IsJSONWellKnownType   bool      // If true, use built-in functions to encode/decode.
 	IsJSONAnyValueType    bool      // If true, the interface/Any representation uses the "value" field.
+	IsBinaryMarshaler2    bool      // Declares MarshalBinary2() and UnmarshalBinary2(), generated by gengo.
 	Elem                  *TypeInfo // Set if Type.Kind() is Slice or Array.
 	ElemIsPtr             bool      // Set true iff Type.Elem().Kind() is Pointer.
 }
@@ -188,6 +189,7 @@ type Codec struct {
 	fullnameToTypeInfo map[string]*TypeInfo
 	packages           pkg.PackageSet
 	usePBBindings      bool
+	useBinary2         bool
 }
 
 func NewCodec() *Codec {
@@ -214,6 +216,23 @@ func (cdc *Codec) WithPBBindings() *Codec {
 		fullnameToTypeInfo: cdc.fullnameToTypeInfo,
 		packages:           cdc.packages,
 		usePBBindings:      true,
+		useBinary2:         cdc.useBinary2,
+	}
+}
+
+// Returns a new codec that is optimized w/ the binary encoding methods
+// generated by gengo, for the types which have them.
+// The returned codec is sealed, but may be affected by
+// modifications to the underlying codec.
+func (cdc *Codec) WithBinary2() *Codec {
+	return &Codec{
+		sealed:             cdc.sealed,
+		autoseal:           cdc.autoseal,
+		typeInfos:          cdc.typeInfos,
+		fullnameToTypeInfo: cdc.fullnameToTypeInfo,
+		packages:           cdc.packages,
+		usePBBindings:      cdc.usePBBindings,
+		useBinary2:         true,
 	}
 }
 
@@ -641,6 +660,7 @@ func (cdc *Codec) newTypeInfoUnregisteredWLocked(rt reflect.Type) *TypeInfo {
 	info.ConcreteInfo.IsBinaryWellKnownType = isBinaryWellKnownType(rt)
 	info.ConcreteInfo.IsJSONWellKnownType = isJSONWellKnownType(rt)
 	info.ConcreteInfo.IsJSONAnyValueType = isJSONAnyValueType(rt)
+	info.ConcreteInfo.IsBinaryMarshaler2 = declaresBinary2(rt)
 	if rt.Kind() == reflect.Array || rt.Kind() == reflect.Slice {
 		einfo, err := cdc.getTypeInfoWLocked(rt.Elem())
 		if err != nil {
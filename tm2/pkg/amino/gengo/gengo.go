@@ -2,73 +2,804 @@ package gengo
 
 import (
 	"fmt"
+	"go/format"
+	"os"
+	"path"
 	"reflect"
+	"sort"
+	"strings"
+	"sync"
 
 	"github.com/gnolang/gno/tm2/pkg/amino"
 	"github.com/gnolang/gno/tm2/pkg/amino/libs/press"
 )
 
-// shortcut
-var _fmt = fmt.Sprintf
+// The generated code is written to this file in the package directory.
+const binary2FileName = "binary2.go"
 
-func PrintIntEncoder(p *press.Press, ref string) {
-	p.Pl("{").I(func(p *press.Press) {
-		p.Pl("var buf[10]byte")
-		p.Pl("n := binary.PutVarint(buf[:], %v)", ref)
-		p.Pl("_, err = w.Write(buf[0:n])")
+// Writes in the same directory as the origin package.  Methods are also
+// generated for extra, types of the package which aren't registered.
+func WriteBinary2(pkg *amino.Package, extra ...reflect.Type) {
+	filename := path.Join(pkg.DirName, binary2FileName)
+	err := WriteBinary2ForTypes(filename, pkg, append(pkg.ReflectTypes(), extra...)...)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Convenience.
+func WriteBinary2ForTypes(filename string, pkg *amino.Package, rtz ...reflect.Type) error {
+	bz, err := GenerateBinary2ForTypes(pkg, rtz...)
+	if err != nil {
+		return err
+	}
+	if bz == nil {
+		fmt.Printf("no binary2 methods to write for package %v\n", pkg)
+		return nil
+	}
+	fmt.Printf("writing binary2 methods to %v for package %v\n", filename, pkg)
+	return os.WriteFile(filename, bz, 0o644)
+}
+
+// GenerateBinary2ForTypes returns the formatted source of a Go file of
+// package pkg, with MarshalBinary2 and UnmarshalBinary2 methods for each
+// struct type of rtz declared in pkg.  The methods encode and decode the
+// same bytes as the amino reflection codec; fields which aren't
+// specialized here are encoded and decoded with reflection.  These are
+// interfaces, as their Any encoding depends on the registered types, well
+// known types such as time.Time, types with MarshalAmino to other than a
+// string, and types of other packages whose name conflicts with an import.  If there are no
+// such types, the returned source is nil.
+func GenerateBinary2ForTypes(pkg *amino.Package, rtz ...reflect.Type) ([]byte, error) {
+	cdc := amino.NewCodec()
+	cdc.RegisterPackage(pkg)
+	g := &generator{
+		cdc:      cdc,
+		pkg:      pkg,
+		types:    make(map[reflect.Type]bool),
+		imports:  make(map[string]bool),
+		pkgNames: make(map[string]string),
+		pkgPaths: make(map[string]bool),
+	}
+
+	// Collect the types to generate methods for first,
+	// as fields of these types are specialized.
+	var infos []*amino.TypeInfo
+	for _, rt := range rtz {
+		if rt.Kind() == reflect.Ptr {
+			rt = rt.Elem()
+		}
+		if rt.Kind() != reflect.Struct {
+			continue
+		}
+		info, err := cdc.GetTypeInfo(rt)
+		if err != nil {
+			return nil, err
+		}
+		if !g.isGenerable(info) || g.types[rt] {
+			continue
+		}
+		g.types[rt] = true
+		infos = append(infos, info)
+	}
+
+	if len(infos) == 0 {
+		return nil, nil
+	}
+
+	body := press.NewPress()
+	for _, info := range infos {
+		g.printMarshalBinary2(body, info)
+		body.Ln()
+		g.printUnmarshalBinary2(body, info)
+		body.Ln()
+	}
+
+	p := press.NewPress()
+	p.Pl("// Code generated by gengo. DO NOT EDIT.")
+	p.Ln()
+	p.Pl("package %v", pkg.GoPkgName)
+	p.Ln()
+	p.Pl("import (").I(func(p *press.Press) {
+		p.Pl("\"bytes\"")
+		for _, imp := range []string{"errors", "fmt", "reflect"} {
+			if g.imports[imp] {
+				p.Pl("%q", imp)
+			}
+		}
+		p.Ln()
+		p.Pl("%q", "github.com/gnolang/gno/tm2/pkg/amino")
+		pkgPaths := make([]string, 0, len(g.pkgPaths))
+		for pkgPath := range g.pkgPaths {
+			pkgPaths = append(pkgPaths, pkgPath)
+		}
+		sort.Strings(pkgPaths)
+		for _, pkgPath := range pkgPaths {
+			p.Pl("%q", pkgPath)
+		}
+	}).Pl(")")
+	p.Ln()
+	p.P("%v", body.Print())
+
+	bz, err := format.Source([]byte(p.Print()))
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %w", err)
+	}
+	return bz, nil
+}
+
+type generator struct {
+	cdc      *amino.Codec
+	pkg      *amino.Package
+	types    map[reflect.Type]bool // structs with generated methods.
+	imports  map[string]bool       // standard imports used.
+	pkgNames map[string]string     // names of other packages to their path.
+	pkgPaths map[string]bool       // other packages imported.
+}
+
+// Names which other packages can't be imported as, as the generated code
+// uses them.
+var reservedNames = map[string]bool{
+	"amino": true, "bytes": true, "errors": true, "fmt": true, "reflect": true,
+	"goo": true, "cdc": true, "buf": true, "buf2": true, "bz": true, "rv": true,
+	"err": true, "v": true, "n": true, "e": true, "list": true, "fnum": true,
+	"typ": true, "lastFieldNum": true,
+}
+
+// Only plain structs declared in the package get methods.  Unexported
+// fields, such as locks, aren't encoded.
+func (g *generator) isGenerable(info *amino.TypeInfo) bool {
+	rt := info.Type
+	return rt.Name() != "" &&
+		rt.PkgPath() == g.pkg.GoPkgPath &&
+		!info.IsAminoMarshaler &&
+		!info.IsBinaryWellKnownType
+}
+
+var (
+	lockerType = reflect.TypeOf((*sync.Locker)(nil)).Elem()
+	stringType = reflect.TypeOf("")
+)
+
+// Whether values of rt contain a lock, which must not be copied, so that
+// MarshalBinary2 has a pointer receiver.
+func hasLock(rt reflect.Type) bool {
+	if reflect.PtrTo(rt).Implements(lockerType) {
+		return true
+	}
+	switch rt.Kind() {
+	case reflect.Array:
+		return hasLock(rt.Elem())
+	case reflect.Struct:
+		for i := 0; i < rt.NumField(); i++ {
+			if hasLock(rt.Field(i).Type) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Returns the Go expression of rt in the generated package, or false if
+// rt can't be expressed, as the name of its package is already taken.
+// Types of other packages are only imported with useType.
+func (g *generator) typeExpr(rt reflect.Type) (string, bool) {
+	if rt.Name() != "" {
+		switch rt.PkgPath() {
+		case "":
+			return rt.Name(), true
+		case g.pkg.GoPkgPath:
+			return rt.Name(), true
+		default:
+			expr := rt.String()
+			name, _, _ := strings.Cut(expr, ".")
+			if strings.Contains(expr, "[") || name == g.pkg.GoPkgName || reservedNames[name] {
+				return "", false
+			}
+			if pkgPath, ok := g.pkgNames[name]; ok && pkgPath != rt.PkgPath() {
+				return "", false
+			}
+			g.pkgNames[name] = rt.PkgPath()
+			return expr, true
+		}
+	}
+	switch rt.Kind() {
+	case reflect.Ptr:
+		expr, ok := g.typeExpr(rt.Elem())
+		return "*" + expr, ok
+	case reflect.Slice:
+		expr, ok := g.typeExpr(rt.Elem())
+		return "[]" + expr, ok
+	case reflect.Array:
+		expr, ok := g.typeExpr(rt.Elem())
+		return fmt.Sprintf("[%d]%s", rt.Len(), expr), ok
+	default:
+		return "", false
+	}
+}
+
+// Imports the package of rt in the generated code, if it is another package.
+func (g *generator) useType(rt reflect.Type) {
+	for rt.Name() == "" {
+		switch rt.Kind() {
+		case reflect.Ptr, reflect.Slice, reflect.Array:
+			rt = rt.Elem()
+		default:
+			return
+		}
+	}
+	if rt.PkgPath() != "" && rt.PkgPath() != g.pkg.GoPkgPath {
+		g.pkgPaths[rt.PkgPath()] = true
+	}
+}
+
+// Whether rt is a struct with generated methods, in this package or
+// another.
+func (g *generator) hasMethods(rt reflect.Type) bool {
+	if g.types[rt] {
+		return true
+	}
+	if rt.PkgPath() == g.pkg.GoPkgPath {
+		return false
+	}
+	info, err := g.cdc.GetTypeInfo(rt)
+	return err == nil && info.IsBinaryMarshaler2
+}
+
+// ----------------------------------------
+// Field kinds
+
+type fieldKind int
+
+const (
+	fieldReflect     fieldKind = iota // encoded and decoded with reflection.
+	fieldPrimitive                    // bool, integers and strings.
+	fieldBytes                        // byte slices.
+	fieldByteArray                    // byte arrays.
+	fieldStruct                       // struct with generated methods.
+	fieldStructPtr                    // pointer to struct with generated methods.
+	fieldList                         // unpacked list of the kinds above but primitives.
+	fieldAminoString                  // slice, array or struct with MarshalAmino to a string.
+)
+
+// The code to encode and decode a primitive value.
+type primitive struct {
+	encode  string // encoder call, formatted with the value.
+	decode  string // decoder function.
+	nonzero string // condition formatted with the value.
+	zero    string // zero value.
+}
+
+func (g *generator) fieldKind(field amino.FieldInfo) fieldKind {
+	if _, ok := g.typeExpr(field.Type); !ok {
+		return fieldReflect
+	}
+	if field.TypeInfo.IsBinaryWellKnownType {
+		return fieldReflect
+	}
+	rt := field.Type
+	if field.TypeInfo.IsAminoMarshaler {
+		switch rt.Kind() {
+		case reflect.Slice, reflect.Array, reflect.Struct:
+			if field.TypeInfo.ReprType.Type == stringType {
+				return fieldAminoString
+			}
+		}
+		return fieldReflect
+	}
+	switch rt.Kind() {
+	case reflect.Ptr:
+		if rt.Name() == "" && rt.Elem().Kind() == reflect.Struct && g.hasMethods(rt.Elem()) && !field.WriteEmpty {
+			return fieldStructPtr
+		}
+	case reflect.Struct:
+		if g.hasMethods(rt) {
+			return fieldStruct
+		}
+	case reflect.Array:
+		if rt.Elem().Kind() == reflect.Uint8 && rt.Len() > 0 && isPlain(field.TypeInfo.Elem) {
+			return fieldByteArray
+		}
+	case reflect.Slice:
+		if rt.Elem().Kind() == reflect.Uint8 {
+			if isPlain(field.TypeInfo.Elem) {
+				return fieldBytes
+			}
+		} else if field.UnpackedList && g.elemKind(field) != fieldReflect {
+			return fieldList
+		}
+	default:
+		if _, ok := g.primitive(rt.Kind(), field.FieldOptions); ok {
+			return fieldPrimitive
+		}
+	}
+	return fieldReflect
+}
+
+// Returns the kind of the elements of an unpacked list field.
+func (g *generator) elemKind(field amino.FieldInfo) fieldKind {
+	ert := field.Type.Elem()
+	einfo := field.TypeInfo.Elem
+	if !isPlain(einfo) {
+		return fieldReflect
+	}
+	if _, ok := g.typeExpr(ert); !ok {
+		return fieldReflect
+	}
+	switch ert.Kind() {
+	case reflect.String:
+		return fieldPrimitive
+	case reflect.Slice:
+		if ert.Elem().Kind() == reflect.Uint8 && isPlain(einfo.Elem) {
+			return fieldBytes
+		}
+	case reflect.Struct:
+		// Elements are copied, which locks must not be.
+		if g.hasMethods(ert) && !hasLock(ert) {
+			return fieldStruct
+		}
+	case reflect.Ptr:
+		if ert.Name() == "" && ert.Elem().Kind() == reflect.Struct && g.hasMethods(ert.Elem()) {
+			return fieldStructPtr
+		}
+	}
+	return fieldReflect
+}
+
+// Whether the type is encoded as its own kind.
+func isPlain(info *amino.TypeInfo) bool {
+	return info != nil && !info.IsAminoMarshaler && !info.IsBinaryWellKnownType
+}
+
+// NOTE: Keep in sync with encodeReflectBinary and decodeReflectBinary.
+func (g *generator) primitive(kind reflect.Kind, fopts amino.FieldOptions) (primitive, bool) {
+	var (
+		integer  = primitive{nonzero: "%v != 0", zero: "0"}
+		fixed64  = fopts.BinFixed64 && !fopts.BinFixed32
+		fixed32  = fopts.BinFixed32 && !fopts.BinFixed64
+		nonfixed = !fopts.BinFixed64 && !fopts.BinFixed32
+	)
+	switch {
+	case kind == reflect.Int64 && fixed64:
+		integer.encode, integer.decode = "EncodeInt64(buf, int64(%v))", "DecodeInt64"
+	case kind == reflect.Int32 && fixed32:
+		integer.encode, integer.decode = "EncodeInt32(buf, int32(%v))", "DecodeInt32"
+	case kind == reflect.Uint64 && fixed64:
+		integer.encode, integer.decode = "EncodeUint64(buf, uint64(%v))", "DecodeUint64"
+	case kind == reflect.Uint32 && fixed32:
+		integer.encode, integer.decode = "EncodeUint32(buf, uint32(%v))", "DecodeUint32"
+	case !nonfixed:
+		return primitive{}, false
+	case kind == reflect.Int64, kind == reflect.Int32, kind == reflect.Int:
+		integer.encode, integer.decode = "EncodeVarint(buf, int64(%v))", "DecodeVarint"
+	case kind == reflect.Int16:
+		integer.encode, integer.decode = "EncodeVarint(buf, int64(%v))", "DecodeVarint16"
+	case kind == reflect.Int8:
+		integer.encode, integer.decode = "EncodeVarint(buf, int64(%v))", "DecodeVarint8"
+	case kind == reflect.Uint64, kind == reflect.Uint32, kind == reflect.Uint:
+		integer.encode, integer.decode = "EncodeUvarint(buf, uint64(%v))", "DecodeUvarint"
+	case kind == reflect.Uint16:
+		integer.encode, integer.decode = "EncodeUvarint(buf, uint64(%v))", "DecodeUvarint16"
+	case kind == reflect.Uint8:
+		integer.encode, integer.decode = "EncodeUvarint(buf, uint64(%v))", "DecodeUvarint8"
+	case kind == reflect.Bool:
+		return primitive{"EncodeBool(buf, bool(%v))", "DecodeBool", "%v", "false"}, true
+	case kind == reflect.String:
+		return primitive{"EncodeString(buf, string(%v))", "DecodeString", "%v != \"\"", "\"\""}, true
+	default:
+		return primitive{}, false
+	}
+	return integer, true
+}
+
+func typ3Expr(typ amino.Typ3) string {
+	switch typ {
+	case amino.Typ3Varint:
+		return "amino.Typ3Varint"
+	case amino.Typ38Byte:
+		return "amino.Typ38Byte"
+	case amino.Typ3ByteLength:
+		return "amino.Typ3ByteLength"
+	case amino.Typ34Byte:
+		return "amino.Typ34Byte"
+	default:
+		panic(fmt.Sprintf("unexpected typ3 %v", typ))
+	}
+}
+
+// ----------------------------------------
+// Encoding
+
+func (g *generator) printMarshalBinary2(p *press.Press, info *amino.TypeInfo) {
+	name := info.Type.Name()
+	kinds := make([]fieldKind, len(info.Fields))
+	usesReflect := false
+	for i, field := range info.Fields {
+		kinds[i] = g.fieldKind(field)
+		usesReflect = usesReflect || kinds[i] == fieldReflect
+	}
+	recv, rv := name, "reflect.ValueOf(goo)"
+	if hasLock(info.Type) {
+		recv, rv = "*"+name, "reflect.ValueOf(goo).Elem()"
+	}
+	p.Pl("func (goo %v) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {", recv).I(func(p *press.Press) {
+		if usesReflect {
+			g.imports["reflect"] = true
+			p.Pl("rv := %v", rv)
+		}
+		for i, field := range info.Fields {
+			p.Pl("// Field %v (#%v)", field.Name, field.BinFieldNum)
+			g.printEncodeField(p, i, field, kinds[i])
+		}
+		p.Pl("return nil")
+	}).Pl("}")
+}
+
+func (g *generator) printEncodeField(p *press.Press, idx int, field amino.FieldInfo, kind fieldKind) {
+	ref := "goo." + field.Name
+	num := field.BinFieldNum
+	printKey := func(p *press.Press, typ string) {
+		p.Pl("if err = amino.EncodeFieldNumberAndTyp3(buf, %v, %v); err != nil {", num, typ).I(func(p *press.Press) {
+			p.Pl("return")
+		}).Pl("}")
+	}
+	printCall := func(p *press.Press, call string, args ...interface{}) {
+		p.Pl("if err = %v; err != nil {", fmt.Sprintf(call, args...)).I(func(p *press.Press) {
+			p.Pl("return")
+		}).Pl("}")
+	}
+	typ := typ3Expr(field.TypeInfo.GetTyp3(field.FieldOptions))
+
+	switch kind {
+	case fieldReflect:
+		printCall(p, "cdc.EncodeReflectBinaryField(buf, rv, %v)", idx)
+
+	case fieldPrimitive:
+		prim, _ := g.primitive(field.Type.Kind(), field.FieldOptions)
+		printEncode := func(p *press.Press) {
+			printKey(p, typ)
+			printCall(p, "amino."+prim.encode, ref)
+		}
+		if field.WriteEmpty {
+			printEncode(p)
+		} else {
+			p.Pl("if %v {", fmt.Sprintf(prim.nonzero, ref)).I(printEncode).Pl("}")
+		}
+
+	case fieldBytes:
+		printEncode := func(p *press.Press) {
+			printKey(p, typ)
+			printCall(p, "amino.EncodeByteSlice(buf, %v)", ref)
+		}
+		if field.WriteEmpty {
+			printEncode(p)
+		} else {
+			p.Pl("if len(%v) != 0 {", ref).I(printEncode).Pl("}")
+		}
+
+	case fieldByteArray:
+		printKey(p, typ)
+		printCall(p, "amino.EncodeByteSlice(buf, %v[:])", ref)
+
+	case fieldStruct:
+		// Empty structs are skipped unless write_empty.
+		p.Pl("{").I(func(p *press.Press) {
+			p.Pl("buf2 := new(bytes.Buffer)")
+			printCall(p, "%v.MarshalBinary2(cdc, buf2)", ref)
+			printEncode := func(p *press.Press) {
+				printKey(p, typ)
+				printCall(p, "amino.EncodeByteSlice(buf, buf2.Bytes())")
+			}
+			if field.WriteEmpty {
+				printEncode(p)
+			} else {
+				p.Pl("if buf2.Len() != 0 {").I(printEncode).Pl("}")
+			}
+		}).Pl("}")
+
+	case fieldStructPtr:
+		// Non-nil pointers are always written.
+		p.Pl("if %v != nil {", ref).I(func(p *press.Press) {
+			p.Pl("buf2 := new(bytes.Buffer)")
+			printCall(p, "%v.MarshalBinary2(cdc, buf2)", ref)
+			printKey(p, typ)
+			printCall(p, "amino.EncodeByteSlice(buf, buf2.Bytes())")
+		}).Pl("}")
+
+	case fieldAminoString:
+		// Empty slices and empty representations are skipped unless
+		// write_empty.
+		printEncode := func(p *press.Press) {
+			p.Pl("var repr string")
+			p.Pl("if repr, err = %v.MarshalAmino(); err != nil {", ref).I(func(p *press.Press) {
+				p.Pl("return")
+			}).Pl("}")
+			printString := func(p *press.Press) {
+				printKey(p, typ)
+				printCall(p, "amino.EncodeString(buf, repr)")
+			}
+			if field.WriteEmpty {
+				printString(p)
+			} else {
+				p.Pl("if repr != \"\" {").I(printString).Pl("}")
+			}
+		}
+		if field.Type.Kind() == reflect.Slice && !field.WriteEmpty {
+			p.Pl("if len(%v) != 0 {", ref).I(printEncode).Pl("}")
+		} else {
+			p.Pl("{").I(printEncode).Pl("}")
+		}
+
+	case fieldList:
+		p.Pl("for _, e := range %v {", ref).I(func(p *press.Press) {
+			printKey(p, "amino.Typ3ByteLength")
+			switch g.elemKind(field) {
+			case fieldPrimitive:
+				printCall(p, "amino.EncodeString(buf, string(e))")
+			case fieldBytes:
+				printCall(p, "amino.EncodeByteSlice(buf, e)")
+			case fieldStruct:
+				p.Pl("buf2 := new(bytes.Buffer)")
+				printCall(p, "e.MarshalBinary2(cdc, buf2)")
+				printCall(p, "amino.EncodeByteSlice(buf, buf2.Bytes())")
+			case fieldStructPtr:
+				p.Pl("if e == nil {").I(func(p *press.Press) {
+					if field.NilElements {
+						printCall(p, "amino.EncodeByte(buf, 0x00)")
+						p.Pl("continue")
+					} else {
+						g.imports["errors"] = true
+						p.Pl("return errors.New(%q)",
+							"nil struct pointers in lists not supported unless nil_elements field tag is also set")
+					}
+				}).Pl("}")
+				p.Pl("buf2 := new(bytes.Buffer)")
+				printCall(p, "e.MarshalBinary2(cdc, buf2)")
+				printCall(p, "amino.EncodeByteSlice(buf, buf2.Bytes())")
+			default:
+				panic("should not happen")
+			}
+		}).Pl("}")
+
+	default:
+		panic("should not happen")
+	}
+}
+
+// ----------------------------------------
+// Decoding
+
+func (g *generator) printUnmarshalBinary2(p *press.Press, info *amino.TypeInfo) {
+	name := info.Type.Name()
+	kinds := make([]fieldKind, len(info.Fields))
+	usesReflect := false
+	for i, field := range info.Fields {
+		kinds[i] = g.fieldKind(field)
+		usesReflect = usesReflect || kinds[i] == fieldReflect
+	}
+	p.Pl("func (goo *%v) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {", name).I(func(p *press.Press) {
+		p.Pl("var lastFieldNum uint32")
+		if usesReflect {
+			g.imports["reflect"] = true
+			p.Pl("rv := reflect.ValueOf(goo).Elem()")
+		}
+		for i, field := range info.Fields {
+			p.Pl("// Field %v (#%v)", field.Name, field.BinFieldNum)
+			g.printDecodeField(p, info, i, field, kinds[i])
+		}
+		p.Pl("_, err := amino.ConsumeFields(bz, lastFieldNum)")
+		p.Pl("return err")
 	}).Pl("}")
 }
 
-func PrintStructFieldEncoder(p *press.Press, ref string, info amino.FieldInfo) {
-	name := info.Name
-	fref := ref + "." + name // TODO document restriction on name types to make naive "+" possible.
-	done := p.RandID("done")
-	cond := printStructFieldSkipCond(p, fref, info)
-	p.Pl("{").I(func(p *press.Press) {
-		p.Pl("// Struct field %v", name)
-		p.Pl("// Maybe skip?")
-		p.Pl("// if (%v) {", cond).I(func(p *press.Press) {
-			p.Pl("goto %v", done)
+// NOTE: Keep in sync with decodeReflectBinaryField.
+func (g *generator) printDecodeField(p *press.Press, info *amino.TypeInfo, idx int, field amino.FieldInfo, kind fieldKind) {
+	ref := "goo." + field.Name
+	num := field.BinFieldNum
+	texpr, _ := g.typeExpr(field.Type)
+	printErr := func(p *press.Press) {
+		p.Pl("if err != nil {").I(func(p *press.Press) {
+			p.Pl("return err")
+		}).Pl("}")
+	}
+
+	switch kind {
+	case fieldReflect:
+		p.Pl("if n, err := cdc.DecodeReflectBinaryField(bz, rv, %v, &lastFieldNum); err != nil {", idx).I(func(p *press.Press) {
+			p.Pl("return err")
+		}).Pl("} else {").I(func(p *press.Press) {
+			p.Pl("bz = bz[n:]")
+		}).Pl("}")
+		return
+
+	case fieldList:
+		g.useType(field.Type)
+		g.useType(field.Type.Elem())
+		g.printDecodeList(p, field, texpr)
+		return
+	}
+	if kind != fieldAminoString || field.Type.Kind() != reflect.Slice {
+		// The zero value or conversion below refers to the type.
+		g.useType(field.Type)
+	}
+
+	var zero string
+	switch kind {
+	case fieldPrimitive:
+		prim, _ := g.primitive(field.Type.Kind(), field.FieldOptions)
+		zero = prim.zero
+	case fieldBytes, fieldStructPtr:
+		zero = "nil"
+	case fieldByteArray, fieldStruct:
+		zero = texpr + "{}"
+	case fieldAminoString:
+		if field.Type.Kind() == reflect.Slice {
+			zero = "nil"
+		} else {
+			zero = texpr + "{}"
+		}
+	}
+	g.imports["fmt"] = true
+	p.Pl("if len(bz) == 0 {").I(func(p *press.Press) {
+		p.Pl("%v = %v", ref, zero)
+	}).Pl("} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > %v {", num).I(func(p *press.Press) {
+		p.Pl("%v = %v", ref, zero)
+	}).Pl("} else {").I(func(p *press.Press) {
+		printErr(p)
+		p.Pl("bz = bz[n:]")
+		p.Pl("if fnum <= lastFieldNum {").I(func(p *press.Press) {
+			p.Pl("return fmt.Errorf(%q, fnum, lastFieldNum, bz)",
+				"encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X")
+		}).Pl("}")
+		p.Pl("lastFieldNum = fnum")
+		p.Pl("if fnum != %v {", num).I(func(p *press.Press) {
+			p.Pl("return fmt.Errorf(%q, fnum)",
+				fmt.Sprintf("expected field # %v of %v, got %%v", num, info.Type))
+		}).Pl("}")
+		typ := typ3Expr(field.TypeInfo.GetTyp3(field.FieldOptions))
+		p.Pl("if typ != %v {", typ).I(func(p *press.Press) {
+			p.Pl("return fmt.Errorf(%q, %v, typ)",
+				fmt.Sprintf("expected field type %%v for # %v of %v, got %%v", num, info.Type), typ)
 		}).Pl("}")
-		p.Pl("pos1 := w.Len()")
-		p.Pl("// Write field number & typ3")
-		p.Pl("// TODO")
-		p.Pl("pos2 := w.Len()")
-		p.Pl("// Write field value")
-		// XXX PrintValueEncoder(p, fref, info.Type)
-		p.Pl("XXX PrintValueEncoder()")
-		p.Pl("pos3 := w.Len()")
-		// Maybe skip the writing of zero structs unless also WriteEmpty.
-		if info.Type.Kind() == reflect.Ptr && !info.WriteEmpty {
-			p.Pl("if (pos2 == pos3-1 && w.PeekLastByte() == 0x00) {").I(func(p *press.Press) {
-				p.Pl("w.Truncate(pos1)")
+
+		switch kind {
+		case fieldPrimitive:
+			prim, _ := g.primitive(field.Type.Kind(), field.FieldOptions)
+			p.Pl("v, n, err := amino.%v(bz)", prim.decode)
+			printErr(p)
+			p.Pl("bz = bz[n:]")
+			p.Pl("%v = %v(v)", ref, texpr)
+		case fieldBytes:
+			p.Pl("v, n, err := amino.DecodeByteSlice(bz)")
+			printErr(p)
+			p.Pl("bz = bz[n:]")
+			p.Pl("if len(v) == 0 {").I(func(p *press.Press) {
+				p.Pl("%v = nil", ref)
+			}).Pl("} else {").I(func(p *press.Press) {
+				p.Pl("%v = %v(v)", ref, texpr)
+			}).Pl("}")
+		case fieldByteArray:
+			length := field.Type.Len()
+			p.Pl("if len(bz) < %v {", length).I(func(p *press.Press) {
+				p.Pl("return fmt.Errorf(%q)", fmt.Sprintf("insufficient bytes to decode [%v]byte", length))
+			}).Pl("}")
+			p.Pl("v, n, err := amino.DecodeByteSlice(bz)")
+			printErr(p)
+			p.Pl("bz = bz[n:]")
+			p.Pl("if len(v) != %v {", length).I(func(p *press.Press) {
+				p.Pl("return fmt.Errorf(%q, len(v))",
+					fmt.Sprintf("mismatched byte array length: Expected %v, got %%v", length))
+			}).Pl("}")
+			p.Pl("copy(%v[:], v)", ref)
+		case fieldStruct, fieldStructPtr:
+			p.Pl("v, n, err := amino.DecodeByteSlice(bz)")
+			printErr(p)
+			p.Pl("bz = bz[n:]")
+			if kind == fieldStructPtr {
+				p.Pl("if %v == nil {", ref).I(func(p *press.Press) {
+					p.Pl("%v = new(%v)", ref, texpr[1:])
+				}).Pl("}")
+			}
+			p.Pl("if err := %v.UnmarshalBinary2(cdc, v); err != nil {", ref).I(func(p *press.Press) {
+				p.Pl("return err")
+			}).Pl("}")
+		case fieldAminoString:
+			p.Pl("v, n, err := amino.DecodeString(bz)")
+			printErr(p)
+			p.Pl("bz = bz[n:]")
+			p.Pl("if err := %v.UnmarshalAmino(v); err != nil {", ref).I(func(p *press.Press) {
+				p.Pl("return err")
 			}).Pl("}")
+		default:
+			panic("should not happen")
 		}
 	}).Pl("}")
 }
 
-func printStructFieldSkipCond(p *press.Press, fref string, info amino.FieldInfo) string {
-	// If the value is nil or empty, do not encode.
-	// Amino "zero" structs are not yet well defined/understood.
-	// Field values that are zero structs (and !WriteEmpty) are not skipped
-	// here, but later in PrintStructFieldEncoder() upon inspecting the number
-	// of written bytes.
-	switch info.Type.Kind() {
-	case reflect.Ptr:
-		return _fmt("%v == nil", fref)
-	case reflect.Bool:
-		return _fmt("%v == false", fref)
-	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		return _fmt("%v == 0", fref)
-	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-		return _fmt("%v == 0", fref)
-	case reflect.String:
-		return _fmt("len(%v) == 0", fref)
-	case reflect.Chan, reflect.Map, reflect.Slice:
-		return _fmt("%v == nil || len(%v) == 0", fref, fref)
-	case reflect.Func, reflect.Interface:
-		return _fmt("%v == nil", fref)
-	default:
-		return "true"
+// NOTE: Keep in sync with decodeReflectBinarySlice.
+func (g *generator) printDecodeList(p *press.Press, field amino.FieldInfo, texpr string) {
+	ref := "goo." + field.Name
+	num := field.BinFieldNum
+	ekind := g.elemKind(field)
+	eexpr, _ := g.typeExpr(field.Type.Elem())
+	var ezero string
+	switch ekind {
+	case fieldPrimitive:
+		ezero = "\"\""
+	case fieldBytes, fieldStructPtr:
+		ezero = "nil"
+	case fieldStruct:
+		ezero = eexpr + "{}"
+	}
+	printErr := func(p *press.Press) {
+		p.Pl("if err != nil {").I(func(p *press.Press) {
+			p.Pl("return err")
+		}).Pl("}")
 	}
+	g.imports["fmt"] = true
+	// Unlike other fields, a skipped list is left as is.
+	p.Pl("if len(bz) == 0 {").I(func(p *press.Press) {
+		p.Pl("%v = nil", ref)
+	}).Pl("} else if fnum, _, _, err := amino.DecodeFieldNumberAndTyp3(bz); err != nil {").I(func(p *press.Press) {
+		p.Pl("return err")
+	}).Pl("} else if fnum <= %v {", num).I(func(p *press.Press) {
+		p.Pl("var list %v", texpr)
+		p.Pl("for len(bz) > 0 {").I(func(p *press.Press) {
+			p.Pl("fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz)")
+			p.Pl("if fnum > %v {", num).I(func(p *press.Press) {
+				p.Pl("break")
+			}).Pl("}")
+			printErr(p)
+			p.Pl("bz = bz[n:]")
+			p.Pl("if fnum < %v {", num).I(func(p *press.Press) {
+				p.Pl("return fmt.Errorf(%q, fnum)",
+					fmt.Sprintf("expected repeated field number %v or greater, got %%v", num))
+			}).Pl("}")
+			p.Pl("if typ != amino.Typ3ByteLength {").I(func(p *press.Press) {
+				p.Pl("return fmt.Errorf(%q, amino.Typ3ByteLength, typ)",
+					"expected repeated field type %v, got %v")
+			}).Pl("}")
+			if ekind != fieldStructPtr || field.NilElements {
+				p.Pl("if len(bz) > 0 && bz[0] == 0x00 {").I(func(p *press.Press) {
+					p.Pl("bz = bz[1:]")
+					p.Pl("list = append(list, %v)", ezero)
+					p.Pl("continue")
+				}).Pl("}")
+			}
+			switch ekind {
+			case fieldPrimitive:
+				p.Pl("v, n, err := amino.DecodeString(bz)")
+				printErr(p)
+				p.Pl("bz = bz[n:]")
+				p.Pl("list = append(list, %v(v))", eexpr)
+			case fieldBytes:
+				p.Pl("var e %v", eexpr)
+				p.Pl("if len(bz) > 0 {").I(func(p *press.Press) {
+					p.Pl("v, n, err := amino.DecodeByteSlice(bz)")
+					printErr(p)
+					p.Pl("bz = bz[n:]")
+					p.Pl("if len(v) > 0 {").I(func(p *press.Press) {
+						p.Pl("e = %v(v)", eexpr)
+					}).Pl("}")
+				}).Pl("}")
+				p.Pl("list = append(list, e)")
+			case fieldStruct, fieldStructPtr:
+				p.Pl("v, n, err := amino.DecodeByteSlice(bz)")
+				printErr(p)
+				p.Pl("bz = bz[n:]")
+				if ekind == fieldStruct {
+					p.Pl("var e %v", eexpr)
+				} else {
+					p.Pl("e := new(%v)", eexpr[1:])
+				}
+				p.Pl("if err := e.UnmarshalBinary2(cdc, v); err != nil {").I(func(p *press.Press) {
+					p.Pl("return err")
+				}).Pl("}")
+				p.Pl("list = append(list, e)")
+			default:
+				panic("should not happen")
+			}
+		}).Pl("}")
+		p.Pl("%v = list", ref)
+	}).Pl("}")
 }
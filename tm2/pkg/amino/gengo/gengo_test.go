@@ -1,32 +1,29 @@
 package gengo
 
 import (
-	"fmt"
-	"reflect"
+	"os"
 	"testing"
 
-	"github.com/gnolang/gno/tm2/pkg/amino"
-	"github.com/gnolang/gno/tm2/pkg/amino/libs/press"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnolang/gno/tm2/pkg/amino/tests"
 )
 
-type SampleStruct struct {
-	Blah1 string
-	Blah2 string
-}
+func TestGenerateBinary2(t *testing.T) {
+	t.Parallel()
 
-func TestBasic(t *testing.T) {
-	p := press.NewPress()
-	fmt.Println(p)
-	ss := SampleStruct{"cat", "dog"}
+	bz, err := GenerateBinary2ForTypes(tests.Package, tests.Package.ReflectTypes()...)
+	require.NoError(t, err)
+	src := string(bz)
+	assert.Contains(t, src, "package tests")
+	assert.Contains(t, src, "func (goo PrimitivesStruct) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {")
+	assert.Contains(t, src, "func (goo *PrimitivesStruct) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {")
+	// Amino marshalers get no methods.
+	assert.NotContains(t, src, "func (goo AminoMarshalerStruct1) MarshalBinary2")
 
-	cdc := amino.NewCodec()
-	ssType := reflect.TypeOf(ss)
-	info, err := cdc.GetTypeInfo(ssType)
-	if err != nil {
-		panic(err)
-	}
-	PrintStructFieldEncoder(p, "b1", info.Fields[0])
-	fmt.Println("----")
-	fmt.Println(p.Print())
-	fmt.Println("----")
+	// The generated code of the tests package must be up to date.
+	existing, err := os.ReadFile("../tests/binary2.go")
+	require.NoError(t, err)
+	assert.Equal(t, string(existing), src, "run tests/cmd to regenerate")
 }
@@ -286,9 +286,39 @@ func (p3c *P3Context) GenerateProto3SchemaForTypes(pkg *amino.Package, rtz ...re
 		p3doc.Messages = append(p3doc.Messages, p3msg)
 	}
 
+	// Set Service definitions.
+	for _, svc := range pkg.Services {
+		p3doc.Services = append(p3doc.Services, p3c.GenerateProto3Service(pkg, svc))
+	}
+
 	return p3doc
 }
 
+// Generate the Proto3 service definition of svc.  The requests and responses
+// are types of pkg, so no imports are needed.
+func (p3c *P3Context) GenerateProto3Service(pkg *amino.Package, svc *amino.Service) (p3svc P3Service) {
+	p3svc.Name = svc.Name
+	for _, m := range svc.Methods {
+		p3svc.Methods = append(p3svc.Methods, P3Method{
+			Name:            m.Name,
+			RequestType:     p3c.localP3MessageType(pkg, m.RequestType()),
+			ResponseType:    p3c.localP3MessageType(pkg, m.ResponseType()),
+			ServerStreaming: m.ServerStreaming,
+		})
+	}
+	return
+}
+
+func (p3c *P3Context) localP3MessageType(pkg *amino.Package, rt reflect.Type) P3MessageType {
+	info, err := p3c.cdc.GetTypeInfo(rt)
+	if err != nil {
+		panic(err)
+	}
+	p3mt := NewP3MessageType(pkg.P3PkgName, info.Name)
+	p3mt.SetOmitPackage()
+	return p3mt
+}
+
 // Convenience.
 func (p3c *P3Context) WriteProto3SchemaForTypes(filename string, pkg *amino.Package, rtz ...reflect.Type) {
 	fmt.Printf("writing proto3 schema to %v for package %v\n", filename, pkg)
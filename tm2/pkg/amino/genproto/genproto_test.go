@@ -4,6 +4,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/gnolang/gno/tm2/pkg/amino"
 	sm1 "github.com/gnolang/gno/tm2/pkg/amino/genproto/example/submodule"
 	"github.com/jaekwon/testify/assert"
 )
@@ -43,3 +44,47 @@ message StructSM {
 	submodule2.StructSM2 FieldC = 3;
 }`)
 }
+
+type (
+	RequestFoo  struct{ Height int64 }
+	ResponseFoo struct{ Data []byte }
+)
+
+func TestService(t *testing.T) {
+	pkg := amino.NewPackage(
+		reflect.TypeOf(RequestFoo{}).PkgPath(),
+		"foo",
+		"",
+	).WithTypes(
+		RequestFoo{},
+		ResponseFoo{},
+	).WithServices(amino.Service{
+		Name: "FooService",
+		Methods: []amino.Method{
+			{Name: "Foo", Request: RequestFoo{}, Response: ResponseFoo{}},
+			{Name: "Foos", Request: RequestFoo{}, Response: ResponseFoo{}, ServerStreaming: true},
+		},
+	})
+	p3c := NewP3Context()
+	p3c.RegisterPackage(pkg)
+	p3doc := p3c.GenerateProto3SchemaForTypes(pkg, pkg.ReflectTypes()...)
+	assert.Equal(t, p3doc.Print(), `syntax = "proto3";
+package foo;
+
+option go_package = "github.com/gnolang/gno/tm2/pkg/amino/genproto/pb";
+
+// messages
+message RequestFoo {
+	sint64 Height = 1;
+}
+
+message ResponseFoo {
+	bytes Data = 1;
+}
+
+// services
+service FooService {
+	rpc Foo (RequestFoo) returns (ResponseFoo);
+	rpc Foos (RequestFoo) returns (stream ResponseFoo);
+}`)
+}
@@ -124,6 +124,7 @@ type P3Doc struct {
 	Comment     string
 	Imports     []P3Import
 	Messages    []P3Message
+	Services    []P3Service
 	// Enums []P3Enums // enums not supported, no need.
 }
 
@@ -155,6 +156,20 @@ type P3Field struct {
 	Number   uint32
 }
 
+type P3Service struct {
+	Comment string
+	Name    string
+	Methods []P3Method
+}
+
+type P3Method struct {
+	Comment         string
+	Name            string
+	RequestType     P3Type
+	ResponseType    P3Type
+	ServerStreaming bool
+}
+
 //----------------------------------------
 // Functions for printing P3 objects
 
@@ -197,10 +212,18 @@ func (doc P3Doc) PrintCode(p *press.Press) *press.Press {
 		}
 		msg.PrintCode(p)
 		p.Ln()
-		if i == len(doc.Messages)-1 {
+		if i == len(doc.Messages)-1 && len(doc.Services) == 0 {
 			p.Ln()
 		}
 	}
+	// Print services, if any.
+	for i, svc := range doc.Services {
+		if i == 0 {
+			p.Pl("// services")
+		}
+		svc.PrintCode(p)
+		p.Ln()
+	}
 	return p
 }
 
@@ -234,6 +257,26 @@ func (fld P3Field) PrintCode(p *press.Press) *press.Press {
 	return p
 }
 
+func (svc P3Service) PrintCode(p *press.Press) *press.Press {
+	printComments(p, svc.Comment)
+	p.Pl("service %v {", svc.Name).I(func(p *press.Press) {
+		for _, m := range svc.Methods {
+			m.PrintCode(p)
+		}
+	}).Pl("}")
+	return p
+}
+
+func (m P3Method) PrintCode(p *press.Press) *press.Press {
+	printComments(p, m.Comment)
+	if m.ServerStreaming {
+		p.Pl("rpc %v (%v) returns (stream %v);", m.Name, m.RequestType, m.ResponseType)
+	} else {
+		p.Pl("rpc %v (%v) returns (%v);", m.Name, m.RequestType, m.ResponseType)
+	}
+	return p
+}
+
 func printComments(p *press.Press, comment string) {
 	if comment == "" {
 		return
@@ -19,6 +19,39 @@ func (t *Type) FullName(pkg *Package) string {
 	return fmt.Sprintf("%v.%v", pkg.P3PkgName, t.Name)
 }
 
+// Service declares an RPC service of the package, for which a proto3 service
+// definition is generated along with the message schemas.
+type Service struct {
+	Name    string // proto3 name
+	Methods []Method
+}
+
+// Method is a method of a Service.  Request and Response are prototype
+// objects of types registered with the package, as for WithTypes().
+type Method struct {
+	Name            string // proto3 name
+	Request         interface{}
+	Response        interface{}
+	ServerStreaming bool // whether a stream of responses is returned.
+}
+
+// RequestType returns the dereferenced type of the request.
+func (m Method) RequestType() reflect.Type {
+	return derefType(reflect.TypeOf(m.Request))
+}
+
+// ResponseType returns the dereferenced type of the response.
+func (m Method) ResponseType() reflect.Type {
+	return derefType(reflect.TypeOf(m.Response))
+}
+
+func derefType(rt reflect.Type) reflect.Type {
+	if rt.Kind() == reflect.Ptr {
+		return rt.Elem()
+	}
+	return rt
+}
+
 // amino: immutable TODO
 type Package struct {
 	// General info
@@ -27,6 +60,7 @@ type Package struct {
 	DirName      string
 	Dependencies []*Package
 	Types        []*Type
+	Services     []*Service
 
 	// Proto3 info
 	P3GoPkgPath  string
@@ -181,6 +215,32 @@ func (pkg *Package) WithTypes(objs ...interface{}) *Package {
 	return pkg
 }
 
+// Services must be declared after the types of their requests and
+// responses.
+func (pkg *Package) WithServices(svcs ...Service) *Package {
+	for _, svc := range svcs {
+		if svc.Name == "" || svc.Name != capitalize(svc.Name) {
+			panic(fmt.Sprintf("Service name must be capitalized, but got %q", svc.Name))
+		}
+		for _, m := range svc.Methods {
+			if m.Name == "" || m.Name != capitalize(m.Name) {
+				panic(fmt.Sprintf("Method name must be capitalized, but got %q", m.Name))
+			}
+			for _, rt := range []reflect.Type{m.RequestType(), m.ResponseType()} {
+				if rt.Kind() != reflect.Struct {
+					panic(fmt.Sprintf("method %v.%v: expected struct, got %v", svc.Name, m.Name, rt))
+				}
+				if _, ok := pkg.GetType(rt); !ok {
+					panic(fmt.Sprintf("method %v.%v: type %v not registered with package", svc.Name, m.Name, rt))
+				}
+			}
+		}
+		svc := svc
+		pkg.Services = append(pkg.Services, &svc)
+	}
+	return pkg
+}
+
 // This path will get imported instead of the default "types.proto"
 // if this package is a dependency.  This is not the filesystem path,
 // but the path imported within the proto schema file.  The filesystem
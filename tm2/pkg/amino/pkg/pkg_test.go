@@ -66,3 +66,36 @@ func TestFullNameForUnexpectedType(t *testing.T) {
 		pkg.TypeURLForType(reflect.TypeOf(Foo{}))
 	})
 }
+
+type Bar struct {
+	FieldA []byte
+}
+
+func TestWithServices(t *testing.T) {
+	gopkg := reflect.TypeOf(Foo{}).PkgPath()
+	pkg := NewPackage(gopkg, "some.path", "").WithTypes(Foo{})
+
+	// This should panic, as Bar wasn't registered.
+	assert.Panics(t, func() {
+		pkg.WithServices(Service{
+			Name:    "FooService",
+			Methods: []Method{{Name: "GetFoo", Request: Bar{}, Response: Foo{}}},
+		})
+	})
+
+	// This should panic, as the method name isn't capitalized.
+	assert.Panics(t, func() {
+		pkg.WithServices(Service{
+			Name:    "FooService",
+			Methods: []Method{{Name: "getFoo", Request: Foo{}, Response: Foo{}}},
+		})
+	})
+
+	pkg.WithTypes(Bar{}).WithServices(Service{
+		Name:    "FooService",
+		Methods: []Method{{Name: "GetFoo", Request: &Bar{}, Response: Foo{}, ServerStreaming: true}},
+	})
+	assert.Len(t, pkg.Services, 1)
+	assert.Equal(t, reflect.TypeOf(Bar{}), pkg.Services[0].Methods[0].RequestType())
+	assert.Equal(t, reflect.TypeOf(Foo{}), pkg.Services[0].Methods[0].ResponseType())
+}
@@ -119,6 +119,22 @@ func _testCodec(t *testing.T, rt reflect.Type, codecType string) {
 			spw(ptr), spw(ptr2), bz, bz)
 
 		if codecType == "binary" {
+			// Encode with the gengo generated code, and ensure equality
+			// of the bytes and of the decoded value.
+			cdc2 := amino.NewCodec().WithBinary2()
+			bz2, err := cdc2.Marshal(ptr)
+			require.NoError(t, err)
+			require.Equal(t, bz, bz2,
+				"binary2 serialization check failed.\nbz(reflect): %X\nbz(binary2): %X\nstart(goo): %v\n",
+				bz, bz2, spw(ptr))
+			rv5 := reflect.New(rt)
+			ptr5 := rv5.Interface()
+			err = cdc2.Unmarshal(bz, ptr5)
+			require.NoError(t, err)
+			require.Equal(t, ptr, ptr5,
+				"end to end through binary2 failed.\nbz: %X\nstart(goo): %v\nend(goo): %v\n",
+				bz, spw(ptr), spw(ptr5))
+
 			// Get pbo from rv. (go -> p3go)
 			pbm, ok := rv.Interface().(amino.PBMessager)
 			if !ok {
@@ -644,3 +660,42 @@ func randString(r fuzz.Continue) string {
 	}
 	return string(runes)
 }
+
+// A struct embedding a struct with generated methods gets them promoted, but
+// must still encode its own fields.
+type embeddingStruct struct {
+	tests.PrimitivesStruct
+	Extra int64
+}
+
+func TestBinary2EmbeddingStruct(t *testing.T) {
+	t.Parallel()
+
+	cdc := amino.NewCodec()
+	cdc2 := amino.NewCodec().WithBinary2()
+
+	s := embeddingStruct{Extra: 42}
+	s.Int8 = 8
+	s.Str = "embedded"
+
+	bz, err := cdc.Marshal(s)
+	require.NoError(t, err)
+	bz2, err := cdc2.Marshal(s)
+	require.NoError(t, err)
+	require.Equal(t, bz, bz2)
+
+	var s2 embeddingStruct
+	require.NoError(t, cdc2.Unmarshal(bz2, &s2))
+	require.Equal(t, s, s2)
+
+	// Also as a field of another struct.
+	type outer struct{ E embeddingStruct }
+	bz, err = cdc.Marshal(outer{s})
+	require.NoError(t, err)
+	bz2, err = cdc2.Marshal(outer{s})
+	require.NoError(t, err)
+	require.Equal(t, bz, bz2)
+	var o2 outer
+	require.NoError(t, cdc2.Unmarshal(bz2, &o2))
+	require.Equal(t, s, o2.E)
+}
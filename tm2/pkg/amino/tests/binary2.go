@@ -0,0 +1,4774 @@
+// Code generated by gengo. DO NOT EDIT.
+
+package tests
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/gnolang/gno/tm2/pkg/amino"
+)
+
+func (goo EmptyStruct) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	return nil
+}
+
+func (goo *EmptyStruct) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo PrimitivesStruct) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Int8 (#1)
+	if goo.Int8 != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Int8)); err != nil {
+			return
+		}
+	}
+	// Field Int16 (#2)
+	if goo.Int16 != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Int16)); err != nil {
+			return
+		}
+	}
+	// Field Int32 (#3)
+	if goo.Int32 != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Int32)); err != nil {
+			return
+		}
+	}
+	// Field Int32Fixed (#4)
+	if goo.Int32Fixed != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 4, amino.Typ34Byte); err != nil {
+			return
+		}
+		if err = amino.EncodeInt32(buf, int32(goo.Int32Fixed)); err != nil {
+			return
+		}
+	}
+	// Field Int64 (#5)
+	if goo.Int64 != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 5, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Int64)); err != nil {
+			return
+		}
+	}
+	// Field Int64Fixed (#6)
+	if goo.Int64Fixed != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 6, amino.Typ38Byte); err != nil {
+			return
+		}
+		if err = amino.EncodeInt64(buf, int64(goo.Int64Fixed)); err != nil {
+			return
+		}
+	}
+	// Field Int (#7)
+	if goo.Int != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 7, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Int)); err != nil {
+			return
+		}
+	}
+	// Field Byte (#8)
+	if goo.Byte != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 8, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeUvarint(buf, uint64(goo.Byte)); err != nil {
+			return
+		}
+	}
+	// Field Uint8 (#9)
+	if goo.Uint8 != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 9, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeUvarint(buf, uint64(goo.Uint8)); err != nil {
+			return
+		}
+	}
+	// Field Uint16 (#10)
+	if goo.Uint16 != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 10, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeUvarint(buf, uint64(goo.Uint16)); err != nil {
+			return
+		}
+	}
+	// Field Uint32 (#11)
+	if goo.Uint32 != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 11, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeUvarint(buf, uint64(goo.Uint32)); err != nil {
+			return
+		}
+	}
+	// Field Uint32Fixed (#12)
+	if goo.Uint32Fixed != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 12, amino.Typ34Byte); err != nil {
+			return
+		}
+		if err = amino.EncodeUint32(buf, uint32(goo.Uint32Fixed)); err != nil {
+			return
+		}
+	}
+	// Field Uint64 (#13)
+	if goo.Uint64 != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 13, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeUvarint(buf, uint64(goo.Uint64)); err != nil {
+			return
+		}
+	}
+	// Field Uint64Fixed (#14)
+	if goo.Uint64Fixed != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 14, amino.Typ38Byte); err != nil {
+			return
+		}
+		if err = amino.EncodeUint64(buf, uint64(goo.Uint64Fixed)); err != nil {
+			return
+		}
+	}
+	// Field Uint (#15)
+	if goo.Uint != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 15, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeUvarint(buf, uint64(goo.Uint)); err != nil {
+			return
+		}
+	}
+	// Field Str (#16)
+	if goo.Str != "" {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 16, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(goo.Str)); err != nil {
+			return
+		}
+	}
+	// Field Bytes (#17)
+	if len(goo.Bytes) != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 17, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, goo.Bytes); err != nil {
+			return
+		}
+	}
+	// Field Time (#18)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 17); err != nil {
+		return
+	}
+	// Field Duration (#19)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 18); err != nil {
+		return
+	}
+	// Field Empty (#20)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Empty.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 20, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	return nil
+}
+
+func (goo *PrimitivesStruct) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Int8 (#1)
+	if len(bz) == 0 {
+		goo.Int8 = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Int8 = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of tests.PrimitivesStruct, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 1 of tests.PrimitivesStruct, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint8(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Int8 = int8(v)
+	}
+	// Field Int16 (#2)
+	if len(bz) == 0 {
+		goo.Int16 = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.Int16 = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of tests.PrimitivesStruct, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 2 of tests.PrimitivesStruct, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint16(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Int16 = int16(v)
+	}
+	// Field Int32 (#3)
+	if len(bz) == 0 {
+		goo.Int32 = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.Int32 = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of tests.PrimitivesStruct, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 3 of tests.PrimitivesStruct, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Int32 = int32(v)
+	}
+	// Field Int32Fixed (#4)
+	if len(bz) == 0 {
+		goo.Int32Fixed = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 4 {
+		goo.Int32Fixed = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 4 {
+			return fmt.Errorf("expected field # 4 of tests.PrimitivesStruct, got %v", fnum)
+		}
+		if typ != amino.Typ34Byte {
+			return fmt.Errorf("expected field type %v for # 4 of tests.PrimitivesStruct, got %v", amino.Typ34Byte, typ)
+		}
+		v, n, err := amino.DecodeInt32(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Int32Fixed = int32(v)
+	}
+	// Field Int64 (#5)
+	if len(bz) == 0 {
+		goo.Int64 = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 5 {
+		goo.Int64 = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 5 {
+			return fmt.Errorf("expected field # 5 of tests.PrimitivesStruct, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 5 of tests.PrimitivesStruct, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Int64 = int64(v)
+	}
+	// Field Int64Fixed (#6)
+	if len(bz) == 0 {
+		goo.Int64Fixed = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 6 {
+		goo.Int64Fixed = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 6 {
+			return fmt.Errorf("expected field # 6 of tests.PrimitivesStruct, got %v", fnum)
+		}
+		if typ != amino.Typ38Byte {
+			return fmt.Errorf("expected field type %v for # 6 of tests.PrimitivesStruct, got %v", amino.Typ38Byte, typ)
+		}
+		v, n, err := amino.DecodeInt64(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Int64Fixed = int64(v)
+	}
+	// Field Int (#7)
+	if len(bz) == 0 {
+		goo.Int = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 7 {
+		goo.Int = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 7 {
+			return fmt.Errorf("expected field # 7 of tests.PrimitivesStruct, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 7 of tests.PrimitivesStruct, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Int = int(v)
+	}
+	// Field Byte (#8)
+	if len(bz) == 0 {
+		goo.Byte = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 8 {
+		goo.Byte = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 8 {
+			return fmt.Errorf("expected field # 8 of tests.PrimitivesStruct, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 8 of tests.PrimitivesStruct, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeUvarint8(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Byte = uint8(v)
+	}
+	// Field Uint8 (#9)
+	if len(bz) == 0 {
+		goo.Uint8 = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 9 {
+		goo.Uint8 = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 9 {
+			return fmt.Errorf("expected field # 9 of tests.PrimitivesStruct, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 9 of tests.PrimitivesStruct, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeUvarint8(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Uint8 = uint8(v)
+	}
+	// Field Uint16 (#10)
+	if len(bz) == 0 {
+		goo.Uint16 = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 10 {
+		goo.Uint16 = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 10 {
+			return fmt.Errorf("expected field # 10 of tests.PrimitivesStruct, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 10 of tests.PrimitivesStruct, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeUvarint16(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Uint16 = uint16(v)
+	}
+	// Field Uint32 (#11)
+	if len(bz) == 0 {
+		goo.Uint32 = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 11 {
+		goo.Uint32 = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 11 {
+			return fmt.Errorf("expected field # 11 of tests.PrimitivesStruct, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 11 of tests.PrimitivesStruct, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeUvarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Uint32 = uint32(v)
+	}
+	// Field Uint32Fixed (#12)
+	if len(bz) == 0 {
+		goo.Uint32Fixed = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 12 {
+		goo.Uint32Fixed = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 12 {
+			return fmt.Errorf("expected field # 12 of tests.PrimitivesStruct, got %v", fnum)
+		}
+		if typ != amino.Typ34Byte {
+			return fmt.Errorf("expected field type %v for # 12 of tests.PrimitivesStruct, got %v", amino.Typ34Byte, typ)
+		}
+		v, n, err := amino.DecodeUint32(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Uint32Fixed = uint32(v)
+	}
+	// Field Uint64 (#13)
+	if len(bz) == 0 {
+		goo.Uint64 = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 13 {
+		goo.Uint64 = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 13 {
+			return fmt.Errorf("expected field # 13 of tests.PrimitivesStruct, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 13 of tests.PrimitivesStruct, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeUvarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Uint64 = uint64(v)
+	}
+	// Field Uint64Fixed (#14)
+	if len(bz) == 0 {
+		goo.Uint64Fixed = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 14 {
+		goo.Uint64Fixed = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 14 {
+			return fmt.Errorf("expected field # 14 of tests.PrimitivesStruct, got %v", fnum)
+		}
+		if typ != amino.Typ38Byte {
+			return fmt.Errorf("expected field type %v for # 14 of tests.PrimitivesStruct, got %v", amino.Typ38Byte, typ)
+		}
+		v, n, err := amino.DecodeUint64(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Uint64Fixed = uint64(v)
+	}
+	// Field Uint (#15)
+	if len(bz) == 0 {
+		goo.Uint = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 15 {
+		goo.Uint = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 15 {
+			return fmt.Errorf("expected field # 15 of tests.PrimitivesStruct, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 15 of tests.PrimitivesStruct, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeUvarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Uint = uint(v)
+	}
+	// Field Str (#16)
+	if len(bz) == 0 {
+		goo.Str = ""
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 16 {
+		goo.Str = ""
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 16 {
+			return fmt.Errorf("expected field # 16 of tests.PrimitivesStruct, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 16 of tests.PrimitivesStruct, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Str = string(v)
+	}
+	// Field Bytes (#17)
+	if len(bz) == 0 {
+		goo.Bytes = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 17 {
+		goo.Bytes = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 17 {
+			return fmt.Errorf("expected field # 17 of tests.PrimitivesStruct, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 17 of tests.PrimitivesStruct, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if len(v) == 0 {
+			goo.Bytes = nil
+		} else {
+			goo.Bytes = []uint8(v)
+		}
+	}
+	// Field Time (#18)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 17, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Duration (#19)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 18, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Empty (#20)
+	if len(bz) == 0 {
+		goo.Empty = EmptyStruct{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 20 {
+		goo.Empty = EmptyStruct{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 20 {
+			return fmt.Errorf("expected field # 20 of tests.PrimitivesStruct, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 20 of tests.PrimitivesStruct, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Empty.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo ShortArraysStruct) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field TimeAr (#1)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 0); err != nil {
+		return
+	}
+	// Field DurationAr (#2)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 1); err != nil {
+		return
+	}
+	return nil
+}
+
+func (goo *ShortArraysStruct) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field TimeAr (#1)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 0, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field DurationAr (#2)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 1, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo ArraysStruct) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Int8Ar (#1)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 0); err != nil {
+		return
+	}
+	// Field Int16Ar (#2)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 1); err != nil {
+		return
+	}
+	// Field Int32Ar (#3)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 2); err != nil {
+		return
+	}
+	// Field Int32FixedAr (#4)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 3); err != nil {
+		return
+	}
+	// Field Int64Ar (#5)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 4); err != nil {
+		return
+	}
+	// Field Int64FixedAr (#6)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 5); err != nil {
+		return
+	}
+	// Field IntAr (#7)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 6); err != nil {
+		return
+	}
+	// Field ByteAr (#8)
+	if err = amino.EncodeFieldNumberAndTyp3(buf, 8, amino.Typ3ByteLength); err != nil {
+		return
+	}
+	if err = amino.EncodeByteSlice(buf, goo.ByteAr[:]); err != nil {
+		return
+	}
+	// Field Uint8Ar (#9)
+	if err = amino.EncodeFieldNumberAndTyp3(buf, 9, amino.Typ3ByteLength); err != nil {
+		return
+	}
+	if err = amino.EncodeByteSlice(buf, goo.Uint8Ar[:]); err != nil {
+		return
+	}
+	// Field Uint16Ar (#10)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 9); err != nil {
+		return
+	}
+	// Field Uint32Ar (#11)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 10); err != nil {
+		return
+	}
+	// Field Uint32FixedAr (#12)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 11); err != nil {
+		return
+	}
+	// Field Uint64Ar (#13)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 12); err != nil {
+		return
+	}
+	// Field Uint64FixedAr (#14)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 13); err != nil {
+		return
+	}
+	// Field UintAr (#15)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 14); err != nil {
+		return
+	}
+	// Field StrAr (#16)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 15); err != nil {
+		return
+	}
+	// Field BytesAr (#17)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 16); err != nil {
+		return
+	}
+	// Field TimeAr (#18)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 17); err != nil {
+		return
+	}
+	// Field DurationAr (#19)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 18); err != nil {
+		return
+	}
+	// Field EmptyAr (#20)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 19); err != nil {
+		return
+	}
+	return nil
+}
+
+func (goo *ArraysStruct) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Int8Ar (#1)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 0, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Int16Ar (#2)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 1, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Int32Ar (#3)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 2, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Int32FixedAr (#4)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 3, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Int64Ar (#5)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 4, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Int64FixedAr (#6)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 5, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field IntAr (#7)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 6, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field ByteAr (#8)
+	if len(bz) == 0 {
+		goo.ByteAr = [4]uint8{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 8 {
+		goo.ByteAr = [4]uint8{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 8 {
+			return fmt.Errorf("expected field # 8 of tests.ArraysStruct, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 8 of tests.ArraysStruct, got %v", amino.Typ3ByteLength, typ)
+		}
+		if len(bz) < 4 {
+			return fmt.Errorf("insufficient bytes to decode [4]byte")
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if len(v) != 4 {
+			return fmt.Errorf("mismatched byte array length: Expected 4, got %v", len(v))
+		}
+		copy(goo.ByteAr[:], v)
+	}
+	// Field Uint8Ar (#9)
+	if len(bz) == 0 {
+		goo.Uint8Ar = [4]uint8{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 9 {
+		goo.Uint8Ar = [4]uint8{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 9 {
+			return fmt.Errorf("expected field # 9 of tests.ArraysStruct, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 9 of tests.ArraysStruct, got %v", amino.Typ3ByteLength, typ)
+		}
+		if len(bz) < 4 {
+			return fmt.Errorf("insufficient bytes to decode [4]byte")
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if len(v) != 4 {
+			return fmt.Errorf("mismatched byte array length: Expected 4, got %v", len(v))
+		}
+		copy(goo.Uint8Ar[:], v)
+	}
+	// Field Uint16Ar (#10)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 9, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Uint32Ar (#11)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 10, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Uint32FixedAr (#12)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 11, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Uint64Ar (#13)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 12, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Uint64FixedAr (#14)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 13, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field UintAr (#15)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 14, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field StrAr (#16)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 15, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field BytesAr (#17)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 16, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field TimeAr (#18)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 17, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field DurationAr (#19)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 18, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field EmptyAr (#20)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 19, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo ArraysArraysStruct) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Int8ArAr (#1)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 0); err != nil {
+		return
+	}
+	// Field Int16ArAr (#2)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 1); err != nil {
+		return
+	}
+	// Field Int32ArAr (#3)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 2); err != nil {
+		return
+	}
+	// Field Int32FixedArAr (#4)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 3); err != nil {
+		return
+	}
+	// Field Int64ArAr (#5)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 4); err != nil {
+		return
+	}
+	// Field Int64FixedArAr (#6)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 5); err != nil {
+		return
+	}
+	// Field IntArAr (#7)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 6); err != nil {
+		return
+	}
+	// Field ByteArAr (#8)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 7); err != nil {
+		return
+	}
+	// Field Uint8ArAr (#9)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 8); err != nil {
+		return
+	}
+	// Field Uint16ArAr (#10)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 9); err != nil {
+		return
+	}
+	// Field Uint32ArAr (#11)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 10); err != nil {
+		return
+	}
+	// Field Uint32FixedArAr (#12)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 11); err != nil {
+		return
+	}
+	// Field Uint64ArAr (#13)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 12); err != nil {
+		return
+	}
+	// Field Uint64FixedArAr (#14)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 13); err != nil {
+		return
+	}
+	// Field UintArAr (#15)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 14); err != nil {
+		return
+	}
+	// Field StrArAr (#16)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 15); err != nil {
+		return
+	}
+	// Field BytesArAr (#17)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 16); err != nil {
+		return
+	}
+	// Field TimeArAr (#18)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 17); err != nil {
+		return
+	}
+	// Field DurationArAr (#19)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 18); err != nil {
+		return
+	}
+	// Field EmptyArAr (#20)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 19); err != nil {
+		return
+	}
+	return nil
+}
+
+func (goo *ArraysArraysStruct) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Int8ArAr (#1)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 0, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Int16ArAr (#2)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 1, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Int32ArAr (#3)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 2, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Int32FixedArAr (#4)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 3, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Int64ArAr (#5)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 4, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Int64FixedArAr (#6)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 5, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field IntArAr (#7)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 6, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field ByteArAr (#8)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 7, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Uint8ArAr (#9)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 8, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Uint16ArAr (#10)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 9, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Uint32ArAr (#11)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 10, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Uint32FixedArAr (#12)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 11, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Uint64ArAr (#13)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 12, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Uint64FixedArAr (#14)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 13, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field UintArAr (#15)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 14, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field StrArAr (#16)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 15, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field BytesArAr (#17)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 16, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field TimeArAr (#18)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 17, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field DurationArAr (#19)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 18, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field EmptyArAr (#20)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 19, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo SlicesStruct) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Int8Sl (#1)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 0); err != nil {
+		return
+	}
+	// Field Int16Sl (#2)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 1); err != nil {
+		return
+	}
+	// Field Int32Sl (#3)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 2); err != nil {
+		return
+	}
+	// Field Int32FixedSl (#4)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 3); err != nil {
+		return
+	}
+	// Field Int64Sl (#5)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 4); err != nil {
+		return
+	}
+	// Field Int64FixedSl (#6)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 5); err != nil {
+		return
+	}
+	// Field IntSl (#7)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 6); err != nil {
+		return
+	}
+	// Field ByteSl (#8)
+	if len(goo.ByteSl) != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 8, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, goo.ByteSl); err != nil {
+			return
+		}
+	}
+	// Field Uint8Sl (#9)
+	if len(goo.Uint8Sl) != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 9, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, goo.Uint8Sl); err != nil {
+			return
+		}
+	}
+	// Field Uint16Sl (#10)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 9); err != nil {
+		return
+	}
+	// Field Uint32Sl (#11)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 10); err != nil {
+		return
+	}
+	// Field Uint32FixedSl (#12)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 11); err != nil {
+		return
+	}
+	// Field Uint64Sl (#13)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 12); err != nil {
+		return
+	}
+	// Field Uint64FixedSl (#14)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 13); err != nil {
+		return
+	}
+	// Field UintSl (#15)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 14); err != nil {
+		return
+	}
+	// Field StrSl (#16)
+	for _, e := range goo.StrSl {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 16, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(e)); err != nil {
+			return
+		}
+	}
+	// Field BytesSl (#17)
+	for _, e := range goo.BytesSl {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 17, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, e); err != nil {
+			return
+		}
+	}
+	// Field TimeSl (#18)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 17); err != nil {
+		return
+	}
+	// Field DurationSl (#19)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 18); err != nil {
+		return
+	}
+	// Field EmptySl (#20)
+	for _, e := range goo.EmptySl {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 20, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		buf2 := new(bytes.Buffer)
+		if err = e.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *SlicesStruct) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Int8Sl (#1)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 0, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Int16Sl (#2)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 1, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Int32Sl (#3)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 2, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Int32FixedSl (#4)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 3, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Int64Sl (#5)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 4, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Int64FixedSl (#6)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 5, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field IntSl (#7)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 6, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field ByteSl (#8)
+	if len(bz) == 0 {
+		goo.ByteSl = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 8 {
+		goo.ByteSl = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 8 {
+			return fmt.Errorf("expected field # 8 of tests.SlicesStruct, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 8 of tests.SlicesStruct, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if len(v) == 0 {
+			goo.ByteSl = nil
+		} else {
+			goo.ByteSl = []uint8(v)
+		}
+	}
+	// Field Uint8Sl (#9)
+	if len(bz) == 0 {
+		goo.Uint8Sl = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 9 {
+		goo.Uint8Sl = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 9 {
+			return fmt.Errorf("expected field # 9 of tests.SlicesStruct, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 9 of tests.SlicesStruct, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if len(v) == 0 {
+			goo.Uint8Sl = nil
+		} else {
+			goo.Uint8Sl = []uint8(v)
+		}
+	}
+	// Field Uint16Sl (#10)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 9, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Uint32Sl (#11)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 10, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Uint32FixedSl (#12)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 11, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Uint64Sl (#13)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 12, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Uint64FixedSl (#14)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 13, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field UintSl (#15)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 14, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field StrSl (#16)
+	if len(bz) == 0 {
+		goo.StrSl = nil
+	} else if fnum, _, _, err := amino.DecodeFieldNumberAndTyp3(bz); err != nil {
+		return err
+	} else if fnum <= 16 {
+		var list []string
+		for len(bz) > 0 {
+			fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz)
+			if fnum > 16 {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			if fnum < 16 {
+				return fmt.Errorf("expected repeated field number 16 or greater, got %v", fnum)
+			}
+			if typ != amino.Typ3ByteLength {
+				return fmt.Errorf("expected repeated field type %v, got %v", amino.Typ3ByteLength, typ)
+			}
+			if len(bz) > 0 && bz[0] == 0x00 {
+				bz = bz[1:]
+				list = append(list, "")
+				continue
+			}
+			v, n, err := amino.DecodeString(bz)
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			list = append(list, string(v))
+		}
+		goo.StrSl = list
+	}
+	// Field BytesSl (#17)
+	if len(bz) == 0 {
+		goo.BytesSl = nil
+	} else if fnum, _, _, err := amino.DecodeFieldNumberAndTyp3(bz); err != nil {
+		return err
+	} else if fnum <= 17 {
+		var list [][]uint8
+		for len(bz) > 0 {
+			fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz)
+			if fnum > 17 {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			if fnum < 17 {
+				return fmt.Errorf("expected repeated field number 17 or greater, got %v", fnum)
+			}
+			if typ != amino.Typ3ByteLength {
+				return fmt.Errorf("expected repeated field type %v, got %v", amino.Typ3ByteLength, typ)
+			}
+			if len(bz) > 0 && bz[0] == 0x00 {
+				bz = bz[1:]
+				list = append(list, nil)
+				continue
+			}
+			var e []uint8
+			if len(bz) > 0 {
+				v, n, err := amino.DecodeByteSlice(bz)
+				if err != nil {
+					return err
+				}
+				bz = bz[n:]
+				if len(v) > 0 {
+					e = []uint8(v)
+				}
+			}
+			list = append(list, e)
+		}
+		goo.BytesSl = list
+	}
+	// Field TimeSl (#18)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 17, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field DurationSl (#19)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 18, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field EmptySl (#20)
+	if len(bz) == 0 {
+		goo.EmptySl = nil
+	} else if fnum, _, _, err := amino.DecodeFieldNumberAndTyp3(bz); err != nil {
+		return err
+	} else if fnum <= 20 {
+		var list []EmptyStruct
+		for len(bz) > 0 {
+			fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz)
+			if fnum > 20 {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			if fnum < 20 {
+				return fmt.Errorf("expected repeated field number 20 or greater, got %v", fnum)
+			}
+			if typ != amino.Typ3ByteLength {
+				return fmt.Errorf("expected repeated field type %v, got %v", amino.Typ3ByteLength, typ)
+			}
+			if len(bz) > 0 && bz[0] == 0x00 {
+				bz = bz[1:]
+				list = append(list, EmptyStruct{})
+				continue
+			}
+			v, n, err := amino.DecodeByteSlice(bz)
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			var e EmptyStruct
+			if err := e.UnmarshalBinary2(cdc, v); err != nil {
+				return err
+			}
+			list = append(list, e)
+		}
+		goo.EmptySl = list
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo SlicesSlicesStruct) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Int8SlSl (#1)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 0); err != nil {
+		return
+	}
+	// Field Int16SlSl (#2)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 1); err != nil {
+		return
+	}
+	// Field Int32SlSl (#3)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 2); err != nil {
+		return
+	}
+	// Field Int32FixedSlSl (#4)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 3); err != nil {
+		return
+	}
+	// Field Int64SlSl (#5)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 4); err != nil {
+		return
+	}
+	// Field Int64FixedSlSl (#6)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 5); err != nil {
+		return
+	}
+	// Field IntSlSl (#7)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 6); err != nil {
+		return
+	}
+	// Field ByteSlSl (#8)
+	for _, e := range goo.ByteSlSl {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 8, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, e); err != nil {
+			return
+		}
+	}
+	// Field Uint8SlSl (#9)
+	for _, e := range goo.Uint8SlSl {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 9, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, e); err != nil {
+			return
+		}
+	}
+	// Field Uint16SlSl (#10)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 9); err != nil {
+		return
+	}
+	// Field Uint32SlSl (#11)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 10); err != nil {
+		return
+	}
+	// Field Uint32FixedSlSl (#12)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 11); err != nil {
+		return
+	}
+	// Field Uint64SlSl (#13)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 12); err != nil {
+		return
+	}
+	// Field Uint64FixedSlSl (#14)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 13); err != nil {
+		return
+	}
+	// Field UintSlSl (#15)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 14); err != nil {
+		return
+	}
+	// Field StrSlSl (#16)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 15); err != nil {
+		return
+	}
+	// Field BytesSlSl (#17)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 16); err != nil {
+		return
+	}
+	// Field TimeSlSl (#18)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 17); err != nil {
+		return
+	}
+	// Field DurationSlSl (#19)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 18); err != nil {
+		return
+	}
+	// Field EmptySlSl (#20)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 19); err != nil {
+		return
+	}
+	return nil
+}
+
+func (goo *SlicesSlicesStruct) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Int8SlSl (#1)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 0, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Int16SlSl (#2)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 1, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Int32SlSl (#3)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 2, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Int32FixedSlSl (#4)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 3, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Int64SlSl (#5)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 4, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Int64FixedSlSl (#6)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 5, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field IntSlSl (#7)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 6, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field ByteSlSl (#8)
+	if len(bz) == 0 {
+		goo.ByteSlSl = nil
+	} else if fnum, _, _, err := amino.DecodeFieldNumberAndTyp3(bz); err != nil {
+		return err
+	} else if fnum <= 8 {
+		var list [][]uint8
+		for len(bz) > 0 {
+			fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz)
+			if fnum > 8 {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			if fnum < 8 {
+				return fmt.Errorf("expected repeated field number 8 or greater, got %v", fnum)
+			}
+			if typ != amino.Typ3ByteLength {
+				return fmt.Errorf("expected repeated field type %v, got %v", amino.Typ3ByteLength, typ)
+			}
+			if len(bz) > 0 && bz[0] == 0x00 {
+				bz = bz[1:]
+				list = append(list, nil)
+				continue
+			}
+			var e []uint8
+			if len(bz) > 0 {
+				v, n, err := amino.DecodeByteSlice(bz)
+				if err != nil {
+					return err
+				}
+				bz = bz[n:]
+				if len(v) > 0 {
+					e = []uint8(v)
+				}
+			}
+			list = append(list, e)
+		}
+		goo.ByteSlSl = list
+	}
+	// Field Uint8SlSl (#9)
+	if len(bz) == 0 {
+		goo.Uint8SlSl = nil
+	} else if fnum, _, _, err := amino.DecodeFieldNumberAndTyp3(bz); err != nil {
+		return err
+	} else if fnum <= 9 {
+		var list [][]uint8
+		for len(bz) > 0 {
+			fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz)
+			if fnum > 9 {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			if fnum < 9 {
+				return fmt.Errorf("expected repeated field number 9 or greater, got %v", fnum)
+			}
+			if typ != amino.Typ3ByteLength {
+				return fmt.Errorf("expected repeated field type %v, got %v", amino.Typ3ByteLength, typ)
+			}
+			if len(bz) > 0 && bz[0] == 0x00 {
+				bz = bz[1:]
+				list = append(list, nil)
+				continue
+			}
+			var e []uint8
+			if len(bz) > 0 {
+				v, n, err := amino.DecodeByteSlice(bz)
+				if err != nil {
+					return err
+				}
+				bz = bz[n:]
+				if len(v) > 0 {
+					e = []uint8(v)
+				}
+			}
+			list = append(list, e)
+		}
+		goo.Uint8SlSl = list
+	}
+	// Field Uint16SlSl (#10)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 9, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Uint32SlSl (#11)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 10, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Uint32FixedSlSl (#12)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 11, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Uint64SlSl (#13)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 12, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Uint64FixedSlSl (#14)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 13, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field UintSlSl (#15)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 14, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field StrSlSl (#16)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 15, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field BytesSlSl (#17)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 16, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field TimeSlSl (#18)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 17, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field DurationSlSl (#19)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 18, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field EmptySlSl (#20)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 19, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo PointersStruct) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Int8Pt (#1)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 0); err != nil {
+		return
+	}
+	// Field Int16Pt (#2)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 1); err != nil {
+		return
+	}
+	// Field Int32Pt (#3)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 2); err != nil {
+		return
+	}
+	// Field Int32FixedPt (#4)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 3); err != nil {
+		return
+	}
+	// Field Int64Pt (#5)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 4); err != nil {
+		return
+	}
+	// Field Int64FixedPt (#6)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 5); err != nil {
+		return
+	}
+	// Field IntPt (#7)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 6); err != nil {
+		return
+	}
+	// Field BytePt (#8)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 7); err != nil {
+		return
+	}
+	// Field Uint8Pt (#9)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 8); err != nil {
+		return
+	}
+	// Field Uint16Pt (#10)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 9); err != nil {
+		return
+	}
+	// Field Uint32Pt (#11)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 10); err != nil {
+		return
+	}
+	// Field Uint32FixedPt (#12)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 11); err != nil {
+		return
+	}
+	// Field Uint64Pt (#13)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 12); err != nil {
+		return
+	}
+	// Field Uint64FixedPt (#14)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 13); err != nil {
+		return
+	}
+	// Field UintPt (#15)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 14); err != nil {
+		return
+	}
+	// Field StrPt (#16)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 15); err != nil {
+		return
+	}
+	// Field BytesPt (#17)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 16); err != nil {
+		return
+	}
+	// Field TimePt (#18)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 17); err != nil {
+		return
+	}
+	// Field DurationPt (#19)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 18); err != nil {
+		return
+	}
+	// Field EmptyPt (#20)
+	if goo.EmptyPt != nil {
+		buf2 := new(bytes.Buffer)
+		if err = goo.EmptyPt.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 20, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *PointersStruct) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Int8Pt (#1)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 0, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Int16Pt (#2)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 1, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Int32Pt (#3)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 2, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Int32FixedPt (#4)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 3, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Int64Pt (#5)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 4, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Int64FixedPt (#6)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 5, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field IntPt (#7)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 6, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field BytePt (#8)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 7, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Uint8Pt (#9)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 8, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Uint16Pt (#10)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 9, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Uint32Pt (#11)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 10, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Uint32FixedPt (#12)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 11, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Uint64Pt (#13)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 12, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Uint64FixedPt (#14)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 13, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field UintPt (#15)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 14, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field StrPt (#16)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 15, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field BytesPt (#17)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 16, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field TimePt (#18)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 17, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field DurationPt (#19)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 18, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field EmptyPt (#20)
+	if len(bz) == 0 {
+		goo.EmptyPt = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 20 {
+		goo.EmptyPt = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 20 {
+			return fmt.Errorf("expected field # 20 of tests.PointersStruct, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 20 of tests.PointersStruct, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if goo.EmptyPt == nil {
+			goo.EmptyPt = new(EmptyStruct)
+		}
+		if err := goo.EmptyPt.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo PointerSlicesStruct) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Int8PtSl (#1)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 0); err != nil {
+		return
+	}
+	// Field Int16PtSl (#2)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 1); err != nil {
+		return
+	}
+	// Field Int32PtSl (#3)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 2); err != nil {
+		return
+	}
+	// Field Int32FixedPtSl (#4)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 3); err != nil {
+		return
+	}
+	// Field Int64PtSl (#5)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 4); err != nil {
+		return
+	}
+	// Field Int64FixedPtSl (#6)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 5); err != nil {
+		return
+	}
+	// Field IntPtSl (#7)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 6); err != nil {
+		return
+	}
+	// Field BytePtSl (#8)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 7); err != nil {
+		return
+	}
+	// Field Uint8PtSl (#9)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 8); err != nil {
+		return
+	}
+	// Field Uint16PtSl (#10)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 9); err != nil {
+		return
+	}
+	// Field Uint32PtSl (#11)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 10); err != nil {
+		return
+	}
+	// Field Uint32FixedPtSl (#12)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 11); err != nil {
+		return
+	}
+	// Field Uint64PtSl (#13)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 12); err != nil {
+		return
+	}
+	// Field Uint64FixedPtSl (#14)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 13); err != nil {
+		return
+	}
+	// Field UintPtSl (#15)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 14); err != nil {
+		return
+	}
+	// Field StrPtSl (#16)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 15); err != nil {
+		return
+	}
+	// Field BytesPtSl (#17)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 16); err != nil {
+		return
+	}
+	// Field TimePtSl (#18)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 17); err != nil {
+		return
+	}
+	// Field DurationPtSl (#19)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 18); err != nil {
+		return
+	}
+	// Field EmptyPtSl (#20)
+	for _, e := range goo.EmptyPtSl {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 20, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if e == nil {
+			return errors.New("nil struct pointers in lists not supported unless nil_elements field tag is also set")
+		}
+		buf2 := new(bytes.Buffer)
+		if err = e.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *PointerSlicesStruct) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Int8PtSl (#1)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 0, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Int16PtSl (#2)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 1, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Int32PtSl (#3)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 2, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Int32FixedPtSl (#4)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 3, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Int64PtSl (#5)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 4, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Int64FixedPtSl (#6)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 5, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field IntPtSl (#7)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 6, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field BytePtSl (#8)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 7, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Uint8PtSl (#9)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 8, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Uint16PtSl (#10)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 9, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Uint32PtSl (#11)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 10, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Uint32FixedPtSl (#12)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 11, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Uint64PtSl (#13)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 12, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Uint64FixedPtSl (#14)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 13, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field UintPtSl (#15)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 14, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field StrPtSl (#16)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 15, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field BytesPtSl (#17)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 16, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field TimePtSl (#18)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 17, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field DurationPtSl (#19)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 18, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field EmptyPtSl (#20)
+	if len(bz) == 0 {
+		goo.EmptyPtSl = nil
+	} else if fnum, _, _, err := amino.DecodeFieldNumberAndTyp3(bz); err != nil {
+		return err
+	} else if fnum <= 20 {
+		var list []*EmptyStruct
+		for len(bz) > 0 {
+			fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz)
+			if fnum > 20 {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			if fnum < 20 {
+				return fmt.Errorf("expected repeated field number 20 or greater, got %v", fnum)
+			}
+			if typ != amino.Typ3ByteLength {
+				return fmt.Errorf("expected repeated field type %v, got %v", amino.Typ3ByteLength, typ)
+			}
+			v, n, err := amino.DecodeByteSlice(bz)
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			e := new(EmptyStruct)
+			if err := e.UnmarshalBinary2(cdc, v); err != nil {
+				return err
+			}
+			list = append(list, e)
+		}
+		goo.EmptyPtSl = list
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo ComplexSt) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field PrField (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.PrField.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field ArField (#2)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.ArField.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field SlField (#3)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.SlField.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field PtField (#4)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.PtField.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 4, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	return nil
+}
+
+func (goo *ComplexSt) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field PrField (#1)
+	if len(bz) == 0 {
+		goo.PrField = PrimitivesStruct{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.PrField = PrimitivesStruct{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of tests.ComplexSt, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of tests.ComplexSt, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.PrField.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field ArField (#2)
+	if len(bz) == 0 {
+		goo.ArField = ArraysStruct{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.ArField = ArraysStruct{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of tests.ComplexSt, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of tests.ComplexSt, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.ArField.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field SlField (#3)
+	if len(bz) == 0 {
+		goo.SlField = SlicesStruct{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.SlField = SlicesStruct{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of tests.ComplexSt, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 3 of tests.ComplexSt, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.SlField.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field PtField (#4)
+	if len(bz) == 0 {
+		goo.PtField = PointersStruct{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 4 {
+		goo.PtField = PointersStruct{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 4 {
+			return fmt.Errorf("expected field # 4 of tests.ComplexSt, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 4 of tests.ComplexSt, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.PtField.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo EmbeddedSt1) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field PrimitivesStruct (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.PrimitivesStruct.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	return nil
+}
+
+func (goo *EmbeddedSt1) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field PrimitivesStruct (#1)
+	if len(bz) == 0 {
+		goo.PrimitivesStruct = PrimitivesStruct{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.PrimitivesStruct = PrimitivesStruct{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of tests.EmbeddedSt1, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of tests.EmbeddedSt1, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.PrimitivesStruct.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo EmbeddedSt2) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field PrimitivesStruct (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.PrimitivesStruct.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field ArraysStruct (#2)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.ArraysStruct.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field SlicesStruct (#3)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.SlicesStruct.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field PointersStruct (#4)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.PointersStruct.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 4, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	return nil
+}
+
+func (goo *EmbeddedSt2) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field PrimitivesStruct (#1)
+	if len(bz) == 0 {
+		goo.PrimitivesStruct = PrimitivesStruct{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.PrimitivesStruct = PrimitivesStruct{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of tests.EmbeddedSt2, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of tests.EmbeddedSt2, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.PrimitivesStruct.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field ArraysStruct (#2)
+	if len(bz) == 0 {
+		goo.ArraysStruct = ArraysStruct{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.ArraysStruct = ArraysStruct{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of tests.EmbeddedSt2, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of tests.EmbeddedSt2, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.ArraysStruct.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field SlicesStruct (#3)
+	if len(bz) == 0 {
+		goo.SlicesStruct = SlicesStruct{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.SlicesStruct = SlicesStruct{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of tests.EmbeddedSt2, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 3 of tests.EmbeddedSt2, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.SlicesStruct.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field PointersStruct (#4)
+	if len(bz) == 0 {
+		goo.PointersStruct = PointersStruct{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 4 {
+		goo.PointersStruct = PointersStruct{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 4 {
+			return fmt.Errorf("expected field # 4 of tests.EmbeddedSt2, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 4 of tests.EmbeddedSt2, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.PointersStruct.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo EmbeddedSt3) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field PrimitivesStruct (#1)
+	if goo.PrimitivesStruct != nil {
+		buf2 := new(bytes.Buffer)
+		if err = goo.PrimitivesStruct.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	// Field ArraysStruct (#2)
+	if goo.ArraysStruct != nil {
+		buf2 := new(bytes.Buffer)
+		if err = goo.ArraysStruct.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	// Field SlicesStruct (#3)
+	if goo.SlicesStruct != nil {
+		buf2 := new(bytes.Buffer)
+		if err = goo.SlicesStruct.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	// Field PointersStruct (#4)
+	if goo.PointersStruct != nil {
+		buf2 := new(bytes.Buffer)
+		if err = goo.PointersStruct.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 4, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	// Field EmptyStruct (#5)
+	if goo.EmptyStruct != nil {
+		buf2 := new(bytes.Buffer)
+		if err = goo.EmptyStruct.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 5, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *EmbeddedSt3) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field PrimitivesStruct (#1)
+	if len(bz) == 0 {
+		goo.PrimitivesStruct = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.PrimitivesStruct = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of tests.EmbeddedSt3, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of tests.EmbeddedSt3, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if goo.PrimitivesStruct == nil {
+			goo.PrimitivesStruct = new(PrimitivesStruct)
+		}
+		if err := goo.PrimitivesStruct.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field ArraysStruct (#2)
+	if len(bz) == 0 {
+		goo.ArraysStruct = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.ArraysStruct = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of tests.EmbeddedSt3, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of tests.EmbeddedSt3, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if goo.ArraysStruct == nil {
+			goo.ArraysStruct = new(ArraysStruct)
+		}
+		if err := goo.ArraysStruct.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field SlicesStruct (#3)
+	if len(bz) == 0 {
+		goo.SlicesStruct = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.SlicesStruct = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of tests.EmbeddedSt3, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 3 of tests.EmbeddedSt3, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if goo.SlicesStruct == nil {
+			goo.SlicesStruct = new(SlicesStruct)
+		}
+		if err := goo.SlicesStruct.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field PointersStruct (#4)
+	if len(bz) == 0 {
+		goo.PointersStruct = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 4 {
+		goo.PointersStruct = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 4 {
+			return fmt.Errorf("expected field # 4 of tests.EmbeddedSt3, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 4 of tests.EmbeddedSt3, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if goo.PointersStruct == nil {
+			goo.PointersStruct = new(PointersStruct)
+		}
+		if err := goo.PointersStruct.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field EmptyStruct (#5)
+	if len(bz) == 0 {
+		goo.EmptyStruct = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 5 {
+		goo.EmptyStruct = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 5 {
+			return fmt.Errorf("expected field # 5 of tests.EmbeddedSt3, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 5 of tests.EmbeddedSt3, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if goo.EmptyStruct == nil {
+			goo.EmptyStruct = new(EmptyStruct)
+		}
+		if err := goo.EmptyStruct.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo EmbeddedSt4) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field Foo1 (#1)
+	if goo.Foo1 != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Foo1)); err != nil {
+			return
+		}
+	}
+	// Field PrimitivesStruct (#2)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.PrimitivesStruct.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Foo2 (#3)
+	if goo.Foo2 != "" {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(goo.Foo2)); err != nil {
+			return
+		}
+	}
+	// Field ArraysStructField (#4)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.ArraysStructField.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 4, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Foo3 (#5)
+	if len(goo.Foo3) != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 5, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, goo.Foo3); err != nil {
+			return
+		}
+	}
+	// Field SlicesStruct (#6)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.SlicesStruct.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 6, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Foo4 (#7)
+	if goo.Foo4 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 7, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeBool(buf, bool(goo.Foo4)); err != nil {
+			return
+		}
+	}
+	// Field PointersStructField (#8)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.PointersStructField.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 8, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Foo5 (#9)
+	if goo.Foo5 != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 9, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeUvarint(buf, uint64(goo.Foo5)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *EmbeddedSt4) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field Foo1 (#1)
+	if len(bz) == 0 {
+		goo.Foo1 = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Foo1 = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of tests.EmbeddedSt4, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 1 of tests.EmbeddedSt4, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Foo1 = int(v)
+	}
+	// Field PrimitivesStruct (#2)
+	if len(bz) == 0 {
+		goo.PrimitivesStruct = PrimitivesStruct{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.PrimitivesStruct = PrimitivesStruct{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of tests.EmbeddedSt4, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of tests.EmbeddedSt4, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.PrimitivesStruct.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Foo2 (#3)
+	if len(bz) == 0 {
+		goo.Foo2 = ""
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.Foo2 = ""
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of tests.EmbeddedSt4, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 3 of tests.EmbeddedSt4, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Foo2 = string(v)
+	}
+	// Field ArraysStructField (#4)
+	if len(bz) == 0 {
+		goo.ArraysStructField = ArraysStruct{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 4 {
+		goo.ArraysStructField = ArraysStruct{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 4 {
+			return fmt.Errorf("expected field # 4 of tests.EmbeddedSt4, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 4 of tests.EmbeddedSt4, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.ArraysStructField.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Foo3 (#5)
+	if len(bz) == 0 {
+		goo.Foo3 = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 5 {
+		goo.Foo3 = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 5 {
+			return fmt.Errorf("expected field # 5 of tests.EmbeddedSt4, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 5 of tests.EmbeddedSt4, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if len(v) == 0 {
+			goo.Foo3 = nil
+		} else {
+			goo.Foo3 = []uint8(v)
+		}
+	}
+	// Field SlicesStruct (#6)
+	if len(bz) == 0 {
+		goo.SlicesStruct = SlicesStruct{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 6 {
+		goo.SlicesStruct = SlicesStruct{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 6 {
+			return fmt.Errorf("expected field # 6 of tests.EmbeddedSt4, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 6 of tests.EmbeddedSt4, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.SlicesStruct.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Foo4 (#7)
+	if len(bz) == 0 {
+		goo.Foo4 = false
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 7 {
+		goo.Foo4 = false
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 7 {
+			return fmt.Errorf("expected field # 7 of tests.EmbeddedSt4, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 7 of tests.EmbeddedSt4, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeBool(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Foo4 = bool(v)
+	}
+	// Field PointersStructField (#8)
+	if len(bz) == 0 {
+		goo.PointersStructField = PointersStruct{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 8 {
+		goo.PointersStructField = PointersStruct{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 8 {
+			return fmt.Errorf("expected field # 8 of tests.EmbeddedSt4, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 8 of tests.EmbeddedSt4, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.PointersStructField.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Foo5 (#9)
+	if len(bz) == 0 {
+		goo.Foo5 = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 9 {
+		goo.Foo5 = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 9 {
+			return fmt.Errorf("expected field # 9 of tests.EmbeddedSt4, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 9 of tests.EmbeddedSt4, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeUvarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Foo5 = uint(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo EmbeddedSt5) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field Foo1 (#1)
+	if goo.Foo1 != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Foo1)); err != nil {
+			return
+		}
+	}
+	// Field PrimitivesStruct (#2)
+	if goo.PrimitivesStruct != nil {
+		buf2 := new(bytes.Buffer)
+		if err = goo.PrimitivesStruct.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	// Field Foo2 (#3)
+	if goo.Foo2 != "" {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(goo.Foo2)); err != nil {
+			return
+		}
+	}
+	// Field ArraysStructField (#4)
+	if goo.ArraysStructField != nil {
+		buf2 := new(bytes.Buffer)
+		if err = goo.ArraysStructField.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 4, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	// Field Foo3 (#5)
+	if len(goo.Foo3) != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 5, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, goo.Foo3); err != nil {
+			return
+		}
+	}
+	// Field SlicesStruct (#6)
+	if goo.SlicesStruct != nil {
+		buf2 := new(bytes.Buffer)
+		if err = goo.SlicesStruct.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 6, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	// Field Foo4 (#7)
+	if goo.Foo4 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 7, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeBool(buf, bool(goo.Foo4)); err != nil {
+			return
+		}
+	}
+	// Field PointersStructField (#8)
+	if goo.PointersStructField != nil {
+		buf2 := new(bytes.Buffer)
+		if err = goo.PointersStructField.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 8, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	// Field Foo5 (#9)
+	if goo.Foo5 != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 9, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeUvarint(buf, uint64(goo.Foo5)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *EmbeddedSt5) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field Foo1 (#1)
+	if len(bz) == 0 {
+		goo.Foo1 = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Foo1 = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of tests.EmbeddedSt5, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 1 of tests.EmbeddedSt5, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Foo1 = int(v)
+	}
+	// Field PrimitivesStruct (#2)
+	if len(bz) == 0 {
+		goo.PrimitivesStruct = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.PrimitivesStruct = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of tests.EmbeddedSt5, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of tests.EmbeddedSt5, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if goo.PrimitivesStruct == nil {
+			goo.PrimitivesStruct = new(PrimitivesStruct)
+		}
+		if err := goo.PrimitivesStruct.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Foo2 (#3)
+	if len(bz) == 0 {
+		goo.Foo2 = ""
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.Foo2 = ""
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of tests.EmbeddedSt5, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 3 of tests.EmbeddedSt5, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Foo2 = string(v)
+	}
+	// Field ArraysStructField (#4)
+	if len(bz) == 0 {
+		goo.ArraysStructField = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 4 {
+		goo.ArraysStructField = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 4 {
+			return fmt.Errorf("expected field # 4 of tests.EmbeddedSt5, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 4 of tests.EmbeddedSt5, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if goo.ArraysStructField == nil {
+			goo.ArraysStructField = new(ArraysStruct)
+		}
+		if err := goo.ArraysStructField.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Foo3 (#5)
+	if len(bz) == 0 {
+		goo.Foo3 = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 5 {
+		goo.Foo3 = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 5 {
+			return fmt.Errorf("expected field # 5 of tests.EmbeddedSt5, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 5 of tests.EmbeddedSt5, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if len(v) == 0 {
+			goo.Foo3 = nil
+		} else {
+			goo.Foo3 = []uint8(v)
+		}
+	}
+	// Field SlicesStruct (#6)
+	if len(bz) == 0 {
+		goo.SlicesStruct = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 6 {
+		goo.SlicesStruct = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 6 {
+			return fmt.Errorf("expected field # 6 of tests.EmbeddedSt5, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 6 of tests.EmbeddedSt5, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if goo.SlicesStruct == nil {
+			goo.SlicesStruct = new(SlicesStruct)
+		}
+		if err := goo.SlicesStruct.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Foo4 (#7)
+	if len(bz) == 0 {
+		goo.Foo4 = false
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 7 {
+		goo.Foo4 = false
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 7 {
+			return fmt.Errorf("expected field # 7 of tests.EmbeddedSt5, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 7 of tests.EmbeddedSt5, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeBool(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Foo4 = bool(v)
+	}
+	// Field PointersStructField (#8)
+	if len(bz) == 0 {
+		goo.PointersStructField = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 8 {
+		goo.PointersStructField = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 8 {
+			return fmt.Errorf("expected field # 8 of tests.EmbeddedSt5, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 8 of tests.EmbeddedSt5, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if goo.PointersStructField == nil {
+			goo.PointersStructField = new(PointersStruct)
+		}
+		if err := goo.PointersStructField.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Foo5 (#9)
+	if len(bz) == 0 {
+		goo.Foo5 = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 9 {
+		goo.Foo5 = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 9 {
+			return fmt.Errorf("expected field # 9 of tests.EmbeddedSt5, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 9 of tests.EmbeddedSt5, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeUvarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Foo5 = uint(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo ReprStruct1) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field C (#1)
+	if goo.C != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.C)); err != nil {
+			return
+		}
+	}
+	// Field D (#2)
+	if goo.D != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.D)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *ReprStruct1) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field C (#1)
+	if len(bz) == 0 {
+		goo.C = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.C = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of tests.ReprStruct1, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 1 of tests.ReprStruct1, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.C = int64(v)
+	}
+	// Field D (#2)
+	if len(bz) == 0 {
+		goo.D = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.D = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of tests.ReprStruct1, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 2 of tests.ReprStruct1, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.D = int64(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo ReprElem2) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Key (#1)
+	if goo.Key != "" {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(goo.Key)); err != nil {
+			return
+		}
+	}
+	// Field Value (#2)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 1); err != nil {
+		return
+	}
+	return nil
+}
+
+func (goo *ReprElem2) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Key (#1)
+	if len(bz) == 0 {
+		goo.Key = ""
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Key = ""
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of tests.ReprElem2, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of tests.ReprElem2, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Key = string(v)
+	}
+	// Field Value (#2)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 1, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo PrimitivesStructDef) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Int8 (#1)
+	if goo.Int8 != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Int8)); err != nil {
+			return
+		}
+	}
+	// Field Int16 (#2)
+	if goo.Int16 != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Int16)); err != nil {
+			return
+		}
+	}
+	// Field Int32 (#3)
+	if goo.Int32 != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Int32)); err != nil {
+			return
+		}
+	}
+	// Field Int32Fixed (#4)
+	if goo.Int32Fixed != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 4, amino.Typ34Byte); err != nil {
+			return
+		}
+		if err = amino.EncodeInt32(buf, int32(goo.Int32Fixed)); err != nil {
+			return
+		}
+	}
+	// Field Int64 (#5)
+	if goo.Int64 != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 5, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Int64)); err != nil {
+			return
+		}
+	}
+	// Field Int64Fixed (#6)
+	if goo.Int64Fixed != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 6, amino.Typ38Byte); err != nil {
+			return
+		}
+		if err = amino.EncodeInt64(buf, int64(goo.Int64Fixed)); err != nil {
+			return
+		}
+	}
+	// Field Int (#7)
+	if goo.Int != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 7, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Int)); err != nil {
+			return
+		}
+	}
+	// Field Byte (#8)
+	if goo.Byte != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 8, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeUvarint(buf, uint64(goo.Byte)); err != nil {
+			return
+		}
+	}
+	// Field Uint8 (#9)
+	if goo.Uint8 != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 9, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeUvarint(buf, uint64(goo.Uint8)); err != nil {
+			return
+		}
+	}
+	// Field Uint16 (#10)
+	if goo.Uint16 != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 10, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeUvarint(buf, uint64(goo.Uint16)); err != nil {
+			return
+		}
+	}
+	// Field Uint32 (#11)
+	if goo.Uint32 != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 11, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeUvarint(buf, uint64(goo.Uint32)); err != nil {
+			return
+		}
+	}
+	// Field Uint32Fixed (#12)
+	if goo.Uint32Fixed != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 12, amino.Typ34Byte); err != nil {
+			return
+		}
+		if err = amino.EncodeUint32(buf, uint32(goo.Uint32Fixed)); err != nil {
+			return
+		}
+	}
+	// Field Uint64 (#13)
+	if goo.Uint64 != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 13, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeUvarint(buf, uint64(goo.Uint64)); err != nil {
+			return
+		}
+	}
+	// Field Uint64Fixed (#14)
+	if goo.Uint64Fixed != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 14, amino.Typ38Byte); err != nil {
+			return
+		}
+		if err = amino.EncodeUint64(buf, uint64(goo.Uint64Fixed)); err != nil {
+			return
+		}
+	}
+	// Field Uint (#15)
+	if goo.Uint != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 15, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeUvarint(buf, uint64(goo.Uint)); err != nil {
+			return
+		}
+	}
+	// Field Str (#16)
+	if goo.Str != "" {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 16, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(goo.Str)); err != nil {
+			return
+		}
+	}
+	// Field Bytes (#17)
+	if len(goo.Bytes) != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 17, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, goo.Bytes); err != nil {
+			return
+		}
+	}
+	// Field Time (#18)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 17); err != nil {
+		return
+	}
+	// Field Duration (#19)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 18); err != nil {
+		return
+	}
+	// Field Empty (#20)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Empty.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 20, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	return nil
+}
+
+func (goo *PrimitivesStructDef) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Int8 (#1)
+	if len(bz) == 0 {
+		goo.Int8 = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Int8 = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of tests.PrimitivesStructDef, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 1 of tests.PrimitivesStructDef, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint8(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Int8 = int8(v)
+	}
+	// Field Int16 (#2)
+	if len(bz) == 0 {
+		goo.Int16 = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.Int16 = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of tests.PrimitivesStructDef, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 2 of tests.PrimitivesStructDef, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint16(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Int16 = int16(v)
+	}
+	// Field Int32 (#3)
+	if len(bz) == 0 {
+		goo.Int32 = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.Int32 = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of tests.PrimitivesStructDef, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 3 of tests.PrimitivesStructDef, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Int32 = int32(v)
+	}
+	// Field Int32Fixed (#4)
+	if len(bz) == 0 {
+		goo.Int32Fixed = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 4 {
+		goo.Int32Fixed = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 4 {
+			return fmt.Errorf("expected field # 4 of tests.PrimitivesStructDef, got %v", fnum)
+		}
+		if typ != amino.Typ34Byte {
+			return fmt.Errorf("expected field type %v for # 4 of tests.PrimitivesStructDef, got %v", amino.Typ34Byte, typ)
+		}
+		v, n, err := amino.DecodeInt32(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Int32Fixed = int32(v)
+	}
+	// Field Int64 (#5)
+	if len(bz) == 0 {
+		goo.Int64 = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 5 {
+		goo.Int64 = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 5 {
+			return fmt.Errorf("expected field # 5 of tests.PrimitivesStructDef, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 5 of tests.PrimitivesStructDef, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Int64 = int64(v)
+	}
+	// Field Int64Fixed (#6)
+	if len(bz) == 0 {
+		goo.Int64Fixed = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 6 {
+		goo.Int64Fixed = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 6 {
+			return fmt.Errorf("expected field # 6 of tests.PrimitivesStructDef, got %v", fnum)
+		}
+		if typ != amino.Typ38Byte {
+			return fmt.Errorf("expected field type %v for # 6 of tests.PrimitivesStructDef, got %v", amino.Typ38Byte, typ)
+		}
+		v, n, err := amino.DecodeInt64(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Int64Fixed = int64(v)
+	}
+	// Field Int (#7)
+	if len(bz) == 0 {
+		goo.Int = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 7 {
+		goo.Int = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 7 {
+			return fmt.Errorf("expected field # 7 of tests.PrimitivesStructDef, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 7 of tests.PrimitivesStructDef, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Int = int(v)
+	}
+	// Field Byte (#8)
+	if len(bz) == 0 {
+		goo.Byte = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 8 {
+		goo.Byte = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 8 {
+			return fmt.Errorf("expected field # 8 of tests.PrimitivesStructDef, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 8 of tests.PrimitivesStructDef, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeUvarint8(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Byte = uint8(v)
+	}
+	// Field Uint8 (#9)
+	if len(bz) == 0 {
+		goo.Uint8 = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 9 {
+		goo.Uint8 = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 9 {
+			return fmt.Errorf("expected field # 9 of tests.PrimitivesStructDef, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 9 of tests.PrimitivesStructDef, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeUvarint8(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Uint8 = uint8(v)
+	}
+	// Field Uint16 (#10)
+	if len(bz) == 0 {
+		goo.Uint16 = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 10 {
+		goo.Uint16 = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 10 {
+			return fmt.Errorf("expected field # 10 of tests.PrimitivesStructDef, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 10 of tests.PrimitivesStructDef, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeUvarint16(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Uint16 = uint16(v)
+	}
+	// Field Uint32 (#11)
+	if len(bz) == 0 {
+		goo.Uint32 = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 11 {
+		goo.Uint32 = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 11 {
+			return fmt.Errorf("expected field # 11 of tests.PrimitivesStructDef, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 11 of tests.PrimitivesStructDef, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeUvarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Uint32 = uint32(v)
+	}
+	// Field Uint32Fixed (#12)
+	if len(bz) == 0 {
+		goo.Uint32Fixed = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 12 {
+		goo.Uint32Fixed = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 12 {
+			return fmt.Errorf("expected field # 12 of tests.PrimitivesStructDef, got %v", fnum)
+		}
+		if typ != amino.Typ34Byte {
+			return fmt.Errorf("expected field type %v for # 12 of tests.PrimitivesStructDef, got %v", amino.Typ34Byte, typ)
+		}
+		v, n, err := amino.DecodeUint32(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Uint32Fixed = uint32(v)
+	}
+	// Field Uint64 (#13)
+	if len(bz) == 0 {
+		goo.Uint64 = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 13 {
+		goo.Uint64 = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 13 {
+			return fmt.Errorf("expected field # 13 of tests.PrimitivesStructDef, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 13 of tests.PrimitivesStructDef, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeUvarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Uint64 = uint64(v)
+	}
+	// Field Uint64Fixed (#14)
+	if len(bz) == 0 {
+		goo.Uint64Fixed = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 14 {
+		goo.Uint64Fixed = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 14 {
+			return fmt.Errorf("expected field # 14 of tests.PrimitivesStructDef, got %v", fnum)
+		}
+		if typ != amino.Typ38Byte {
+			return fmt.Errorf("expected field type %v for # 14 of tests.PrimitivesStructDef, got %v", amino.Typ38Byte, typ)
+		}
+		v, n, err := amino.DecodeUint64(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Uint64Fixed = uint64(v)
+	}
+	// Field Uint (#15)
+	if len(bz) == 0 {
+		goo.Uint = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 15 {
+		goo.Uint = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 15 {
+			return fmt.Errorf("expected field # 15 of tests.PrimitivesStructDef, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 15 of tests.PrimitivesStructDef, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeUvarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Uint = uint(v)
+	}
+	// Field Str (#16)
+	if len(bz) == 0 {
+		goo.Str = ""
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 16 {
+		goo.Str = ""
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 16 {
+			return fmt.Errorf("expected field # 16 of tests.PrimitivesStructDef, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 16 of tests.PrimitivesStructDef, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Str = string(v)
+	}
+	// Field Bytes (#17)
+	if len(bz) == 0 {
+		goo.Bytes = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 17 {
+		goo.Bytes = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 17 {
+			return fmt.Errorf("expected field # 17 of tests.PrimitivesStructDef, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 17 of tests.PrimitivesStructDef, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if len(v) == 0 {
+			goo.Bytes = nil
+		} else {
+			goo.Bytes = []uint8(v)
+		}
+	}
+	// Field Time (#18)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 17, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Duration (#19)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 18, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Empty (#20)
+	if len(bz) == 0 {
+		goo.Empty = EmptyStruct{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 20 {
+		goo.Empty = EmptyStruct{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 20 {
+			return fmt.Errorf("expected field # 20 of tests.PrimitivesStructDef, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 20 of tests.PrimitivesStructDef, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Empty.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo Concrete1) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	return nil
+}
+
+func (goo *Concrete1) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo Concrete2) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	return nil
+}
+
+func (goo *Concrete2) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo ConcreteWrappedBytes) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field Value (#1)
+	if len(goo.Value) != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, goo.Value); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *ConcreteWrappedBytes) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field Value (#1)
+	if len(bz) == 0 {
+		goo.Value = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Value = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of tests.ConcreteWrappedBytes, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of tests.ConcreteWrappedBytes, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if len(v) == 0 {
+			goo.Value = nil
+		} else {
+			goo.Value = []uint8(v)
+		}
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo InterfaceFieldsStruct) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field F1 (#1)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 0); err != nil {
+		return
+	}
+	// Field F2 (#2)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 1); err != nil {
+		return
+	}
+	// Field F3 (#3)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 2); err != nil {
+		return
+	}
+	// Field F4 (#4)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 3); err != nil {
+		return
+	}
+	return nil
+}
+
+func (goo *InterfaceFieldsStruct) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field F1 (#1)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 0, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field F2 (#2)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 1, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field F3 (#3)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 2, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field F4 (#4)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 3, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"github.com/gnolang/gno/tm2/pkg/amino/gengo"
 	"github.com/gnolang/gno/tm2/pkg/amino/genproto"
 	"github.com/gnolang/gno/tm2/pkg/amino/tests"
 )
@@ -11,4 +12,5 @@ func main() {
 	genproto.MakeProtoFolder(pkg, "proto")
 	genproto.RunProtoc(pkg, "proto")
 	genproto.WriteProtoBindings(pkg)
+	gengo.WriteBinary2(pkg)
 }
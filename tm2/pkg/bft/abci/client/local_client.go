@@ -131,6 +131,22 @@ func (app *localClient) EndBlockAsync(req abci.RequestEndBlock) *ReqRes {
 	return app.completeRequest(req, res)
 }
 
+func (app *localClient) PrepareProposalAsync(req abci.RequestPrepareProposal) *ReqRes {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+
+	res := app.Application.PrepareProposal(req)
+	return app.completeRequest(req, res)
+}
+
+func (app *localClient) ProcessProposalAsync(req abci.RequestProcessProposal) *ReqRes {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+
+	res := app.Application.ProcessProposal(req)
+	return app.completeRequest(req, res)
+}
+
 //-------------------------------------------------------
 
 func (app *localClient) FlushSync() error {
@@ -213,6 +229,22 @@ func (app *localClient) EndBlockSync(req abci.RequestEndBlock) (abci.ResponseEnd
 	return res, nil
 }
 
+func (app *localClient) PrepareProposalSync(req abci.RequestPrepareProposal) (abci.ResponsePrepareProposal, error) {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+
+	res := app.Application.PrepareProposal(req)
+	return res, nil
+}
+
+func (app *localClient) ProcessProposalSync(req abci.RequestProcessProposal) (abci.ResponseProcessProposal, error) {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+
+	res := app.Application.ProcessProposal(req)
+	return res, nil
+}
+
 //-------------------------------------------------------
 
 func (app *localClient) completeRequest(req abci.Request, res abci.Response) *ReqRes {
@@ -21,6 +21,10 @@ type Application interface {
 	EndBlock(RequestEndBlock) ResponseEndBlock       // Signals the end of a block, returns changes to the validator set
 	Commit() ResponseCommit                          // Commit the state and return the application Merkle root hash
 
+	// Proposals (Consensus Connection)
+	PrepareProposal(RequestPrepareProposal) ResponsePrepareProposal // Select the txs of a block to propose
+	ProcessProposal(RequestProcessProposal) ResponseProcessProposal // Accept or reject a proposed block
+
 	// Cleanup
 	Close() error
 }
@@ -72,6 +76,14 @@ func (BaseApplication) EndBlock(req RequestEndBlock) ResponseEndBlock {
 	return ResponseEndBlock{}
 }
 
+func (BaseApplication) PrepareProposal(req RequestPrepareProposal) ResponsePrepareProposal {
+	return ResponsePrepareProposal{Txs: req.Txs}
+}
+
+func (BaseApplication) ProcessProposal(req RequestProcessProposal) ResponseProcessProposal {
+	return ResponseProcessProposal{}
+}
+
 func (BaseApplication) Close() error {
 	return nil
 }
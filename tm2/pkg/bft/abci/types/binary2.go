@@ -0,0 +1,4298 @@
+// Code generated by gengo. DO NOT EDIT.
+
+package abci
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+
+	"github.com/gnolang/gno/tm2/pkg/amino"
+	"github.com/gnolang/gno/tm2/pkg/crypto"
+	"github.com/gnolang/gno/tm2/pkg/crypto/merkle"
+)
+
+func (goo RequestBase) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	return nil
+}
+
+func (goo *RequestBase) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo RequestEcho) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field RequestBase (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.RequestBase.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Message (#2)
+	if goo.Message != "" {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(goo.Message)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *RequestEcho) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field RequestBase (#1)
+	if len(bz) == 0 {
+		goo.RequestBase = RequestBase{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.RequestBase = RequestBase{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of abci.RequestEcho, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of abci.RequestEcho, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.RequestBase.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Message (#2)
+	if len(bz) == 0 {
+		goo.Message = ""
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.Message = ""
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of abci.RequestEcho, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of abci.RequestEcho, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Message = string(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo RequestFlush) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field RequestBase (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.RequestBase.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	return nil
+}
+
+func (goo *RequestFlush) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field RequestBase (#1)
+	if len(bz) == 0 {
+		goo.RequestBase = RequestBase{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.RequestBase = RequestBase{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of abci.RequestFlush, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of abci.RequestFlush, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.RequestBase.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo RequestInfo) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field RequestBase (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.RequestBase.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	return nil
+}
+
+func (goo *RequestInfo) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field RequestBase (#1)
+	if len(bz) == 0 {
+		goo.RequestBase = RequestBase{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.RequestBase = RequestBase{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of abci.RequestInfo, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of abci.RequestInfo, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.RequestBase.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo RequestSetOption) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field RequestBase (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.RequestBase.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Key (#2)
+	if goo.Key != "" {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(goo.Key)); err != nil {
+			return
+		}
+	}
+	// Field Value (#3)
+	if goo.Value != "" {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(goo.Value)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *RequestSetOption) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field RequestBase (#1)
+	if len(bz) == 0 {
+		goo.RequestBase = RequestBase{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.RequestBase = RequestBase{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of abci.RequestSetOption, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of abci.RequestSetOption, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.RequestBase.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Key (#2)
+	if len(bz) == 0 {
+		goo.Key = ""
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.Key = ""
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of abci.RequestSetOption, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of abci.RequestSetOption, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Key = string(v)
+	}
+	// Field Value (#3)
+	if len(bz) == 0 {
+		goo.Value = ""
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.Value = ""
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of abci.RequestSetOption, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 3 of abci.RequestSetOption, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Value = string(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo RequestInitChain) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field RequestBase (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.RequestBase.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Time (#2)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 1); err != nil {
+		return
+	}
+	// Field ChainID (#3)
+	if goo.ChainID != "" {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(goo.ChainID)); err != nil {
+			return
+		}
+	}
+	// Field ConsensusParams (#4)
+	if goo.ConsensusParams != nil {
+		buf2 := new(bytes.Buffer)
+		if err = goo.ConsensusParams.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 4, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	// Field Validators (#5)
+	for _, e := range goo.Validators {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 5, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		buf2 := new(bytes.Buffer)
+		if err = e.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	// Field AppState (#6)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 5); err != nil {
+		return
+	}
+	return nil
+}
+
+func (goo *RequestInitChain) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field RequestBase (#1)
+	if len(bz) == 0 {
+		goo.RequestBase = RequestBase{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.RequestBase = RequestBase{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of abci.RequestInitChain, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of abci.RequestInitChain, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.RequestBase.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Time (#2)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 1, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field ChainID (#3)
+	if len(bz) == 0 {
+		goo.ChainID = ""
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.ChainID = ""
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of abci.RequestInitChain, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 3 of abci.RequestInitChain, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.ChainID = string(v)
+	}
+	// Field ConsensusParams (#4)
+	if len(bz) == 0 {
+		goo.ConsensusParams = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 4 {
+		goo.ConsensusParams = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 4 {
+			return fmt.Errorf("expected field # 4 of abci.RequestInitChain, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 4 of abci.RequestInitChain, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if goo.ConsensusParams == nil {
+			goo.ConsensusParams = new(ConsensusParams)
+		}
+		if err := goo.ConsensusParams.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Validators (#5)
+	if len(bz) == 0 {
+		goo.Validators = nil
+	} else if fnum, _, _, err := amino.DecodeFieldNumberAndTyp3(bz); err != nil {
+		return err
+	} else if fnum <= 5 {
+		var list []ValidatorUpdate
+		for len(bz) > 0 {
+			fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz)
+			if fnum > 5 {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			if fnum < 5 {
+				return fmt.Errorf("expected repeated field number 5 or greater, got %v", fnum)
+			}
+			if typ != amino.Typ3ByteLength {
+				return fmt.Errorf("expected repeated field type %v, got %v", amino.Typ3ByteLength, typ)
+			}
+			if len(bz) > 0 && bz[0] == 0x00 {
+				bz = bz[1:]
+				list = append(list, ValidatorUpdate{})
+				continue
+			}
+			v, n, err := amino.DecodeByteSlice(bz)
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			var e ValidatorUpdate
+			if err := e.UnmarshalBinary2(cdc, v); err != nil {
+				return err
+			}
+			list = append(list, e)
+		}
+		goo.Validators = list
+	}
+	// Field AppState (#6)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 5, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo RequestQuery) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field RequestBase (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.RequestBase.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Data (#2)
+	if len(goo.Data) != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, goo.Data); err != nil {
+			return
+		}
+	}
+	// Field Path (#3)
+	if goo.Path != "" {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(goo.Path)); err != nil {
+			return
+		}
+	}
+	// Field Height (#4)
+	if goo.Height != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 4, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Height)); err != nil {
+			return
+		}
+	}
+	// Field Prove (#5)
+	if goo.Prove {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 5, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeBool(buf, bool(goo.Prove)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *RequestQuery) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field RequestBase (#1)
+	if len(bz) == 0 {
+		goo.RequestBase = RequestBase{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.RequestBase = RequestBase{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of abci.RequestQuery, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of abci.RequestQuery, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.RequestBase.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Data (#2)
+	if len(bz) == 0 {
+		goo.Data = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.Data = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of abci.RequestQuery, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of abci.RequestQuery, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if len(v) == 0 {
+			goo.Data = nil
+		} else {
+			goo.Data = []uint8(v)
+		}
+	}
+	// Field Path (#3)
+	if len(bz) == 0 {
+		goo.Path = ""
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.Path = ""
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of abci.RequestQuery, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 3 of abci.RequestQuery, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Path = string(v)
+	}
+	// Field Height (#4)
+	if len(bz) == 0 {
+		goo.Height = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 4 {
+		goo.Height = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 4 {
+			return fmt.Errorf("expected field # 4 of abci.RequestQuery, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 4 of abci.RequestQuery, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Height = int64(v)
+	}
+	// Field Prove (#5)
+	if len(bz) == 0 {
+		goo.Prove = false
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 5 {
+		goo.Prove = false
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 5 {
+			return fmt.Errorf("expected field # 5 of abci.RequestQuery, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 5 of abci.RequestQuery, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeBool(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Prove = bool(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo RequestBeginBlock) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field RequestBase (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.RequestBase.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Hash (#2)
+	if len(goo.Hash) != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, goo.Hash); err != nil {
+			return
+		}
+	}
+	// Field Header (#3)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 2); err != nil {
+		return
+	}
+	// Field LastCommitInfo (#4)
+	if goo.LastCommitInfo != nil {
+		buf2 := new(bytes.Buffer)
+		if err = goo.LastCommitInfo.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 4, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *RequestBeginBlock) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field RequestBase (#1)
+	if len(bz) == 0 {
+		goo.RequestBase = RequestBase{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.RequestBase = RequestBase{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of abci.RequestBeginBlock, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of abci.RequestBeginBlock, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.RequestBase.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Hash (#2)
+	if len(bz) == 0 {
+		goo.Hash = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.Hash = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of abci.RequestBeginBlock, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of abci.RequestBeginBlock, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if len(v) == 0 {
+			goo.Hash = nil
+		} else {
+			goo.Hash = []uint8(v)
+		}
+	}
+	// Field Header (#3)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 2, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field LastCommitInfo (#4)
+	if len(bz) == 0 {
+		goo.LastCommitInfo = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 4 {
+		goo.LastCommitInfo = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 4 {
+			return fmt.Errorf("expected field # 4 of abci.RequestBeginBlock, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 4 of abci.RequestBeginBlock, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if goo.LastCommitInfo == nil {
+			goo.LastCommitInfo = new(LastCommitInfo)
+		}
+		if err := goo.LastCommitInfo.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo RequestCheckTx) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field RequestBase (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.RequestBase.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Tx (#2)
+	if len(goo.Tx) != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, goo.Tx); err != nil {
+			return
+		}
+	}
+	// Field Type (#3)
+	if goo.Type != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Type)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *RequestCheckTx) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field RequestBase (#1)
+	if len(bz) == 0 {
+		goo.RequestBase = RequestBase{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.RequestBase = RequestBase{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of abci.RequestCheckTx, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of abci.RequestCheckTx, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.RequestBase.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Tx (#2)
+	if len(bz) == 0 {
+		goo.Tx = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.Tx = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of abci.RequestCheckTx, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of abci.RequestCheckTx, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if len(v) == 0 {
+			goo.Tx = nil
+		} else {
+			goo.Tx = []uint8(v)
+		}
+	}
+	// Field Type (#3)
+	if len(bz) == 0 {
+		goo.Type = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.Type = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of abci.RequestCheckTx, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 3 of abci.RequestCheckTx, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Type = CheckTxType(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo RequestDeliverTx) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field RequestBase (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.RequestBase.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Tx (#2)
+	if len(goo.Tx) != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, goo.Tx); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *RequestDeliverTx) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field RequestBase (#1)
+	if len(bz) == 0 {
+		goo.RequestBase = RequestBase{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.RequestBase = RequestBase{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of abci.RequestDeliverTx, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of abci.RequestDeliverTx, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.RequestBase.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Tx (#2)
+	if len(bz) == 0 {
+		goo.Tx = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.Tx = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of abci.RequestDeliverTx, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of abci.RequestDeliverTx, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if len(v) == 0 {
+			goo.Tx = nil
+		} else {
+			goo.Tx = []uint8(v)
+		}
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo RequestEndBlock) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field RequestBase (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.RequestBase.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Height (#2)
+	if goo.Height != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Height)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *RequestEndBlock) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field RequestBase (#1)
+	if len(bz) == 0 {
+		goo.RequestBase = RequestBase{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.RequestBase = RequestBase{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of abci.RequestEndBlock, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of abci.RequestEndBlock, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.RequestBase.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Height (#2)
+	if len(bz) == 0 {
+		goo.Height = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.Height = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of abci.RequestEndBlock, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 2 of abci.RequestEndBlock, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Height = int64(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo RequestCommit) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field RequestBase (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.RequestBase.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	return nil
+}
+
+func (goo *RequestCommit) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field RequestBase (#1)
+	if len(bz) == 0 {
+		goo.RequestBase = RequestBase{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.RequestBase = RequestBase{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of abci.RequestCommit, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of abci.RequestCommit, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.RequestBase.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo RequestPrepareProposal) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field RequestBase (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.RequestBase.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Height (#2)
+	if goo.Height != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Height)); err != nil {
+			return
+		}
+	}
+	// Field Time (#3)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 2); err != nil {
+		return
+	}
+	// Field ProposerAddress (#4)
+	{
+		var repr string
+		if repr, err = goo.ProposerAddress.MarshalAmino(); err != nil {
+			return
+		}
+		if repr != "" {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 4, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeString(buf, repr); err != nil {
+				return
+			}
+		}
+	}
+	// Field Txs (#5)
+	for _, e := range goo.Txs {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 5, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, e); err != nil {
+			return
+		}
+	}
+	// Field MaxTxBytes (#6)
+	if goo.MaxTxBytes != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 6, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.MaxTxBytes)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *RequestPrepareProposal) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field RequestBase (#1)
+	if len(bz) == 0 {
+		goo.RequestBase = RequestBase{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.RequestBase = RequestBase{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of abci.RequestPrepareProposal, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of abci.RequestPrepareProposal, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.RequestBase.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Height (#2)
+	if len(bz) == 0 {
+		goo.Height = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.Height = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of abci.RequestPrepareProposal, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 2 of abci.RequestPrepareProposal, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Height = int64(v)
+	}
+	// Field Time (#3)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 2, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field ProposerAddress (#4)
+	if len(bz) == 0 {
+		goo.ProposerAddress = crypto.Address{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 4 {
+		goo.ProposerAddress = crypto.Address{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 4 {
+			return fmt.Errorf("expected field # 4 of abci.RequestPrepareProposal, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 4 of abci.RequestPrepareProposal, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.ProposerAddress.UnmarshalAmino(v); err != nil {
+			return err
+		}
+	}
+	// Field Txs (#5)
+	if len(bz) == 0 {
+		goo.Txs = nil
+	} else if fnum, _, _, err := amino.DecodeFieldNumberAndTyp3(bz); err != nil {
+		return err
+	} else if fnum <= 5 {
+		var list [][]uint8
+		for len(bz) > 0 {
+			fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz)
+			if fnum > 5 {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			if fnum < 5 {
+				return fmt.Errorf("expected repeated field number 5 or greater, got %v", fnum)
+			}
+			if typ != amino.Typ3ByteLength {
+				return fmt.Errorf("expected repeated field type %v, got %v", amino.Typ3ByteLength, typ)
+			}
+			if len(bz) > 0 && bz[0] == 0x00 {
+				bz = bz[1:]
+				list = append(list, nil)
+				continue
+			}
+			var e []uint8
+			if len(bz) > 0 {
+				v, n, err := amino.DecodeByteSlice(bz)
+				if err != nil {
+					return err
+				}
+				bz = bz[n:]
+				if len(v) > 0 {
+					e = []uint8(v)
+				}
+			}
+			list = append(list, e)
+		}
+		goo.Txs = list
+	}
+	// Field MaxTxBytes (#6)
+	if len(bz) == 0 {
+		goo.MaxTxBytes = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 6 {
+		goo.MaxTxBytes = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 6 {
+			return fmt.Errorf("expected field # 6 of abci.RequestPrepareProposal, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 6 of abci.RequestPrepareProposal, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.MaxTxBytes = int64(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo RequestProcessProposal) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field RequestBase (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.RequestBase.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Hash (#2)
+	if len(goo.Hash) != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, goo.Hash); err != nil {
+			return
+		}
+	}
+	// Field Header (#3)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 2); err != nil {
+		return
+	}
+	// Field Txs (#4)
+	for _, e := range goo.Txs {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 4, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, e); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *RequestProcessProposal) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field RequestBase (#1)
+	if len(bz) == 0 {
+		goo.RequestBase = RequestBase{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.RequestBase = RequestBase{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of abci.RequestProcessProposal, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of abci.RequestProcessProposal, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.RequestBase.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Hash (#2)
+	if len(bz) == 0 {
+		goo.Hash = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.Hash = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of abci.RequestProcessProposal, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of abci.RequestProcessProposal, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if len(v) == 0 {
+			goo.Hash = nil
+		} else {
+			goo.Hash = []uint8(v)
+		}
+	}
+	// Field Header (#3)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 2, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Txs (#4)
+	if len(bz) == 0 {
+		goo.Txs = nil
+	} else if fnum, _, _, err := amino.DecodeFieldNumberAndTyp3(bz); err != nil {
+		return err
+	} else if fnum <= 4 {
+		var list [][]uint8
+		for len(bz) > 0 {
+			fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz)
+			if fnum > 4 {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			if fnum < 4 {
+				return fmt.Errorf("expected repeated field number 4 or greater, got %v", fnum)
+			}
+			if typ != amino.Typ3ByteLength {
+				return fmt.Errorf("expected repeated field type %v, got %v", amino.Typ3ByteLength, typ)
+			}
+			if len(bz) > 0 && bz[0] == 0x00 {
+				bz = bz[1:]
+				list = append(list, nil)
+				continue
+			}
+			var e []uint8
+			if len(bz) > 0 {
+				v, n, err := amino.DecodeByteSlice(bz)
+				if err != nil {
+					return err
+				}
+				bz = bz[n:]
+				if len(v) > 0 {
+					e = []uint8(v)
+				}
+			}
+			list = append(list, e)
+		}
+		goo.Txs = list
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo ResponseBase) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Error (#1)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 0); err != nil {
+		return
+	}
+	// Field Data (#2)
+	if len(goo.Data) != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, goo.Data); err != nil {
+			return
+		}
+	}
+	// Field Events (#3)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 2); err != nil {
+		return
+	}
+	// Field Log (#4)
+	if goo.Log != "" {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 4, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(goo.Log)); err != nil {
+			return
+		}
+	}
+	// Field Info (#5)
+	if goo.Info != "" {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 5, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(goo.Info)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *ResponseBase) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Error (#1)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 0, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Data (#2)
+	if len(bz) == 0 {
+		goo.Data = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.Data = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of abci.ResponseBase, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of abci.ResponseBase, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if len(v) == 0 {
+			goo.Data = nil
+		} else {
+			goo.Data = []uint8(v)
+		}
+	}
+	// Field Events (#3)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 2, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Log (#4)
+	if len(bz) == 0 {
+		goo.Log = ""
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 4 {
+		goo.Log = ""
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 4 {
+			return fmt.Errorf("expected field # 4 of abci.ResponseBase, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 4 of abci.ResponseBase, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Log = string(v)
+	}
+	// Field Info (#5)
+	if len(bz) == 0 {
+		goo.Info = ""
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 5 {
+		goo.Info = ""
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 5 {
+			return fmt.Errorf("expected field # 5 of abci.ResponseBase, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 5 of abci.ResponseBase, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Info = string(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo ResponseException) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field ResponseBase (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.ResponseBase.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	return nil
+}
+
+func (goo *ResponseException) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field ResponseBase (#1)
+	if len(bz) == 0 {
+		goo.ResponseBase = ResponseBase{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.ResponseBase = ResponseBase{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of abci.ResponseException, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of abci.ResponseException, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.ResponseBase.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo ResponseEcho) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field ResponseBase (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.ResponseBase.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Message (#2)
+	if goo.Message != "" {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(goo.Message)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *ResponseEcho) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field ResponseBase (#1)
+	if len(bz) == 0 {
+		goo.ResponseBase = ResponseBase{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.ResponseBase = ResponseBase{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of abci.ResponseEcho, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of abci.ResponseEcho, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.ResponseBase.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Message (#2)
+	if len(bz) == 0 {
+		goo.Message = ""
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.Message = ""
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of abci.ResponseEcho, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of abci.ResponseEcho, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Message = string(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo ResponseFlush) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field ResponseBase (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.ResponseBase.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	return nil
+}
+
+func (goo *ResponseFlush) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field ResponseBase (#1)
+	if len(bz) == 0 {
+		goo.ResponseBase = ResponseBase{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.ResponseBase = ResponseBase{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of abci.ResponseFlush, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of abci.ResponseFlush, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.ResponseBase.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo ResponseInfo) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field ResponseBase (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.ResponseBase.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field ABCIVersion (#2)
+	if goo.ABCIVersion != "" {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(goo.ABCIVersion)); err != nil {
+			return
+		}
+	}
+	// Field AppVersion (#3)
+	if goo.AppVersion != "" {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(goo.AppVersion)); err != nil {
+			return
+		}
+	}
+	// Field LastBlockHeight (#4)
+	if goo.LastBlockHeight != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 4, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.LastBlockHeight)); err != nil {
+			return
+		}
+	}
+	// Field LastBlockAppHash (#5)
+	if len(goo.LastBlockAppHash) != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 5, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, goo.LastBlockAppHash); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *ResponseInfo) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field ResponseBase (#1)
+	if len(bz) == 0 {
+		goo.ResponseBase = ResponseBase{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.ResponseBase = ResponseBase{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of abci.ResponseInfo, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of abci.ResponseInfo, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.ResponseBase.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field ABCIVersion (#2)
+	if len(bz) == 0 {
+		goo.ABCIVersion = ""
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.ABCIVersion = ""
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of abci.ResponseInfo, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of abci.ResponseInfo, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.ABCIVersion = string(v)
+	}
+	// Field AppVersion (#3)
+	if len(bz) == 0 {
+		goo.AppVersion = ""
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.AppVersion = ""
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of abci.ResponseInfo, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 3 of abci.ResponseInfo, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.AppVersion = string(v)
+	}
+	// Field LastBlockHeight (#4)
+	if len(bz) == 0 {
+		goo.LastBlockHeight = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 4 {
+		goo.LastBlockHeight = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 4 {
+			return fmt.Errorf("expected field # 4 of abci.ResponseInfo, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 4 of abci.ResponseInfo, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.LastBlockHeight = int64(v)
+	}
+	// Field LastBlockAppHash (#5)
+	if len(bz) == 0 {
+		goo.LastBlockAppHash = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 5 {
+		goo.LastBlockAppHash = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 5 {
+			return fmt.Errorf("expected field # 5 of abci.ResponseInfo, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 5 of abci.ResponseInfo, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if len(v) == 0 {
+			goo.LastBlockAppHash = nil
+		} else {
+			goo.LastBlockAppHash = []uint8(v)
+		}
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo ResponseSetOption) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field ResponseBase (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.ResponseBase.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	return nil
+}
+
+func (goo *ResponseSetOption) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field ResponseBase (#1)
+	if len(bz) == 0 {
+		goo.ResponseBase = ResponseBase{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.ResponseBase = ResponseBase{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of abci.ResponseSetOption, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of abci.ResponseSetOption, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.ResponseBase.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo ResponseInitChain) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field ResponseBase (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.ResponseBase.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field ConsensusParams (#2)
+	if goo.ConsensusParams != nil {
+		buf2 := new(bytes.Buffer)
+		if err = goo.ConsensusParams.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	// Field Validators (#3)
+	for _, e := range goo.Validators {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		buf2 := new(bytes.Buffer)
+		if err = e.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *ResponseInitChain) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field ResponseBase (#1)
+	if len(bz) == 0 {
+		goo.ResponseBase = ResponseBase{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.ResponseBase = ResponseBase{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of abci.ResponseInitChain, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of abci.ResponseInitChain, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.ResponseBase.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field ConsensusParams (#2)
+	if len(bz) == 0 {
+		goo.ConsensusParams = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.ConsensusParams = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of abci.ResponseInitChain, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of abci.ResponseInitChain, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if goo.ConsensusParams == nil {
+			goo.ConsensusParams = new(ConsensusParams)
+		}
+		if err := goo.ConsensusParams.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Validators (#3)
+	if len(bz) == 0 {
+		goo.Validators = nil
+	} else if fnum, _, _, err := amino.DecodeFieldNumberAndTyp3(bz); err != nil {
+		return err
+	} else if fnum <= 3 {
+		var list []ValidatorUpdate
+		for len(bz) > 0 {
+			fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz)
+			if fnum > 3 {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			if fnum < 3 {
+				return fmt.Errorf("expected repeated field number 3 or greater, got %v", fnum)
+			}
+			if typ != amino.Typ3ByteLength {
+				return fmt.Errorf("expected repeated field type %v, got %v", amino.Typ3ByteLength, typ)
+			}
+			if len(bz) > 0 && bz[0] == 0x00 {
+				bz = bz[1:]
+				list = append(list, ValidatorUpdate{})
+				continue
+			}
+			v, n, err := amino.DecodeByteSlice(bz)
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			var e ValidatorUpdate
+			if err := e.UnmarshalBinary2(cdc, v); err != nil {
+				return err
+			}
+			list = append(list, e)
+		}
+		goo.Validators = list
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo ResponseQuery) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field ResponseBase (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.ResponseBase.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Key (#2)
+	if len(goo.Key) != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, goo.Key); err != nil {
+			return
+		}
+	}
+	// Field Value (#3)
+	if len(goo.Value) != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, goo.Value); err != nil {
+			return
+		}
+	}
+	// Field Proof (#4)
+	if goo.Proof != nil {
+		buf2 := new(bytes.Buffer)
+		if err = goo.Proof.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 4, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	// Field Height (#5)
+	if goo.Height != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 5, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Height)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *ResponseQuery) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field ResponseBase (#1)
+	if len(bz) == 0 {
+		goo.ResponseBase = ResponseBase{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.ResponseBase = ResponseBase{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of abci.ResponseQuery, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of abci.ResponseQuery, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.ResponseBase.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Key (#2)
+	if len(bz) == 0 {
+		goo.Key = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.Key = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of abci.ResponseQuery, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of abci.ResponseQuery, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if len(v) == 0 {
+			goo.Key = nil
+		} else {
+			goo.Key = []uint8(v)
+		}
+	}
+	// Field Value (#3)
+	if len(bz) == 0 {
+		goo.Value = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.Value = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of abci.ResponseQuery, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 3 of abci.ResponseQuery, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if len(v) == 0 {
+			goo.Value = nil
+		} else {
+			goo.Value = []uint8(v)
+		}
+	}
+	// Field Proof (#4)
+	if len(bz) == 0 {
+		goo.Proof = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 4 {
+		goo.Proof = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 4 {
+			return fmt.Errorf("expected field # 4 of abci.ResponseQuery, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 4 of abci.ResponseQuery, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if goo.Proof == nil {
+			goo.Proof = new(merkle.Proof)
+		}
+		if err := goo.Proof.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Height (#5)
+	if len(bz) == 0 {
+		goo.Height = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 5 {
+		goo.Height = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 5 {
+			return fmt.Errorf("expected field # 5 of abci.ResponseQuery, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 5 of abci.ResponseQuery, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Height = int64(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo ResponseBeginBlock) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field ResponseBase (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.ResponseBase.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	return nil
+}
+
+func (goo *ResponseBeginBlock) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field ResponseBase (#1)
+	if len(bz) == 0 {
+		goo.ResponseBase = ResponseBase{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.ResponseBase = ResponseBase{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of abci.ResponseBeginBlock, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of abci.ResponseBeginBlock, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.ResponseBase.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo ResponseCheckTx) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field ResponseBase (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.ResponseBase.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field GasWanted (#2)
+	if goo.GasWanted != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.GasWanted)); err != nil {
+			return
+		}
+	}
+	// Field GasUsed (#3)
+	if goo.GasUsed != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.GasUsed)); err != nil {
+			return
+		}
+	}
+	// Field TimeoutHeight (#4)
+	if goo.TimeoutHeight != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 4, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.TimeoutHeight)); err != nil {
+			return
+		}
+	}
+	// Field TimeoutTime (#5)
+	if goo.TimeoutTime != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 5, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.TimeoutTime)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *ResponseCheckTx) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field ResponseBase (#1)
+	if len(bz) == 0 {
+		goo.ResponseBase = ResponseBase{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.ResponseBase = ResponseBase{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of abci.ResponseCheckTx, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of abci.ResponseCheckTx, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.ResponseBase.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field GasWanted (#2)
+	if len(bz) == 0 {
+		goo.GasWanted = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.GasWanted = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of abci.ResponseCheckTx, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 2 of abci.ResponseCheckTx, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.GasWanted = int64(v)
+	}
+	// Field GasUsed (#3)
+	if len(bz) == 0 {
+		goo.GasUsed = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.GasUsed = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of abci.ResponseCheckTx, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 3 of abci.ResponseCheckTx, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.GasUsed = int64(v)
+	}
+	// Field TimeoutHeight (#4)
+	if len(bz) == 0 {
+		goo.TimeoutHeight = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 4 {
+		goo.TimeoutHeight = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 4 {
+			return fmt.Errorf("expected field # 4 of abci.ResponseCheckTx, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 4 of abci.ResponseCheckTx, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.TimeoutHeight = int64(v)
+	}
+	// Field TimeoutTime (#5)
+	if len(bz) == 0 {
+		goo.TimeoutTime = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 5 {
+		goo.TimeoutTime = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 5 {
+			return fmt.Errorf("expected field # 5 of abci.ResponseCheckTx, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 5 of abci.ResponseCheckTx, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.TimeoutTime = int64(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo ResponseDeliverTx) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field ResponseBase (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.ResponseBase.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field GasWanted (#2)
+	if goo.GasWanted != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.GasWanted)); err != nil {
+			return
+		}
+	}
+	// Field GasUsed (#3)
+	if goo.GasUsed != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.GasUsed)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *ResponseDeliverTx) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field ResponseBase (#1)
+	if len(bz) == 0 {
+		goo.ResponseBase = ResponseBase{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.ResponseBase = ResponseBase{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of abci.ResponseDeliverTx, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of abci.ResponseDeliverTx, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.ResponseBase.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field GasWanted (#2)
+	if len(bz) == 0 {
+		goo.GasWanted = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.GasWanted = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of abci.ResponseDeliverTx, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 2 of abci.ResponseDeliverTx, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.GasWanted = int64(v)
+	}
+	// Field GasUsed (#3)
+	if len(bz) == 0 {
+		goo.GasUsed = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.GasUsed = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of abci.ResponseDeliverTx, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 3 of abci.ResponseDeliverTx, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.GasUsed = int64(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo ResponseEndBlock) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field ResponseBase (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.ResponseBase.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field ValidatorUpdates (#2)
+	for _, e := range goo.ValidatorUpdates {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		buf2 := new(bytes.Buffer)
+		if err = e.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	// Field ConsensusParams (#3)
+	if goo.ConsensusParams != nil {
+		buf2 := new(bytes.Buffer)
+		if err = goo.ConsensusParams.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	// Field Events (#4)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 3); err != nil {
+		return
+	}
+	return nil
+}
+
+func (goo *ResponseEndBlock) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field ResponseBase (#1)
+	if len(bz) == 0 {
+		goo.ResponseBase = ResponseBase{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.ResponseBase = ResponseBase{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of abci.ResponseEndBlock, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of abci.ResponseEndBlock, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.ResponseBase.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field ValidatorUpdates (#2)
+	if len(bz) == 0 {
+		goo.ValidatorUpdates = nil
+	} else if fnum, _, _, err := amino.DecodeFieldNumberAndTyp3(bz); err != nil {
+		return err
+	} else if fnum <= 2 {
+		var list []ValidatorUpdate
+		for len(bz) > 0 {
+			fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz)
+			if fnum > 2 {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			if fnum < 2 {
+				return fmt.Errorf("expected repeated field number 2 or greater, got %v", fnum)
+			}
+			if typ != amino.Typ3ByteLength {
+				return fmt.Errorf("expected repeated field type %v, got %v", amino.Typ3ByteLength, typ)
+			}
+			if len(bz) > 0 && bz[0] == 0x00 {
+				bz = bz[1:]
+				list = append(list, ValidatorUpdate{})
+				continue
+			}
+			v, n, err := amino.DecodeByteSlice(bz)
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			var e ValidatorUpdate
+			if err := e.UnmarshalBinary2(cdc, v); err != nil {
+				return err
+			}
+			list = append(list, e)
+		}
+		goo.ValidatorUpdates = list
+	}
+	// Field ConsensusParams (#3)
+	if len(bz) == 0 {
+		goo.ConsensusParams = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.ConsensusParams = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of abci.ResponseEndBlock, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 3 of abci.ResponseEndBlock, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if goo.ConsensusParams == nil {
+			goo.ConsensusParams = new(ConsensusParams)
+		}
+		if err := goo.ConsensusParams.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Events (#4)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 3, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo ResponseCommit) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field ResponseBase (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.ResponseBase.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field RetainHeight (#2)
+	if goo.RetainHeight != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.RetainHeight)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *ResponseCommit) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field ResponseBase (#1)
+	if len(bz) == 0 {
+		goo.ResponseBase = ResponseBase{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.ResponseBase = ResponseBase{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of abci.ResponseCommit, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of abci.ResponseCommit, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.ResponseBase.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field RetainHeight (#2)
+	if len(bz) == 0 {
+		goo.RetainHeight = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.RetainHeight = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of abci.ResponseCommit, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 2 of abci.ResponseCommit, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.RetainHeight = int64(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo ResponsePrepareProposal) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field ResponseBase (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.ResponseBase.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Txs (#2)
+	for _, e := range goo.Txs {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, e); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *ResponsePrepareProposal) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field ResponseBase (#1)
+	if len(bz) == 0 {
+		goo.ResponseBase = ResponseBase{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.ResponseBase = ResponseBase{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of abci.ResponsePrepareProposal, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of abci.ResponsePrepareProposal, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.ResponseBase.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Txs (#2)
+	if len(bz) == 0 {
+		goo.Txs = nil
+	} else if fnum, _, _, err := amino.DecodeFieldNumberAndTyp3(bz); err != nil {
+		return err
+	} else if fnum <= 2 {
+		var list [][]uint8
+		for len(bz) > 0 {
+			fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz)
+			if fnum > 2 {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			if fnum < 2 {
+				return fmt.Errorf("expected repeated field number 2 or greater, got %v", fnum)
+			}
+			if typ != amino.Typ3ByteLength {
+				return fmt.Errorf("expected repeated field type %v, got %v", amino.Typ3ByteLength, typ)
+			}
+			if len(bz) > 0 && bz[0] == 0x00 {
+				bz = bz[1:]
+				list = append(list, nil)
+				continue
+			}
+			var e []uint8
+			if len(bz) > 0 {
+				v, n, err := amino.DecodeByteSlice(bz)
+				if err != nil {
+					return err
+				}
+				bz = bz[n:]
+				if len(v) > 0 {
+					e = []uint8(v)
+				}
+			}
+			list = append(list, e)
+		}
+		goo.Txs = list
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo ResponseProcessProposal) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field ResponseBase (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.ResponseBase.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	return nil
+}
+
+func (goo *ResponseProcessProposal) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field ResponseBase (#1)
+	if len(bz) == 0 {
+		goo.ResponseBase = ResponseBase{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.ResponseBase = ResponseBase{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of abci.ResponseProcessProposal, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of abci.ResponseProcessProposal, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.ResponseBase.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo ConsensusParams) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field Block (#1)
+	if goo.Block != nil {
+		buf2 := new(bytes.Buffer)
+		if err = goo.Block.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	// Field Validator (#2)
+	if goo.Validator != nil {
+		buf2 := new(bytes.Buffer)
+		if err = goo.Validator.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *ConsensusParams) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field Block (#1)
+	if len(bz) == 0 {
+		goo.Block = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Block = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of abci.ConsensusParams, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of abci.ConsensusParams, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if goo.Block == nil {
+			goo.Block = new(BlockParams)
+		}
+		if err := goo.Block.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Validator (#2)
+	if len(bz) == 0 {
+		goo.Validator = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.Validator = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of abci.ConsensusParams, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of abci.ConsensusParams, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if goo.Validator == nil {
+			goo.Validator = new(ValidatorParams)
+		}
+		if err := goo.Validator.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo BlockParams) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field MaxTxBytes (#1)
+	if goo.MaxTxBytes != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.MaxTxBytes)); err != nil {
+			return
+		}
+	}
+	// Field MaxDataBytes (#2)
+	if goo.MaxDataBytes != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.MaxDataBytes)); err != nil {
+			return
+		}
+	}
+	// Field MaxBlockBytes (#3)
+	if goo.MaxBlockBytes != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.MaxBlockBytes)); err != nil {
+			return
+		}
+	}
+	// Field MaxGas (#4)
+	if goo.MaxGas != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 4, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.MaxGas)); err != nil {
+			return
+		}
+	}
+	// Field TimeIotaMS (#5)
+	if goo.TimeIotaMS != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 5, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.TimeIotaMS)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *BlockParams) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field MaxTxBytes (#1)
+	if len(bz) == 0 {
+		goo.MaxTxBytes = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.MaxTxBytes = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of abci.BlockParams, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 1 of abci.BlockParams, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.MaxTxBytes = int64(v)
+	}
+	// Field MaxDataBytes (#2)
+	if len(bz) == 0 {
+		goo.MaxDataBytes = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.MaxDataBytes = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of abci.BlockParams, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 2 of abci.BlockParams, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.MaxDataBytes = int64(v)
+	}
+	// Field MaxBlockBytes (#3)
+	if len(bz) == 0 {
+		goo.MaxBlockBytes = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.MaxBlockBytes = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of abci.BlockParams, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 3 of abci.BlockParams, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.MaxBlockBytes = int64(v)
+	}
+	// Field MaxGas (#4)
+	if len(bz) == 0 {
+		goo.MaxGas = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 4 {
+		goo.MaxGas = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 4 {
+			return fmt.Errorf("expected field # 4 of abci.BlockParams, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 4 of abci.BlockParams, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.MaxGas = int64(v)
+	}
+	// Field TimeIotaMS (#5)
+	if len(bz) == 0 {
+		goo.TimeIotaMS = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 5 {
+		goo.TimeIotaMS = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 5 {
+			return fmt.Errorf("expected field # 5 of abci.BlockParams, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 5 of abci.BlockParams, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.TimeIotaMS = int64(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo ValidatorParams) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field PubKeyTypeURLs (#1)
+	for _, e := range goo.PubKeyTypeURLs {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(e)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *ValidatorParams) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field PubKeyTypeURLs (#1)
+	if len(bz) == 0 {
+		goo.PubKeyTypeURLs = nil
+	} else if fnum, _, _, err := amino.DecodeFieldNumberAndTyp3(bz); err != nil {
+		return err
+	} else if fnum <= 1 {
+		var list []string
+		for len(bz) > 0 {
+			fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz)
+			if fnum > 1 {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			if fnum < 1 {
+				return fmt.Errorf("expected repeated field number 1 or greater, got %v", fnum)
+			}
+			if typ != amino.Typ3ByteLength {
+				return fmt.Errorf("expected repeated field type %v, got %v", amino.Typ3ByteLength, typ)
+			}
+			if len(bz) > 0 && bz[0] == 0x00 {
+				bz = bz[1:]
+				list = append(list, "")
+				continue
+			}
+			v, n, err := amino.DecodeString(bz)
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			list = append(list, string(v))
+		}
+		goo.PubKeyTypeURLs = list
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo ValidatorUpdate) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Address (#1)
+	{
+		var repr string
+		if repr, err = goo.Address.MarshalAmino(); err != nil {
+			return
+		}
+		if repr != "" {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeString(buf, repr); err != nil {
+				return
+			}
+		}
+	}
+	// Field PubKey (#2)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 1); err != nil {
+		return
+	}
+	// Field Power (#3)
+	if goo.Power != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Power)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *ValidatorUpdate) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Address (#1)
+	if len(bz) == 0 {
+		goo.Address = crypto.Address{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Address = crypto.Address{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of abci.ValidatorUpdate, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of abci.ValidatorUpdate, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Address.UnmarshalAmino(v); err != nil {
+			return err
+		}
+	}
+	// Field PubKey (#2)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 1, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Power (#3)
+	if len(bz) == 0 {
+		goo.Power = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.Power = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of abci.ValidatorUpdate, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 3 of abci.ValidatorUpdate, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Power = int64(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo LastCommitInfo) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field Round (#1)
+	if goo.Round != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Round)); err != nil {
+			return
+		}
+	}
+	// Field Votes (#2)
+	for _, e := range goo.Votes {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		buf2 := new(bytes.Buffer)
+		if err = e.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *LastCommitInfo) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field Round (#1)
+	if len(bz) == 0 {
+		goo.Round = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Round = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of abci.LastCommitInfo, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 1 of abci.LastCommitInfo, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Round = int32(v)
+	}
+	// Field Votes (#2)
+	if len(bz) == 0 {
+		goo.Votes = nil
+	} else if fnum, _, _, err := amino.DecodeFieldNumberAndTyp3(bz); err != nil {
+		return err
+	} else if fnum <= 2 {
+		var list []VoteInfo
+		for len(bz) > 0 {
+			fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz)
+			if fnum > 2 {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			if fnum < 2 {
+				return fmt.Errorf("expected repeated field number 2 or greater, got %v", fnum)
+			}
+			if typ != amino.Typ3ByteLength {
+				return fmt.Errorf("expected repeated field type %v, got %v", amino.Typ3ByteLength, typ)
+			}
+			if len(bz) > 0 && bz[0] == 0x00 {
+				bz = bz[1:]
+				list = append(list, VoteInfo{})
+				continue
+			}
+			v, n, err := amino.DecodeByteSlice(bz)
+			if err != nil {
+				return err
+			}
+			bz = bz[n:]
+			var e VoteInfo
+			if err := e.UnmarshalBinary2(cdc, v); err != nil {
+				return err
+			}
+			list = append(list, e)
+		}
+		goo.Votes = list
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo VoteInfo) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field Address (#1)
+	{
+		var repr string
+		if repr, err = goo.Address.MarshalAmino(); err != nil {
+			return
+		}
+		if repr != "" {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeString(buf, repr); err != nil {
+				return
+			}
+		}
+	}
+	// Field Power (#2)
+	if goo.Power != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Power)); err != nil {
+			return
+		}
+	}
+	// Field SignedLastBlock (#3)
+	if goo.SignedLastBlock {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeBool(buf, bool(goo.SignedLastBlock)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *VoteInfo) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field Address (#1)
+	if len(bz) == 0 {
+		goo.Address = crypto.Address{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Address = crypto.Address{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of abci.VoteInfo, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of abci.VoteInfo, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Address.UnmarshalAmino(v); err != nil {
+			return err
+		}
+	}
+	// Field Power (#2)
+	if len(bz) == 0 {
+		goo.Power = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.Power = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of abci.VoteInfo, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 2 of abci.VoteInfo, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Power = int64(v)
+	}
+	// Field SignedLastBlock (#3)
+	if len(bz) == 0 {
+		goo.SignedLastBlock = false
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.SignedLastBlock = false
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of abci.VoteInfo, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 3 of abci.VoteInfo, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeBool(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.SignedLastBlock = bool(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo MockHeader) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Version (#1)
+	if goo.Version != "" {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(goo.Version)); err != nil {
+			return
+		}
+	}
+	// Field ChainID (#2)
+	if goo.ChainID != "" {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(goo.ChainID)); err != nil {
+			return
+		}
+	}
+	// Field Height (#3)
+	if goo.Height != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Height)); err != nil {
+			return
+		}
+	}
+	// Field Time (#4)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 3); err != nil {
+		return
+	}
+	// Field NumTxs (#5)
+	if goo.NumTxs != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 5, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.NumTxs)); err != nil {
+			return
+		}
+	}
+	// Field TotalTxs (#6)
+	if goo.TotalTxs != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 6, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.TotalTxs)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *MockHeader) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Version (#1)
+	if len(bz) == 0 {
+		goo.Version = ""
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Version = ""
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of abci.MockHeader, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of abci.MockHeader, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Version = string(v)
+	}
+	// Field ChainID (#2)
+	if len(bz) == 0 {
+		goo.ChainID = ""
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.ChainID = ""
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of abci.MockHeader, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of abci.MockHeader, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.ChainID = string(v)
+	}
+	// Field Height (#3)
+	if len(bz) == 0 {
+		goo.Height = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.Height = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of abci.MockHeader, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 3 of abci.MockHeader, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Height = int64(v)
+	}
+	// Field Time (#4)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 3, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field NumTxs (#5)
+	if len(bz) == 0 {
+		goo.NumTxs = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 5 {
+		goo.NumTxs = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 5 {
+			return fmt.Errorf("expected field # 5 of abci.MockHeader, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 5 of abci.MockHeader, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.NumTxs = int64(v)
+	}
+	// Field TotalTxs (#6)
+	if len(bz) == 0 {
+		goo.TotalTxs = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 6 {
+		goo.TotalTxs = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 6 {
+			return fmt.Errorf("expected field # 6 of abci.MockHeader, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 6 of abci.MockHeader, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.TotalTxs = int64(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
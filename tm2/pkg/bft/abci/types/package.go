@@ -37,6 +37,8 @@ var Package = amino.RegisterPackage(amino.NewPackage(
 		RequestDeliverTx{},
 		RequestEndBlock{},
 		RequestCommit{},
+		RequestPrepareProposal{},
+		RequestProcessProposal{},
 
 		// response types
 		ResponseBase{},
@@ -52,6 +54,8 @@ var Package = amino.RegisterPackage(amino.NewPackage(
 		ResponseDeliverTx{},
 		ResponseEndBlock{},
 		ResponseCommit{},
+		ResponsePrepareProposal{},
+		ResponseProcessProposal{},
 
 		// error types
 		StringError(""),
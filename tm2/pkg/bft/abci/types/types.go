@@ -90,6 +90,27 @@ type RequestCommit struct {
 	RequestBase
 }
 
+// RequestPrepareProposal is sent by the proposer of the block at Height, with
+// the txs reaped from its mempool. MaxTxBytes is the maximum total size of
+// the returned txs, or -1 for no limit.
+type RequestPrepareProposal struct {
+	RequestBase
+	Height          int64
+	Time            time.Time
+	ProposerAddress crypto.Address
+	Txs             [][]byte
+	MaxTxBytes      int64
+}
+
+// RequestProcessProposal is sent by the validators for a proposed block,
+// before they prevote for it.
+type RequestProcessProposal struct {
+	RequestBase
+	Hash   []byte
+	Header Header
+	Txs    [][]byte
+}
+
 // ----------------------------------------
 // Response types
 
@@ -165,6 +186,11 @@ type ResponseCheckTx struct {
 	ResponseBase
 	GasWanted int64 // nondeterministic
 	GasUsed   int64
+
+	// Optional last block height and time (unix seconds) the tx is valid
+	// in, for the mempool to evict it afterwards.
+	TimeoutHeight int64
+	TimeoutTime   int64
 }
 
 type ResponseDeliverTx struct {
@@ -182,6 +208,19 @@ type ResponseEndBlock struct {
 
 type ResponseCommit struct {
 	ResponseBase
+	RetainHeight int64 // blocks below this height may be pruned, if non-zero.
+}
+
+// ResponsePrepareProposal holds the txs of the block to propose, which may
+// be reordered, dropped from or added to the requested txs.
+type ResponsePrepareProposal struct {
+	ResponseBase
+	Txs [][]byte
+}
+
+// ResponseProcessProposal rejects the proposed block if it has an error.
+type ResponseProcessProposal struct {
+	ResponseBase
 }
 
 // ----------------------------------------
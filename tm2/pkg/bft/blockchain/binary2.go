@@ -0,0 +1,286 @@
+// Code generated by gengo. DO NOT EDIT.
+
+package blockchain
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/gnolang/gno/tm2/pkg/amino"
+	"github.com/gnolang/gno/tm2/pkg/bft/types"
+)
+
+func (goo bcBlockRequestMessage) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field Height (#1)
+	if goo.Height != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Height)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *bcBlockRequestMessage) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field Height (#1)
+	if len(bz) == 0 {
+		goo.Height = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Height = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of blockchain.bcBlockRequestMessage, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 1 of blockchain.bcBlockRequestMessage, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Height = int64(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo bcBlockResponseMessage) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field Block (#1)
+	if goo.Block != nil {
+		buf2 := new(bytes.Buffer)
+		if err = goo.Block.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *bcBlockResponseMessage) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field Block (#1)
+	if len(bz) == 0 {
+		goo.Block = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Block = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of blockchain.bcBlockResponseMessage, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of blockchain.bcBlockResponseMessage, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if goo.Block == nil {
+			goo.Block = new(types.Block)
+		}
+		if err := goo.Block.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo bcNoBlockResponseMessage) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field Height (#1)
+	if goo.Height != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Height)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *bcNoBlockResponseMessage) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field Height (#1)
+	if len(bz) == 0 {
+		goo.Height = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Height = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of blockchain.bcNoBlockResponseMessage, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 1 of blockchain.bcNoBlockResponseMessage, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Height = int64(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo bcStatusRequestMessage) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field Height (#1)
+	if goo.Height != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Height)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *bcStatusRequestMessage) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field Height (#1)
+	if len(bz) == 0 {
+		goo.Height = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Height = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of blockchain.bcStatusRequestMessage, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 1 of blockchain.bcStatusRequestMessage, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Height = int64(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo bcStatusResponseMessage) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field Height (#1)
+	if goo.Height != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Height)); err != nil {
+			return
+		}
+	}
+	// Field Base (#2)
+	if goo.Base != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Base)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *bcStatusResponseMessage) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field Height (#1)
+	if len(bz) == 0 {
+		goo.Height = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Height = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of blockchain.bcStatusResponseMessage, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 1 of blockchain.bcStatusResponseMessage, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Height = int64(v)
+	}
+	// Field Base (#2)
+	if len(bz) == 0 {
+		goo.Base = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.Base = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of blockchain.bcStatusResponseMessage, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 2 of blockchain.bcStatusResponseMessage, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Base = int64(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
@@ -276,16 +276,19 @@ func (pool *BlockPool) MaxPeerHeight() int64 {
 	return pool.maxPeerHeight
 }
 
-// SetPeerHeight sets the peer's alleged blockchain height.
-func (pool *BlockPool) SetPeerHeight(peerID p2p.ID, height int64) {
+// SetPeerRange sets the peer's alleged blockchain base and height. Blocks
+// below base are not requested from the peer, as it pruned them; a base of
+// 0 is unknown, and all the blocks up to height are requested.
+func (pool *BlockPool) SetPeerRange(peerID p2p.ID, base int64, height int64) {
 	pool.mtx.Lock()
 	defer pool.mtx.Unlock()
 
 	peer := pool.peers[peerID]
 	if peer != nil {
+		peer.base = base
 		peer.height = height
 	} else {
-		peer = newBPPeer(pool, peerID, height)
+		peer = newBPPeer(pool, peerID, base, height)
 		peer.setLogger(pool.Logger.With("peer", peerID))
 		pool.peers[peerID] = peer
 	}
@@ -338,8 +341,8 @@ func (pool *BlockPool) updateMaxPeerHeight() {
 	pool.maxPeerHeight = max
 }
 
-// Pick an available peer with at least the given minHeight.
-// If no peers are available, returns nil.
+// Pick an available peer with the block at height, which is at least
+// minHeight and not pruned. If no peers are available, returns nil.
 func (pool *BlockPool) pickIncrAvailablePeer(minHeight int64) *bpPeer {
 	pool.mtx.Lock()
 	defer pool.mtx.Unlock()
@@ -352,7 +355,7 @@ func (pool *BlockPool) pickIncrAvailablePeer(minHeight int64) *bpPeer {
 		if peer.numPending >= maxPendingRequestsPerPeer {
 			continue
 		}
-		if peer.height < minHeight {
+		if peer.height < minHeight || peer.base > minHeight {
 			continue
 		}
 		peer.incrPending()
@@ -426,6 +429,7 @@ type bpPeer struct {
 	id          p2p.ID
 	recvMonitor *flow.Monitor
 
+	base       int64
 	height     int64
 	numPending int32
 	timeout    *time.Timer
@@ -434,10 +438,11 @@ type bpPeer struct {
 	logger log.Logger
 }
 
-func newBPPeer(pool *BlockPool, peerID p2p.ID, height int64) *bpPeer {
+func newBPPeer(pool *BlockPool, peerID p2p.ID, base int64, height int64) *bpPeer {
 	peer := &bpPeer{
 		pool:       pool,
 		id:         peerID,
+		base:       base,
 		height:     height,
 		numPending: 0,
 		logger:     log.NewNopLogger(),
@@ -92,7 +92,7 @@ func TestBlockPoolBasic(t *testing.T) {
 	// Introduce each peer.
 	go func() {
 		for _, peer := range peers {
-			pool.SetPeerHeight(peer.id, peer.height)
+			pool.SetPeerRange(peer.id, 1, peer.height)
 		}
 	}()
 
@@ -147,7 +147,7 @@ func TestBlockPoolTimeout(t *testing.T) {
 	// Introduce each peer.
 	go func() {
 		for _, peer := range peers {
-			pool.SetPeerHeight(peer.id, peer.height)
+			pool.SetPeerRange(peer.id, 1, peer.height)
 		}
 	}()
 
@@ -204,7 +204,7 @@ func TestBlockPoolRemovePeer(t *testing.T) {
 
 	// add peers
 	for peerID, peer := range peers {
-		pool.SetPeerHeight(peerID, peer.height)
+		pool.SetPeerRange(peerID, 1, peer.height)
 	}
 	assert.EqualValues(t, 10, pool.MaxPeerHeight())
 
@@ -222,3 +222,27 @@ func TestBlockPoolRemovePeer(t *testing.T) {
 
 	assert.EqualValues(t, 0, pool.MaxPeerHeight())
 }
+
+func TestBlockPoolPeerBase(t *testing.T) {
+	requestsCh := make(chan BlockRequest)
+	errorsCh := make(chan peerError)
+	pool := NewBlockPool(1, requestsCh, errorsCh)
+	pool.SetLogger(log.TestingLogger())
+
+	// the pruned peer has no blocks below 50.
+	pool.SetPeerRange(p2p.ID("pruned"), 50, 100)
+	pool.SetPeerRange(p2p.ID("full"), 1, 60)
+	assert.EqualValues(t, 100, pool.MaxPeerHeight())
+
+	peer := pool.pickIncrAvailablePeer(10)
+	require.NotNil(t, peer)
+	assert.Equal(t, p2p.ID("full"), peer.id)
+	peer = pool.pickIncrAvailablePeer(80)
+	require.NotNil(t, peer)
+	assert.Equal(t, p2p.ID("pruned"), peer.id)
+	assert.Nil(t, pool.pickIncrAvailablePeer(101))
+
+	// without the full peer, the pruned blocks can't be requested.
+	pool.RemovePeer(p2p.ID("full"))
+	assert.Nil(t, pool.pickIncrAvailablePeer(10))
+}
@@ -139,12 +139,15 @@ func (bcR *BlockchainReactor) GetChannels() []*p2p.ChannelDescriptor {
 
 // AddPeer implements Reactor by sending our state to peer.
 func (bcR *BlockchainReactor) AddPeer(peer p2p.Peer) {
-	msgBytes := amino.MustMarshalAny(&bcStatusResponseMessage{bcR.store.Height()})
+	msgBytes := amino.MustMarshalAny(&bcStatusResponseMessage{
+		Height: bcR.store.Height(),
+		Base:   bcR.store.Base(),
+	})
 	peer.Send(BlockchainChannel, msgBytes)
 	// it's OK if send fails. will try later in poolRoutine
 
 	// peer is added to the pool once we receive the first
-	// bcStatusResponseMessage from the peer and call pool.SetPeerHeight
+	// bcStatusResponseMessage from the peer and call pool.SetPeerRange
 }
 
 // RemovePeer implements Reactor by removing peer from the pool.
@@ -195,11 +198,18 @@ func (bcR *BlockchainReactor) Receive(chID byte, src p2p.Peer, msgBytes []byte)
 		bcR.pool.AddBlock(src.ID(), msg.Block, len(msgBytes))
 	case *bcStatusRequestMessage:
 		// Send peer our state.
-		msgBytes := amino.MustMarshalAny(&bcStatusResponseMessage{bcR.store.Height()})
+		msgBytes := amino.MustMarshalAny(&bcStatusResponseMessage{
+			Height: bcR.store.Height(),
+			Base:   bcR.store.Base(),
+		})
 		src.TrySend(BlockchainChannel, msgBytes)
 	case *bcStatusResponseMessage:
 		// Got a peer status. Unverified.
-		bcR.pool.SetPeerHeight(src.ID(), msg.Height)
+		bcR.pool.SetPeerRange(src.ID(), msg.Base, msg.Height)
+	case *bcNoBlockResponseMessage:
+		// The block was requested from a peer which announced it, and is
+		// requested again from another peer when the request times out.
+		bcR.Logger.Debug("Peer does not have requested block", "peer", src, "height", msg.Height)
 	default:
 		bcR.Logger.Error(fmt.Sprintf("Unknown message type %v", reflect.TypeOf(msg)))
 	}
@@ -451,6 +461,7 @@ func (m *bcStatusRequestMessage) String() string {
 
 type bcStatusResponseMessage struct {
 	Height int64
+	Base   int64 // the lowest block height, 0 if unknown.
 }
 
 // ValidateBasic performs basic validation.
@@ -458,9 +469,15 @@ func (m *bcStatusResponseMessage) ValidateBasic() error {
 	if m.Height < 0 {
 		return errors.New("negative height")
 	}
+	if m.Base < 0 {
+		return errors.New("negative base")
+	}
+	if m.Base > m.Height {
+		return fmt.Errorf("base %v above height %v", m.Base, m.Height)
+	}
 	return nil
 }
 
 func (m *bcStatusResponseMessage) String() string {
-	return fmt.Sprintf("[bcStatusResponseMessage %v]", m.Height)
+	return fmt.Sprintf("[bcStatusResponseMessage %v:%v]", m.Base, m.Height)
 }
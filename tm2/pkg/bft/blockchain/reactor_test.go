@@ -307,18 +307,23 @@ func TestBcStatusRequestMessageValidateBasic(t *testing.T) {
 func TestBcStatusResponseMessageValidateBasic(t *testing.T) {
 	testCases := []struct {
 		testName       string
+		responseBase   int64
 		responseHeight int64
 		expectErr      bool
 	}{
-		{"Valid Response Message", 0, false},
-		{"Valid Response Message", 1, false},
-		{"Invalid Response Message", -1, true},
+		{"Valid Response Message", 0, 0, false},
+		{"Valid Response Message", 0, 1, false},
+		{"Valid Response Message", 1, 1, false},
+		{"Valid Pruned Response Message", 5, 10, false},
+		{"Invalid Response Message", 0, -1, true},
+		{"Invalid Base", -1, 1, true},
+		{"Base Above Height", 2, 1, true},
 	}
 
 	for _, tc := range testCases {
 		tc := tc
 		t.Run(tc.testName, func(t *testing.T) {
-			response := bcStatusResponseMessage{Height: tc.responseHeight}
+			response := bcStatusResponseMessage{Base: tc.responseBase, Height: tc.responseHeight}
 			assert.Equal(t, tc.expectErr, response.ValidateBasic() != nil, "Validate Basic had an unexpected result")
 		})
 	}
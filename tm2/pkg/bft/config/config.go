@@ -9,6 +9,7 @@ import (
 	cns "github.com/gnolang/gno/tm2/pkg/bft/consensus/config"
 	mem "github.com/gnolang/gno/tm2/pkg/bft/mempool/config"
 	rpc "github.com/gnolang/gno/tm2/pkg/bft/rpc/config"
+	sm "github.com/gnolang/gno/tm2/pkg/bft/state/config"
 	"github.com/gnolang/gno/tm2/pkg/errors"
 	osm "github.com/gnolang/gno/tm2/pkg/os"
 	p2p "github.com/gnolang/gno/tm2/pkg/p2p/config"
@@ -24,6 +25,7 @@ type Config struct {
 	P2P       *p2p.P2PConfig       `toml:"p2p"`
 	Mempool   *mem.MempoolConfig   `toml:"mempool"`
 	Consensus *cns.ConsensusConfig `toml:"consensus"`
+	Pruning   *sm.PruningConfig    `toml:"pruning"`
 }
 
 // DefaultConfig returns a default configuration for a Tendermint node
@@ -34,6 +36,7 @@ func DefaultConfig() *Config {
 		P2P:        p2p.DefaultP2PConfig(),
 		Mempool:    mem.DefaultMempoolConfig(),
 		Consensus:  cns.DefaultConsensusConfig(),
+		Pruning:    sm.DefaultPruningConfig(),
 	}
 }
 
@@ -73,6 +76,7 @@ func TestConfig() *Config {
 		P2P:        p2p.TestP2PConfig(),
 		Mempool:    mem.TestMempoolConfig(),
 		Consensus:  cns.TestConsensusConfig(),
+		Pruning:    sm.TestPruningConfig(),
 	}
 }
 
@@ -118,6 +122,9 @@ func (cfg *Config) ValidateBasic() error {
 	if err := cfg.Consensus.ValidateBasic(); err != nil {
 		return errors.Wrap(err, "Error in [consensus] section")
 	}
+	if err := cfg.Pruning.ValidateBasic(); err != nil {
+		return errors.Wrap(err, "Error in [pruning] section")
+	}
 	return nil
 }
 
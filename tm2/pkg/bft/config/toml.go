@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"text/template"
 
+	sm "github.com/gnolang/gno/tm2/pkg/bft/state/config"
 	osm "github.com/gnolang/gno/tm2/pkg/os"
 	"github.com/pelletier/go-toml"
 )
@@ -34,6 +35,10 @@ func LoadConfigFile(configFilePath string) *Config {
 	if err != nil {
 		panic(err)
 	}
+	// Config files written before the [pruning] section was added.
+	if config.Pruning == nil {
+		config.Pruning = sm.DefaultPruningConfig()
+	}
 	return &config
 }
 
@@ -140,7 +145,9 @@ cors_allowed_methods = [{{ range .RPC.CORSAllowedMethods }}{{ printf "%q, " . }}
 cors_allowed_headers = [{{ range .RPC.CORSAllowedHeaders }}{{ printf "%q, " . }}{{end}}]
 
 # TCP or UNIX socket address for the gRPC server to listen on
-# NOTE: This server only supports /broadcast_tx_commit
+# NOTE: The service (broadcast, query, status and block streaming) is
+# defined in tm2/pkg/bft/rpc/grpc/coregrpc.proto, and is only served along
+# with the RPC server (laddr)
 grpc_laddr = "{{ .RPC.GRPCListenAddress }}"
 
 # Maximum number of simultaneous connections.
@@ -288,6 +295,21 @@ create_empty_blocks_interval = "{{ .Consensus.CreateEmptyBlocksInterval }}"
 # Reactor sleep duration parameters
 peer_gossip_sleep_duration = "{{ .Consensus.PeerGossipSleepDuration }}"
 peer_query_maj23_sleep_duration = "{{ .Consensus.PeerQueryMaj23SleepDuration }}"
+
+##### pruning configuration options #####
+[pruning]
+
+# Number of recent blocks to keep, with their validator sets, consensus params
+# and ABCI responses; older ones are pruned. 0 keeps all blocks (archive node).
+keep_recent = {{ .Pruning.KeepRecent }}
+
+# Prune below the retain height requested by the application on commit.
+# When both are set, the lowest retain height of keep_recent and of the
+# application is used.
+app_retain_height = {{ .Pruning.AppRetainHeight }}
+
+# Number of blocks between two prunings.
+interval = {{ .Pruning.Interval }}
 `
 
 /****** these are for test settings ***********/
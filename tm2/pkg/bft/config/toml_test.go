@@ -85,6 +85,7 @@ func checkConfig(configFile string) bool {
 		"propose",
 		"max",
 		"genesis",
+		"keep_recent",
 	}
 	for _, e := range elems {
 		if !strings.Contains(configFile, e) {
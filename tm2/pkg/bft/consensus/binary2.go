@@ -0,0 +1,1541 @@
+// Code generated by gengo. DO NOT EDIT.
+
+package consensus
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+
+	"github.com/gnolang/gno/tm2/pkg/amino"
+	"github.com/gnolang/gno/tm2/pkg/bft/consensus/types"
+	"github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/gnolang/gno/tm2/pkg/bitarray"
+	"github.com/gnolang/gno/tm2/pkg/crypto"
+)
+
+func (goo NewRoundStepMessage) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field Height (#1)
+	if goo.Height != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Height)); err != nil {
+			return
+		}
+	}
+	// Field Round (#2)
+	if goo.Round != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Round)); err != nil {
+			return
+		}
+	}
+	// Field Step (#3)
+	if goo.Step != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeUvarint(buf, uint64(goo.Step)); err != nil {
+			return
+		}
+	}
+	// Field SecondsSinceStartTime (#4)
+	if goo.SecondsSinceStartTime != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 4, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.SecondsSinceStartTime)); err != nil {
+			return
+		}
+	}
+	// Field LastCommitRound (#5)
+	if goo.LastCommitRound != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 5, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.LastCommitRound)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *NewRoundStepMessage) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field Height (#1)
+	if len(bz) == 0 {
+		goo.Height = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Height = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of consensus.NewRoundStepMessage, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 1 of consensus.NewRoundStepMessage, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Height = int64(v)
+	}
+	// Field Round (#2)
+	if len(bz) == 0 {
+		goo.Round = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.Round = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of consensus.NewRoundStepMessage, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 2 of consensus.NewRoundStepMessage, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Round = int(v)
+	}
+	// Field Step (#3)
+	if len(bz) == 0 {
+		goo.Step = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.Step = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of consensus.NewRoundStepMessage, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 3 of consensus.NewRoundStepMessage, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeUvarint8(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Step = cstypes.RoundStepType(v)
+	}
+	// Field SecondsSinceStartTime (#4)
+	if len(bz) == 0 {
+		goo.SecondsSinceStartTime = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 4 {
+		goo.SecondsSinceStartTime = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 4 {
+			return fmt.Errorf("expected field # 4 of consensus.NewRoundStepMessage, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 4 of consensus.NewRoundStepMessage, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.SecondsSinceStartTime = int(v)
+	}
+	// Field LastCommitRound (#5)
+	if len(bz) == 0 {
+		goo.LastCommitRound = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 5 {
+		goo.LastCommitRound = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 5 {
+			return fmt.Errorf("expected field # 5 of consensus.NewRoundStepMessage, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 5 of consensus.NewRoundStepMessage, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.LastCommitRound = int(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo NewValidBlockMessage) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field Height (#1)
+	if goo.Height != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Height)); err != nil {
+			return
+		}
+	}
+	// Field Round (#2)
+	if goo.Round != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Round)); err != nil {
+			return
+		}
+	}
+	// Field BlockPartsHeader (#3)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.BlockPartsHeader.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field BlockParts (#4)
+	if goo.BlockParts != nil {
+		buf2 := new(bytes.Buffer)
+		if err = goo.BlockParts.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 4, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	// Field IsCommit (#5)
+	if goo.IsCommit {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 5, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeBool(buf, bool(goo.IsCommit)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *NewValidBlockMessage) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field Height (#1)
+	if len(bz) == 0 {
+		goo.Height = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Height = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of consensus.NewValidBlockMessage, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 1 of consensus.NewValidBlockMessage, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Height = int64(v)
+	}
+	// Field Round (#2)
+	if len(bz) == 0 {
+		goo.Round = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.Round = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of consensus.NewValidBlockMessage, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 2 of consensus.NewValidBlockMessage, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Round = int(v)
+	}
+	// Field BlockPartsHeader (#3)
+	if len(bz) == 0 {
+		goo.BlockPartsHeader = types.PartSetHeader{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.BlockPartsHeader = types.PartSetHeader{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of consensus.NewValidBlockMessage, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 3 of consensus.NewValidBlockMessage, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.BlockPartsHeader.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field BlockParts (#4)
+	if len(bz) == 0 {
+		goo.BlockParts = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 4 {
+		goo.BlockParts = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 4 {
+			return fmt.Errorf("expected field # 4 of consensus.NewValidBlockMessage, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 4 of consensus.NewValidBlockMessage, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if goo.BlockParts == nil {
+			goo.BlockParts = new(bitarray.BitArray)
+		}
+		if err := goo.BlockParts.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field IsCommit (#5)
+	if len(bz) == 0 {
+		goo.IsCommit = false
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 5 {
+		goo.IsCommit = false
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 5 {
+			return fmt.Errorf("expected field # 5 of consensus.NewValidBlockMessage, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 5 of consensus.NewValidBlockMessage, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeBool(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.IsCommit = bool(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo ProposalMessage) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field Proposal (#1)
+	if goo.Proposal != nil {
+		buf2 := new(bytes.Buffer)
+		if err = goo.Proposal.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *ProposalMessage) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field Proposal (#1)
+	if len(bz) == 0 {
+		goo.Proposal = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Proposal = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of consensus.ProposalMessage, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of consensus.ProposalMessage, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if goo.Proposal == nil {
+			goo.Proposal = new(types.Proposal)
+		}
+		if err := goo.Proposal.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo ProposalPOLMessage) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field Height (#1)
+	if goo.Height != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Height)); err != nil {
+			return
+		}
+	}
+	// Field ProposalPOLRound (#2)
+	if goo.ProposalPOLRound != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.ProposalPOLRound)); err != nil {
+			return
+		}
+	}
+	// Field ProposalPOL (#3)
+	if goo.ProposalPOL != nil {
+		buf2 := new(bytes.Buffer)
+		if err = goo.ProposalPOL.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *ProposalPOLMessage) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field Height (#1)
+	if len(bz) == 0 {
+		goo.Height = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Height = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of consensus.ProposalPOLMessage, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 1 of consensus.ProposalPOLMessage, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Height = int64(v)
+	}
+	// Field ProposalPOLRound (#2)
+	if len(bz) == 0 {
+		goo.ProposalPOLRound = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.ProposalPOLRound = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of consensus.ProposalPOLMessage, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 2 of consensus.ProposalPOLMessage, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.ProposalPOLRound = int(v)
+	}
+	// Field ProposalPOL (#3)
+	if len(bz) == 0 {
+		goo.ProposalPOL = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.ProposalPOL = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of consensus.ProposalPOLMessage, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 3 of consensus.ProposalPOLMessage, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if goo.ProposalPOL == nil {
+			goo.ProposalPOL = new(bitarray.BitArray)
+		}
+		if err := goo.ProposalPOL.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo BlockPartMessage) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field Height (#1)
+	if goo.Height != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Height)); err != nil {
+			return
+		}
+	}
+	// Field Round (#2)
+	if goo.Round != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Round)); err != nil {
+			return
+		}
+	}
+	// Field Part (#3)
+	if goo.Part != nil {
+		buf2 := new(bytes.Buffer)
+		if err = goo.Part.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *BlockPartMessage) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field Height (#1)
+	if len(bz) == 0 {
+		goo.Height = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Height = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of consensus.BlockPartMessage, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 1 of consensus.BlockPartMessage, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Height = int64(v)
+	}
+	// Field Round (#2)
+	if len(bz) == 0 {
+		goo.Round = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.Round = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of consensus.BlockPartMessage, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 2 of consensus.BlockPartMessage, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Round = int(v)
+	}
+	// Field Part (#3)
+	if len(bz) == 0 {
+		goo.Part = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.Part = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of consensus.BlockPartMessage, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 3 of consensus.BlockPartMessage, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if goo.Part == nil {
+			goo.Part = new(types.Part)
+		}
+		if err := goo.Part.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo VoteMessage) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field Vote (#1)
+	if goo.Vote != nil {
+		buf2 := new(bytes.Buffer)
+		if err = goo.Vote.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *VoteMessage) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field Vote (#1)
+	if len(bz) == 0 {
+		goo.Vote = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Vote = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of consensus.VoteMessage, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of consensus.VoteMessage, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if goo.Vote == nil {
+			goo.Vote = new(types.Vote)
+		}
+		if err := goo.Vote.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo HasVoteMessage) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field Height (#1)
+	if goo.Height != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Height)); err != nil {
+			return
+		}
+	}
+	// Field Round (#2)
+	if goo.Round != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Round)); err != nil {
+			return
+		}
+	}
+	// Field Type (#3)
+	if goo.Type != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeUvarint(buf, uint64(goo.Type)); err != nil {
+			return
+		}
+	}
+	// Field Index (#4)
+	if goo.Index != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 4, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Index)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *HasVoteMessage) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field Height (#1)
+	if len(bz) == 0 {
+		goo.Height = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Height = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of consensus.HasVoteMessage, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 1 of consensus.HasVoteMessage, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Height = int64(v)
+	}
+	// Field Round (#2)
+	if len(bz) == 0 {
+		goo.Round = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.Round = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of consensus.HasVoteMessage, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 2 of consensus.HasVoteMessage, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Round = int(v)
+	}
+	// Field Type (#3)
+	if len(bz) == 0 {
+		goo.Type = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.Type = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of consensus.HasVoteMessage, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 3 of consensus.HasVoteMessage, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeUvarint8(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Type = types.SignedMsgType(v)
+	}
+	// Field Index (#4)
+	if len(bz) == 0 {
+		goo.Index = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 4 {
+		goo.Index = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 4 {
+			return fmt.Errorf("expected field # 4 of consensus.HasVoteMessage, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 4 of consensus.HasVoteMessage, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Index = int(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo VoteSetMaj23Message) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field Height (#1)
+	if goo.Height != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Height)); err != nil {
+			return
+		}
+	}
+	// Field Round (#2)
+	if goo.Round != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Round)); err != nil {
+			return
+		}
+	}
+	// Field Type (#3)
+	if goo.Type != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeUvarint(buf, uint64(goo.Type)); err != nil {
+			return
+		}
+	}
+	// Field BlockID (#4)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.BlockID.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 4, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	return nil
+}
+
+func (goo *VoteSetMaj23Message) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field Height (#1)
+	if len(bz) == 0 {
+		goo.Height = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Height = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of consensus.VoteSetMaj23Message, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 1 of consensus.VoteSetMaj23Message, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Height = int64(v)
+	}
+	// Field Round (#2)
+	if len(bz) == 0 {
+		goo.Round = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.Round = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of consensus.VoteSetMaj23Message, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 2 of consensus.VoteSetMaj23Message, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Round = int(v)
+	}
+	// Field Type (#3)
+	if len(bz) == 0 {
+		goo.Type = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.Type = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of consensus.VoteSetMaj23Message, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 3 of consensus.VoteSetMaj23Message, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeUvarint8(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Type = types.SignedMsgType(v)
+	}
+	// Field BlockID (#4)
+	if len(bz) == 0 {
+		goo.BlockID = types.BlockID{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 4 {
+		goo.BlockID = types.BlockID{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 4 {
+			return fmt.Errorf("expected field # 4 of consensus.VoteSetMaj23Message, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 4 of consensus.VoteSetMaj23Message, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.BlockID.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo VoteSetBitsMessage) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field Height (#1)
+	if goo.Height != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Height)); err != nil {
+			return
+		}
+	}
+	// Field Round (#2)
+	if goo.Round != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Round)); err != nil {
+			return
+		}
+	}
+	// Field Type (#3)
+	if goo.Type != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeUvarint(buf, uint64(goo.Type)); err != nil {
+			return
+		}
+	}
+	// Field BlockID (#4)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.BlockID.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 4, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Votes (#5)
+	if goo.Votes != nil {
+		buf2 := new(bytes.Buffer)
+		if err = goo.Votes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 5, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *VoteSetBitsMessage) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field Height (#1)
+	if len(bz) == 0 {
+		goo.Height = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Height = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of consensus.VoteSetBitsMessage, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 1 of consensus.VoteSetBitsMessage, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Height = int64(v)
+	}
+	// Field Round (#2)
+	if len(bz) == 0 {
+		goo.Round = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.Round = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of consensus.VoteSetBitsMessage, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 2 of consensus.VoteSetBitsMessage, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Round = int(v)
+	}
+	// Field Type (#3)
+	if len(bz) == 0 {
+		goo.Type = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.Type = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of consensus.VoteSetBitsMessage, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 3 of consensus.VoteSetBitsMessage, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeUvarint8(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Type = types.SignedMsgType(v)
+	}
+	// Field BlockID (#4)
+	if len(bz) == 0 {
+		goo.BlockID = types.BlockID{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 4 {
+		goo.BlockID = types.BlockID{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 4 {
+			return fmt.Errorf("expected field # 4 of consensus.VoteSetBitsMessage, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 4 of consensus.VoteSetBitsMessage, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.BlockID.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Votes (#5)
+	if len(bz) == 0 {
+		goo.Votes = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 5 {
+		goo.Votes = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 5 {
+			return fmt.Errorf("expected field # 5 of consensus.VoteSetBitsMessage, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 5 of consensus.VoteSetBitsMessage, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if goo.Votes == nil {
+			goo.Votes = new(bitarray.BitArray)
+		}
+		if err := goo.Votes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo newRoundStepInfo) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field HRS (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.HRS.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	return nil
+}
+
+func (goo *newRoundStepInfo) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field HRS (#1)
+	if len(bz) == 0 {
+		goo.HRS = cstypes.HRS{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.HRS = cstypes.HRS{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of consensus.newRoundStepInfo, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of consensus.newRoundStepInfo, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.HRS.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo msgInfo) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Msg (#1)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 0); err != nil {
+		return
+	}
+	// Field PeerID (#2)
+	if goo.PeerID != "" {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(goo.PeerID)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *msgInfo) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Msg (#1)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 0, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field PeerID (#2)
+	if len(bz) == 0 {
+		goo.PeerID = ""
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.PeerID = ""
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of consensus.msgInfo, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of consensus.msgInfo, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.PeerID = crypto.ID(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo timeoutInfo) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Duration (#1)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 0); err != nil {
+		return
+	}
+	// Field Height (#2)
+	if goo.Height != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Height)); err != nil {
+			return
+		}
+	}
+	// Field Round (#3)
+	if goo.Round != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Round)); err != nil {
+			return
+		}
+	}
+	// Field Step (#4)
+	if goo.Step != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 4, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeUvarint(buf, uint64(goo.Step)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *timeoutInfo) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Duration (#1)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 0, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Height (#2)
+	if len(bz) == 0 {
+		goo.Height = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.Height = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of consensus.timeoutInfo, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 2 of consensus.timeoutInfo, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Height = int64(v)
+	}
+	// Field Round (#3)
+	if len(bz) == 0 {
+		goo.Round = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.Round = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of consensus.timeoutInfo, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 3 of consensus.timeoutInfo, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Round = int(v)
+	}
+	// Field Step (#4)
+	if len(bz) == 0 {
+		goo.Step = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 4 {
+		goo.Step = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 4 {
+			return fmt.Errorf("expected field # 4 of consensus.timeoutInfo, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 4 of consensus.timeoutInfo, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeUvarint8(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Step = cstypes.RoundStepType(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
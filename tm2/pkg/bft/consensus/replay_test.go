@@ -1065,6 +1065,7 @@ func newMockBlockStore(config *cfg.Config, params abci.ConsensusParams) *mockBlo
 	return &mockBlockStore{config, params, nil, nil}
 }
 
+func (bs *mockBlockStore) Base() int64                         { return 1 }
 func (bs *mockBlockStore) Height() int64                       { return int64(len(bs.chain)) }
 func (bs *mockBlockStore) LoadBlock(height int64) *types.Block { return bs.chain[height-1] }
 func (bs *mockBlockStore) LoadBlockMeta(height int64) *types.BlockMeta {
@@ -1077,6 +1078,7 @@ func (bs *mockBlockStore) LoadBlockMeta(height int64) *types.BlockMeta {
 func (bs *mockBlockStore) LoadBlockPart(height int64, index int) *types.Part { return nil }
 func (bs *mockBlockStore) SaveBlock(block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit) {
 }
+func (bs *mockBlockStore) PruneBlocks(height int64) (uint64, error) { return 0, nil }
 
 func (bs *mockBlockStore) LoadBlockCommit(height int64) *types.Commit {
 	return bs.commits[height-1]
@@ -1040,6 +1040,14 @@ func (cs *ConsensusState) defaultDoPrevote(height int64, round int) {
 		return
 	}
 
+	// Let the app reject the proposal block
+	if err := cs.blockExec.ProcessProposal(cs.ProposalBlock); err != nil {
+		// ProposalBlock is rejected, prevote nil.
+		logger.Error("enterPrevote: ProposalBlock is rejected", "err", err)
+		cs.signAddVote(types.PrevoteType, nil, types.PartSetHeader{})
+		return
+	}
+
 	// Prevote cs.ProposalBlock
 	// NOTE: the proposal signature is validated when it is received,
 	// and the proposal block parts are validated as they are received (against the merkle hash in the proposal)
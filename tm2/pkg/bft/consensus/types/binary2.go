@@ -0,0 +1,2376 @@
+// Code generated by gengo. DO NOT EDIT.
+
+package cstypes
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+
+	"github.com/gnolang/gno/tm2/pkg/amino"
+	"github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/gnolang/gno/tm2/pkg/bitarray"
+)
+
+func (goo RoundState) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Height (#1)
+	if goo.Height != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Height)); err != nil {
+			return
+		}
+	}
+	// Field Round (#2)
+	if goo.Round != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Round)); err != nil {
+			return
+		}
+	}
+	// Field Step (#3)
+	if goo.Step != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeUvarint(buf, uint64(goo.Step)); err != nil {
+			return
+		}
+	}
+	// Field StartTime (#4)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 3); err != nil {
+		return
+	}
+	// Field CommitTime (#5)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 4); err != nil {
+		return
+	}
+	// Field Validators (#6)
+	if goo.Validators != nil {
+		buf2 := new(bytes.Buffer)
+		if err = goo.Validators.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 6, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	// Field Proposal (#7)
+	if goo.Proposal != nil {
+		buf2 := new(bytes.Buffer)
+		if err = goo.Proposal.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 7, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	// Field ProposalBlock (#8)
+	if goo.ProposalBlock != nil {
+		buf2 := new(bytes.Buffer)
+		if err = goo.ProposalBlock.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 8, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	// Field ProposalBlockParts (#9)
+	if goo.ProposalBlockParts != nil {
+		buf2 := new(bytes.Buffer)
+		if err = goo.ProposalBlockParts.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 9, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	// Field LockedRound (#10)
+	if goo.LockedRound != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 10, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.LockedRound)); err != nil {
+			return
+		}
+	}
+	// Field LockedBlock (#11)
+	if goo.LockedBlock != nil {
+		buf2 := new(bytes.Buffer)
+		if err = goo.LockedBlock.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 11, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	// Field LockedBlockParts (#12)
+	if goo.LockedBlockParts != nil {
+		buf2 := new(bytes.Buffer)
+		if err = goo.LockedBlockParts.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 12, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	// Field ValidRound (#13)
+	if goo.ValidRound != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 13, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.ValidRound)); err != nil {
+			return
+		}
+	}
+	// Field ValidBlock (#14)
+	if goo.ValidBlock != nil {
+		buf2 := new(bytes.Buffer)
+		if err = goo.ValidBlock.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 14, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	// Field ValidBlockParts (#15)
+	if goo.ValidBlockParts != nil {
+		buf2 := new(bytes.Buffer)
+		if err = goo.ValidBlockParts.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 15, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	// Field Votes (#16)
+	if goo.Votes != nil {
+		buf2 := new(bytes.Buffer)
+		if err = goo.Votes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 16, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	// Field CommitRound (#17)
+	if goo.CommitRound != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 17, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.CommitRound)); err != nil {
+			return
+		}
+	}
+	// Field LastCommit (#18)
+	if goo.LastCommit != nil {
+		buf2 := new(bytes.Buffer)
+		if err = goo.LastCommit.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 18, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	// Field LastValidators (#19)
+	if goo.LastValidators != nil {
+		buf2 := new(bytes.Buffer)
+		if err = goo.LastValidators.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 19, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	// Field TriggeredTimeoutPrecommit (#20)
+	if goo.TriggeredTimeoutPrecommit {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 20, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeBool(buf, bool(goo.TriggeredTimeoutPrecommit)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *RoundState) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Height (#1)
+	if len(bz) == 0 {
+		goo.Height = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Height = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of cstypes.RoundState, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 1 of cstypes.RoundState, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Height = int64(v)
+	}
+	// Field Round (#2)
+	if len(bz) == 0 {
+		goo.Round = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.Round = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of cstypes.RoundState, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 2 of cstypes.RoundState, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Round = int(v)
+	}
+	// Field Step (#3)
+	if len(bz) == 0 {
+		goo.Step = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.Step = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of cstypes.RoundState, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 3 of cstypes.RoundState, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeUvarint8(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Step = RoundStepType(v)
+	}
+	// Field StartTime (#4)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 3, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field CommitTime (#5)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 4, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Validators (#6)
+	if len(bz) == 0 {
+		goo.Validators = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 6 {
+		goo.Validators = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 6 {
+			return fmt.Errorf("expected field # 6 of cstypes.RoundState, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 6 of cstypes.RoundState, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if goo.Validators == nil {
+			goo.Validators = new(types.ValidatorSet)
+		}
+		if err := goo.Validators.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Proposal (#7)
+	if len(bz) == 0 {
+		goo.Proposal = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 7 {
+		goo.Proposal = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 7 {
+			return fmt.Errorf("expected field # 7 of cstypes.RoundState, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 7 of cstypes.RoundState, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if goo.Proposal == nil {
+			goo.Proposal = new(types.Proposal)
+		}
+		if err := goo.Proposal.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field ProposalBlock (#8)
+	if len(bz) == 0 {
+		goo.ProposalBlock = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 8 {
+		goo.ProposalBlock = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 8 {
+			return fmt.Errorf("expected field # 8 of cstypes.RoundState, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 8 of cstypes.RoundState, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if goo.ProposalBlock == nil {
+			goo.ProposalBlock = new(types.Block)
+		}
+		if err := goo.ProposalBlock.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field ProposalBlockParts (#9)
+	if len(bz) == 0 {
+		goo.ProposalBlockParts = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 9 {
+		goo.ProposalBlockParts = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 9 {
+			return fmt.Errorf("expected field # 9 of cstypes.RoundState, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 9 of cstypes.RoundState, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if goo.ProposalBlockParts == nil {
+			goo.ProposalBlockParts = new(types.PartSet)
+		}
+		if err := goo.ProposalBlockParts.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field LockedRound (#10)
+	if len(bz) == 0 {
+		goo.LockedRound = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 10 {
+		goo.LockedRound = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 10 {
+			return fmt.Errorf("expected field # 10 of cstypes.RoundState, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 10 of cstypes.RoundState, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.LockedRound = int(v)
+	}
+	// Field LockedBlock (#11)
+	if len(bz) == 0 {
+		goo.LockedBlock = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 11 {
+		goo.LockedBlock = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 11 {
+			return fmt.Errorf("expected field # 11 of cstypes.RoundState, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 11 of cstypes.RoundState, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if goo.LockedBlock == nil {
+			goo.LockedBlock = new(types.Block)
+		}
+		if err := goo.LockedBlock.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field LockedBlockParts (#12)
+	if len(bz) == 0 {
+		goo.LockedBlockParts = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 12 {
+		goo.LockedBlockParts = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 12 {
+			return fmt.Errorf("expected field # 12 of cstypes.RoundState, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 12 of cstypes.RoundState, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if goo.LockedBlockParts == nil {
+			goo.LockedBlockParts = new(types.PartSet)
+		}
+		if err := goo.LockedBlockParts.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field ValidRound (#13)
+	if len(bz) == 0 {
+		goo.ValidRound = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 13 {
+		goo.ValidRound = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 13 {
+			return fmt.Errorf("expected field # 13 of cstypes.RoundState, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 13 of cstypes.RoundState, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.ValidRound = int(v)
+	}
+	// Field ValidBlock (#14)
+	if len(bz) == 0 {
+		goo.ValidBlock = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 14 {
+		goo.ValidBlock = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 14 {
+			return fmt.Errorf("expected field # 14 of cstypes.RoundState, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 14 of cstypes.RoundState, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if goo.ValidBlock == nil {
+			goo.ValidBlock = new(types.Block)
+		}
+		if err := goo.ValidBlock.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field ValidBlockParts (#15)
+	if len(bz) == 0 {
+		goo.ValidBlockParts = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 15 {
+		goo.ValidBlockParts = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 15 {
+			return fmt.Errorf("expected field # 15 of cstypes.RoundState, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 15 of cstypes.RoundState, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if goo.ValidBlockParts == nil {
+			goo.ValidBlockParts = new(types.PartSet)
+		}
+		if err := goo.ValidBlockParts.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Votes (#16)
+	if len(bz) == 0 {
+		goo.Votes = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 16 {
+		goo.Votes = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 16 {
+			return fmt.Errorf("expected field # 16 of cstypes.RoundState, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 16 of cstypes.RoundState, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if goo.Votes == nil {
+			goo.Votes = new(HeightVoteSet)
+		}
+		if err := goo.Votes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field CommitRound (#17)
+	if len(bz) == 0 {
+		goo.CommitRound = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 17 {
+		goo.CommitRound = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 17 {
+			return fmt.Errorf("expected field # 17 of cstypes.RoundState, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 17 of cstypes.RoundState, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.CommitRound = int(v)
+	}
+	// Field LastCommit (#18)
+	if len(bz) == 0 {
+		goo.LastCommit = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 18 {
+		goo.LastCommit = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 18 {
+			return fmt.Errorf("expected field # 18 of cstypes.RoundState, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 18 of cstypes.RoundState, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if goo.LastCommit == nil {
+			goo.LastCommit = new(types.VoteSet)
+		}
+		if err := goo.LastCommit.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field LastValidators (#19)
+	if len(bz) == 0 {
+		goo.LastValidators = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 19 {
+		goo.LastValidators = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 19 {
+			return fmt.Errorf("expected field # 19 of cstypes.RoundState, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 19 of cstypes.RoundState, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if goo.LastValidators == nil {
+			goo.LastValidators = new(types.ValidatorSet)
+		}
+		if err := goo.LastValidators.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field TriggeredTimeoutPrecommit (#20)
+	if len(bz) == 0 {
+		goo.TriggeredTimeoutPrecommit = false
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 20 {
+		goo.TriggeredTimeoutPrecommit = false
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 20 {
+			return fmt.Errorf("expected field # 20 of cstypes.RoundState, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 20 of cstypes.RoundState, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeBool(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.TriggeredTimeoutPrecommit = bool(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo HRS) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field Height (#1)
+	if goo.Height != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Height)); err != nil {
+			return
+		}
+	}
+	// Field Round (#2)
+	if goo.Round != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Round)); err != nil {
+			return
+		}
+	}
+	// Field Step (#3)
+	if goo.Step != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeUvarint(buf, uint64(goo.Step)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *HRS) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field Height (#1)
+	if len(bz) == 0 {
+		goo.Height = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Height = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of cstypes.HRS, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 1 of cstypes.HRS, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Height = int64(v)
+	}
+	// Field Round (#2)
+	if len(bz) == 0 {
+		goo.Round = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.Round = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of cstypes.HRS, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 2 of cstypes.HRS, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Round = int(v)
+	}
+	// Field Step (#3)
+	if len(bz) == 0 {
+		goo.Step = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.Step = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of cstypes.HRS, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 3 of cstypes.HRS, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeUvarint8(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Step = RoundStepType(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo RoundStateSimple) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field HeightRoundStep (#1)
+	if goo.HeightRoundStep != "" {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeString(buf, string(goo.HeightRoundStep)); err != nil {
+			return
+		}
+	}
+	// Field StartTime (#2)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 1); err != nil {
+		return
+	}
+	// Field ProposalBlockHash (#3)
+	if len(goo.ProposalBlockHash) != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, goo.ProposalBlockHash); err != nil {
+			return
+		}
+	}
+	// Field LockedBlockHash (#4)
+	if len(goo.LockedBlockHash) != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 4, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, goo.LockedBlockHash); err != nil {
+			return
+		}
+	}
+	// Field ValidBlockHash (#5)
+	if len(goo.ValidBlockHash) != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 5, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, goo.ValidBlockHash); err != nil {
+			return
+		}
+	}
+	// Field Votes (#6)
+	if goo.Votes != nil {
+		buf2 := new(bytes.Buffer)
+		if err = goo.Votes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 6, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *RoundStateSimple) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field HeightRoundStep (#1)
+	if len(bz) == 0 {
+		goo.HeightRoundStep = ""
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.HeightRoundStep = ""
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of cstypes.RoundStateSimple, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of cstypes.RoundStateSimple, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.HeightRoundStep = string(v)
+	}
+	// Field StartTime (#2)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 1, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field ProposalBlockHash (#3)
+	if len(bz) == 0 {
+		goo.ProposalBlockHash = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.ProposalBlockHash = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of cstypes.RoundStateSimple, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 3 of cstypes.RoundStateSimple, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if len(v) == 0 {
+			goo.ProposalBlockHash = nil
+		} else {
+			goo.ProposalBlockHash = []uint8(v)
+		}
+	}
+	// Field LockedBlockHash (#4)
+	if len(bz) == 0 {
+		goo.LockedBlockHash = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 4 {
+		goo.LockedBlockHash = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 4 {
+			return fmt.Errorf("expected field # 4 of cstypes.RoundStateSimple, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 4 of cstypes.RoundStateSimple, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if len(v) == 0 {
+			goo.LockedBlockHash = nil
+		} else {
+			goo.LockedBlockHash = []uint8(v)
+		}
+	}
+	// Field ValidBlockHash (#5)
+	if len(bz) == 0 {
+		goo.ValidBlockHash = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 5 {
+		goo.ValidBlockHash = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 5 {
+			return fmt.Errorf("expected field # 5 of cstypes.RoundStateSimple, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 5 of cstypes.RoundStateSimple, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if len(v) == 0 {
+			goo.ValidBlockHash = nil
+		} else {
+			goo.ValidBlockHash = []uint8(v)
+		}
+	}
+	// Field Votes (#6)
+	if len(bz) == 0 {
+		goo.Votes = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 6 {
+		goo.Votes = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 6 {
+			return fmt.Errorf("expected field # 6 of cstypes.RoundStateSimple, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 6 of cstypes.RoundStateSimple, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if goo.Votes == nil {
+			goo.Votes = new(HeightVoteSet)
+		}
+		if err := goo.Votes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo PeerRoundState) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field Height (#1)
+	if goo.Height != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Height)); err != nil {
+			return
+		}
+	}
+	// Field Round (#2)
+	if goo.Round != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.Round)); err != nil {
+			return
+		}
+	}
+	// Field Step (#3)
+	if goo.Step != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeUvarint(buf, uint64(goo.Step)); err != nil {
+			return
+		}
+	}
+	// Field StartTime (#4)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 3); err != nil {
+		return
+	}
+	// Field Proposal (#5)
+	if goo.Proposal {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 5, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeBool(buf, bool(goo.Proposal)); err != nil {
+			return
+		}
+	}
+	// Field ProposalBlockPartsHeader (#6)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.ProposalBlockPartsHeader.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 6, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field ProposalBlockParts (#7)
+	if goo.ProposalBlockParts != nil {
+		buf2 := new(bytes.Buffer)
+		if err = goo.ProposalBlockParts.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 7, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	// Field ProposalPOLRound (#8)
+	if goo.ProposalPOLRound != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 8, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.ProposalPOLRound)); err != nil {
+			return
+		}
+	}
+	// Field ProposalPOL (#9)
+	if goo.ProposalPOL != nil {
+		buf2 := new(bytes.Buffer)
+		if err = goo.ProposalPOL.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 9, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	// Field Prevotes (#10)
+	if goo.Prevotes != nil {
+		buf2 := new(bytes.Buffer)
+		if err = goo.Prevotes.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 10, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	// Field Precommits (#11)
+	if goo.Precommits != nil {
+		buf2 := new(bytes.Buffer)
+		if err = goo.Precommits.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 11, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	// Field LastCommitRound (#12)
+	if goo.LastCommitRound != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 12, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.LastCommitRound)); err != nil {
+			return
+		}
+	}
+	// Field LastCommit (#13)
+	if goo.LastCommit != nil {
+		buf2 := new(bytes.Buffer)
+		if err = goo.LastCommit.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 13, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	// Field CatchupCommitRound (#14)
+	if goo.CatchupCommitRound != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 14, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.CatchupCommitRound)); err != nil {
+			return
+		}
+	}
+	// Field CatchupCommit (#15)
+	if goo.CatchupCommit != nil {
+		buf2 := new(bytes.Buffer)
+		if err = goo.CatchupCommit.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 15, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *PeerRoundState) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field Height (#1)
+	if len(bz) == 0 {
+		goo.Height = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Height = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of cstypes.PeerRoundState, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 1 of cstypes.PeerRoundState, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Height = int64(v)
+	}
+	// Field Round (#2)
+	if len(bz) == 0 {
+		goo.Round = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.Round = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of cstypes.PeerRoundState, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 2 of cstypes.PeerRoundState, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Round = int(v)
+	}
+	// Field Step (#3)
+	if len(bz) == 0 {
+		goo.Step = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.Step = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of cstypes.PeerRoundState, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 3 of cstypes.PeerRoundState, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeUvarint8(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Step = RoundStepType(v)
+	}
+	// Field StartTime (#4)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 3, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	// Field Proposal (#5)
+	if len(bz) == 0 {
+		goo.Proposal = false
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 5 {
+		goo.Proposal = false
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 5 {
+			return fmt.Errorf("expected field # 5 of cstypes.PeerRoundState, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 5 of cstypes.PeerRoundState, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeBool(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Proposal = bool(v)
+	}
+	// Field ProposalBlockPartsHeader (#6)
+	if len(bz) == 0 {
+		goo.ProposalBlockPartsHeader = types.PartSetHeader{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 6 {
+		goo.ProposalBlockPartsHeader = types.PartSetHeader{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 6 {
+			return fmt.Errorf("expected field # 6 of cstypes.PeerRoundState, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 6 of cstypes.PeerRoundState, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.ProposalBlockPartsHeader.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field ProposalBlockParts (#7)
+	if len(bz) == 0 {
+		goo.ProposalBlockParts = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 7 {
+		goo.ProposalBlockParts = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 7 {
+			return fmt.Errorf("expected field # 7 of cstypes.PeerRoundState, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 7 of cstypes.PeerRoundState, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if goo.ProposalBlockParts == nil {
+			goo.ProposalBlockParts = new(bitarray.BitArray)
+		}
+		if err := goo.ProposalBlockParts.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field ProposalPOLRound (#8)
+	if len(bz) == 0 {
+		goo.ProposalPOLRound = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 8 {
+		goo.ProposalPOLRound = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 8 {
+			return fmt.Errorf("expected field # 8 of cstypes.PeerRoundState, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 8 of cstypes.PeerRoundState, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.ProposalPOLRound = int(v)
+	}
+	// Field ProposalPOL (#9)
+	if len(bz) == 0 {
+		goo.ProposalPOL = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 9 {
+		goo.ProposalPOL = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 9 {
+			return fmt.Errorf("expected field # 9 of cstypes.PeerRoundState, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 9 of cstypes.PeerRoundState, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if goo.ProposalPOL == nil {
+			goo.ProposalPOL = new(bitarray.BitArray)
+		}
+		if err := goo.ProposalPOL.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Prevotes (#10)
+	if len(bz) == 0 {
+		goo.Prevotes = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 10 {
+		goo.Prevotes = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 10 {
+			return fmt.Errorf("expected field # 10 of cstypes.PeerRoundState, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 10 of cstypes.PeerRoundState, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if goo.Prevotes == nil {
+			goo.Prevotes = new(bitarray.BitArray)
+		}
+		if err := goo.Prevotes.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Precommits (#11)
+	if len(bz) == 0 {
+		goo.Precommits = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 11 {
+		goo.Precommits = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 11 {
+			return fmt.Errorf("expected field # 11 of cstypes.PeerRoundState, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 11 of cstypes.PeerRoundState, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if goo.Precommits == nil {
+			goo.Precommits = new(bitarray.BitArray)
+		}
+		if err := goo.Precommits.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field LastCommitRound (#12)
+	if len(bz) == 0 {
+		goo.LastCommitRound = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 12 {
+		goo.LastCommitRound = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 12 {
+			return fmt.Errorf("expected field # 12 of cstypes.PeerRoundState, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 12 of cstypes.PeerRoundState, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.LastCommitRound = int(v)
+	}
+	// Field LastCommit (#13)
+	if len(bz) == 0 {
+		goo.LastCommit = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 13 {
+		goo.LastCommit = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 13 {
+			return fmt.Errorf("expected field # 13 of cstypes.PeerRoundState, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 13 of cstypes.PeerRoundState, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if goo.LastCommit == nil {
+			goo.LastCommit = new(bitarray.BitArray)
+		}
+		if err := goo.LastCommit.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field CatchupCommitRound (#14)
+	if len(bz) == 0 {
+		goo.CatchupCommitRound = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 14 {
+		goo.CatchupCommitRound = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 14 {
+			return fmt.Errorf("expected field # 14 of cstypes.PeerRoundState, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 14 of cstypes.PeerRoundState, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.CatchupCommitRound = int(v)
+	}
+	// Field CatchupCommit (#15)
+	if len(bz) == 0 {
+		goo.CatchupCommit = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 15 {
+		goo.CatchupCommit = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 15 {
+			return fmt.Errorf("expected field # 15 of cstypes.PeerRoundState, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 15 of cstypes.PeerRoundState, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if goo.CatchupCommit == nil {
+			goo.CatchupCommit = new(bitarray.BitArray)
+		}
+		if err := goo.CatchupCommit.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo *HeightVoteSet) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	return nil
+}
+
+func (goo *HeightVoteSet) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo EventNewRoundStep) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field HRS (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.HRS.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field SecondsSinceStartTime (#2)
+	if goo.SecondsSinceStartTime != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.SecondsSinceStartTime)); err != nil {
+			return
+		}
+	}
+	// Field LastCommitRound (#3)
+	if goo.LastCommitRound != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.LastCommitRound)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *EventNewRoundStep) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field HRS (#1)
+	if len(bz) == 0 {
+		goo.HRS = HRS{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.HRS = HRS{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of cstypes.EventNewRoundStep, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of cstypes.EventNewRoundStep, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.HRS.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field SecondsSinceStartTime (#2)
+	if len(bz) == 0 {
+		goo.SecondsSinceStartTime = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.SecondsSinceStartTime = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of cstypes.EventNewRoundStep, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 2 of cstypes.EventNewRoundStep, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.SecondsSinceStartTime = int(v)
+	}
+	// Field LastCommitRound (#3)
+	if len(bz) == 0 {
+		goo.LastCommitRound = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.LastCommitRound = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of cstypes.EventNewRoundStep, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 3 of cstypes.EventNewRoundStep, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.LastCommitRound = int(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo EventNewValidBlock) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field HRS (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.HRS.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field BlockPartsHeader (#2)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.BlockPartsHeader.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field BlockParts (#3)
+	if goo.BlockParts != nil {
+		buf2 := new(bytes.Buffer)
+		if err = goo.BlockParts.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3ByteLength); err != nil {
+			return
+		}
+		if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+			return
+		}
+	}
+	// Field IsCommit (#4)
+	if goo.IsCommit {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 4, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeBool(buf, bool(goo.IsCommit)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *EventNewValidBlock) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field HRS (#1)
+	if len(bz) == 0 {
+		goo.HRS = HRS{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.HRS = HRS{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of cstypes.EventNewValidBlock, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of cstypes.EventNewValidBlock, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.HRS.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field BlockPartsHeader (#2)
+	if len(bz) == 0 {
+		goo.BlockPartsHeader = types.PartSetHeader{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.BlockPartsHeader = types.PartSetHeader{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of cstypes.EventNewValidBlock, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of cstypes.EventNewValidBlock, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.BlockPartsHeader.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field BlockParts (#3)
+	if len(bz) == 0 {
+		goo.BlockParts = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.BlockParts = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of cstypes.EventNewValidBlock, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 3 of cstypes.EventNewValidBlock, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if goo.BlockParts == nil {
+			goo.BlockParts = new(bitarray.BitArray)
+		}
+		if err := goo.BlockParts.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field IsCommit (#4)
+	if len(bz) == 0 {
+		goo.IsCommit = false
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 4 {
+		goo.IsCommit = false
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 4 {
+			return fmt.Errorf("expected field # 4 of cstypes.EventNewValidBlock, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 4 of cstypes.EventNewValidBlock, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeBool(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.IsCommit = bool(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo EventNewRound) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field HRS (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.HRS.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field Proposer (#2)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.Proposer.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field ProposerIndex (#3)
+	if goo.ProposerIndex != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeVarint(buf, int64(goo.ProposerIndex)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *EventNewRound) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field HRS (#1)
+	if len(bz) == 0 {
+		goo.HRS = HRS{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.HRS = HRS{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of cstypes.EventNewRound, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of cstypes.EventNewRound, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.HRS.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field Proposer (#2)
+	if len(bz) == 0 {
+		goo.Proposer = types.Validator{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.Proposer = types.Validator{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of cstypes.EventNewRound, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of cstypes.EventNewRound, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Proposer.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field ProposerIndex (#3)
+	if len(bz) == 0 {
+		goo.ProposerIndex = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.ProposerIndex = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of cstypes.EventNewRound, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 3 of cstypes.EventNewRound, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeVarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.ProposerIndex = int(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo EventCompleteProposal) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field HRS (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.HRS.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	// Field BlockID (#2)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.BlockID.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	return nil
+}
+
+func (goo *EventCompleteProposal) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field HRS (#1)
+	if len(bz) == 0 {
+		goo.HRS = HRS{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.HRS = HRS{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of cstypes.EventCompleteProposal, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of cstypes.EventCompleteProposal, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.HRS.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	// Field BlockID (#2)
+	if len(bz) == 0 {
+		goo.BlockID = types.BlockID{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.BlockID = types.BlockID{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of cstypes.EventCompleteProposal, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of cstypes.EventCompleteProposal, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.BlockID.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo EventTimeoutPropose) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field HRS (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.HRS.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	return nil
+}
+
+func (goo *EventTimeoutPropose) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field HRS (#1)
+	if len(bz) == 0 {
+		goo.HRS = HRS{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.HRS = HRS{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of cstypes.EventTimeoutPropose, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of cstypes.EventTimeoutPropose, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.HRS.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo EventTimeoutWait) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field HRS (#1)
+	{
+		buf2 := new(bytes.Buffer)
+		if err = goo.HRS.MarshalBinary2(cdc, buf2); err != nil {
+			return
+		}
+		if buf2.Len() != 0 {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeByteSlice(buf, buf2.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+	return nil
+}
+
+func (goo *EventTimeoutWait) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field HRS (#1)
+	if len(bz) == 0 {
+		goo.HRS = HRS{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.HRS = HRS{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of cstypes.EventTimeoutWait, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of cstypes.EventTimeoutWait, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.HRS.UnmarshalBinary2(cdc, v); err != nil {
+			return err
+		}
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
@@ -4,6 +4,7 @@ import (
 	"crypto/rand"
 	"crypto/sha256"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -66,7 +67,7 @@ func TestCacheAfterUpdate(t *testing.T) {
 			tx := types.Tx{byte(v)}
 			updateTxs = append(updateTxs, tx)
 		}
-		mempool.Update(int64(tcIndex), updateTxs, abciResponses(len(updateTxs), nil), nil, 0)
+		mempool.Update(int64(tcIndex), time.Time{}, updateTxs, abciResponses(len(updateTxs), nil), nil, 0)
 
 		for _, v := range tc.reAddIndices {
 			tx := types.Tx{byte(v)}
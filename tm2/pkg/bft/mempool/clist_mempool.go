@@ -358,9 +358,11 @@ func (mem *CListMempool) resCbFirstTime(tx []byte, peerID uint16, res abci.Respo
 	case abci.ResponseCheckTx:
 		if res.Error == nil {
 			memTx := &mempoolTx{
-				height:    mem.height,
-				gasWanted: res.GasWanted,
-				tx:        tx,
+				height:        mem.height,
+				gasWanted:     res.GasWanted,
+				timeoutHeight: res.TimeoutHeight,
+				timeoutTime:   res.TimeoutTime,
+				tx:            tx,
 			}
 			memTx.senders.Store(peerID, true)
 			mem.addTx(memTx)
@@ -506,6 +508,7 @@ func (mem *CListMempool) ReapMaxTxs(max int) types.Txs {
 
 func (mem *CListMempool) Update(
 	height int64,
+	blockTime time.Time,
 	txs types.Txs,
 	deliverTxResponses []abci.ResponseDeliverTx,
 	preCheck PreCheckFunc,
@@ -546,6 +549,9 @@ func (mem *CListMempool) Update(
 		}
 	}
 
+	// Remove txs which can't be included in the next blocks anymore.
+	mem.removeExpiredTxs(height, blockTime)
+
 	// Either recheck non-committed txs to see if they became invalid
 	// or just notify there're some txs left.
 	if mem.Size() > 0 {
@@ -563,6 +569,19 @@ func (mem *CListMempool) Update(
 	return nil
 }
 
+// removeExpiredTxs removes the txs past their timeout height after the
+// block at height, or past their timeout time at blockTime.
+func (mem *CListMempool) removeExpiredTxs(height int64, blockTime time.Time) {
+	for e := mem.txs.Front(); e != nil; e = e.Next() {
+		memTx := e.Value.(*mempoolTx)
+		if memTx.isExpired(height, blockTime) {
+			mem.logger.Info("Tx expired", "tx", txID(memTx.tx), "height", height)
+			// NOTE: the tx stays in the cache, it can't become valid again.
+			mem.removeTx(memTx.tx, e, false)
+		}
+	}
+}
+
 func (mem *CListMempool) recheckTxs() {
 	if mem.Size() == 0 {
 		panic("recheckTxs is called, but the mempool is empty")
@@ -602,9 +621,11 @@ func (mem *CListMempool) recheckTxs() {
 
 // mempoolTx is a transaction that successfully ran
 type mempoolTx struct {
-	height    int64    // height that this tx had been validated in
-	gasWanted int64    // amount of gas this tx states it will require
-	tx        types.Tx //
+	height        int64    // height that this tx had been validated in
+	gasWanted     int64    // amount of gas this tx states it will require
+	timeoutHeight int64    // last height the tx is valid in, if positive
+	timeoutTime   int64    // last time (unix seconds) the tx is valid at, if positive
+	tx            types.Tx //
 
 	// ids of peers who've sent us this tx (as a map for quick lookups).
 	// senders: PeerID -> bool
@@ -616,6 +637,19 @@ func (memTx *mempoolTx) Height() int64 {
 	return atomic.LoadInt64(&memTx.height)
 }
 
+// isExpired returns true if the tx can't be included in the blocks after
+// the block at height and blockTime. Block times are increasing, but the
+// next block may be in the same second.
+func (memTx *mempoolTx) isExpired(height int64, blockTime time.Time) bool {
+	if memTx.timeoutHeight > 0 && height >= memTx.timeoutHeight {
+		return true
+	}
+	if memTx.timeoutTime > 0 && blockTime.Unix() > memTx.timeoutTime {
+		return true
+	}
+	return false
+}
+
 // --------------------------------------------------------------------------------
 
 type txCache interface {
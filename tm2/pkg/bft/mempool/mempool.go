@@ -1,6 +1,8 @@
 package mempool
 
 import (
+	"time"
+
 	abci "github.com/gnolang/gno/tm2/pkg/bft/abci/types"
 	"github.com/gnolang/gno/tm2/pkg/bft/types"
 )
@@ -39,9 +41,10 @@ type Mempool interface {
 	Unlock()
 
 	// Update informs the mempool that the given txs were committed and can be discarded.
+	// Txs which expire after blockHeight and blockTime are discarded too.
 	// NOTE: this should be called *after* block is committed by consensus.
 	// NOTE: unsafe; Lock/Unlock must be managed by caller
-	Update(blockHeight int64, blockTxs types.Txs, deliverTxResponses []abci.ResponseDeliverTx, newPreFn PreCheckFunc, maxTxBytes int64) error
+	Update(blockHeight int64, blockTime time.Time, blockTxs types.Txs, deliverTxResponses []abci.ResponseDeliverTx, newPreFn PreCheckFunc, maxTxBytes int64) error
 
 	// FlushAppConn flushes the mempool connection to ensure async reqResCb calls are
 	// done. E.g. from CheckTx.
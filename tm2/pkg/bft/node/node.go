@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/gnolang/cors"
+	"google.golang.org/grpc"
 
 	"github.com/gnolang/gno/tm2/pkg/amino"
 	abci "github.com/gnolang/gno/tm2/pkg/bft/abci/types"
@@ -23,6 +24,7 @@ import (
 	"github.com/gnolang/gno/tm2/pkg/bft/proxy"
 	rpccore "github.com/gnolang/gno/tm2/pkg/bft/rpc/core"
 	_ "github.com/gnolang/gno/tm2/pkg/bft/rpc/core/types"
+	coregrpc "github.com/gnolang/gno/tm2/pkg/bft/rpc/grpc"
 	rpcserver "github.com/gnolang/gno/tm2/pkg/bft/rpc/lib/server"
 	sm "github.com/gnolang/gno/tm2/pkg/bft/state"
 	"github.com/gnolang/gno/tm2/pkg/bft/state/txindex"
@@ -166,6 +168,7 @@ type Node struct {
 	consensusReactor *cs.ConsensusReactor // for participating in the consensus
 	proxyApp         proxy.AppConns       // connection to the application
 	rpcListeners     []net.Listener       // rpc servers
+	grpcServer       *grpc.Server         // grpc server, if grpc_laddr is set
 	txIndexer        txindex.TxIndexer
 	indexerService   *txindex.IndexerService
 }
@@ -488,6 +491,7 @@ func NewNode(config *cfg.Config,
 		logger.With("module", "state"),
 		proxyApp.Consensus(),
 		mempool,
+		sm.BlockExecutorWithPruning(blockStore, config.Pruning),
 	)
 
 	// Make BlockchainReactor
@@ -647,6 +651,10 @@ func (n *Node) OnStop() {
 			n.Logger.Error("Error closing listener", "listener", l, "err", err)
 		}
 	}
+	if n.grpcServer != nil {
+		n.Logger.Info("Stopping grpc server")
+		n.grpcServer.Stop()
+	}
 
 	if pvsc, ok := n.privValidator.(service.Service); ok {
 		pvsc.Stop()
@@ -754,6 +762,29 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 		n.config.RPC.ListenAddress = joinListenerAddresses(listeners)
 	}
 
+	// we expose a simplified api over grpc, for clients generated from
+	// the proto3 schema of coregrpc.
+	if grpcListenAddr := n.config.RPC.GRPCListenAddress; grpcListenAddr != "" {
+		config := rpcserver.DefaultConfig()
+		config.MaxOpenConnections = n.config.RPC.GRPCMaxOpenConnections
+		listener, err := rpcserver.Listen(grpcListenAddr, config)
+		if err != nil {
+			return nil, err
+		}
+		if strings.HasPrefix(grpcListenAddr, "tcp://") && strings.HasSuffix(grpcListenAddr, ":0") {
+			n.config.RPC.GRPCListenAddress = joinListenerAddresses([]net.Listener{listener})
+		}
+
+		grpcLogger := n.Logger.With("module", "grpc-server")
+		n.grpcServer = coregrpc.NewServer(n.blockStore, n.evsw)
+		go func() {
+			if err := n.grpcServer.Serve(listener); err != nil {
+				grpcLogger.Error("Error serving grpc", "err", err)
+			}
+		}()
+		grpcLogger.Info("Serving grpc", "laddr", listener.Addr())
+	}
+
 	return listeners, nil
 }
 
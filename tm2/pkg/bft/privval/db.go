@@ -0,0 +1,250 @@
+package privval
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"sync"
+
+	"github.com/gnolang/gno/tm2/pkg/amino"
+	"github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/gnolang/gno/tm2/pkg/crypto"
+	dbm "github.com/gnolang/gno/tm2/pkg/db"
+)
+
+// Prefix of the db keys of the signed records.
+var signedKeyPrefix = []byte("signed/")
+
+// Returns the db key of the record signed at height/round/step.
+// Keys sort in the order of height, round and step.
+func signedKey(height int64, round int, step int8) []byte {
+	key := make([]byte, len(signedKeyPrefix)+8+4+1)
+	n := copy(key, signedKeyPrefix)
+	binary.BigEndian.PutUint64(key[n:], uint64(height))
+	binary.BigEndian.PutUint32(key[n+8:], uint32(round))
+	key[n+12] = byte(step)
+	return key
+}
+
+//-------------------------------------------------------------------------------
+
+// DBPV implements PrivValidator using a signing history persisted to a
+// database to prevent double signing.
+// Unlike FilePV, which only remembers the last signed height/round/step, DBPV
+// records every signature, so that the same request coming from multiple
+// nodes (e.g. sentries of the same validator) is answered with the same
+// signature, even after the signer signed for a later step.
+// A DBPV is safe for concurrent use, and may be shared by several SignerServers.
+type DBPV struct {
+	privKey crypto.PrivKey
+	db      dbm.DB
+
+	mtx sync.Mutex
+}
+
+// NewDBPV returns a DBPV signing with privKey, and recording its signatures
+// in db.  The db must not be shared with other signers of the same key.
+func NewDBPV(privKey crypto.PrivKey, db dbm.DB) *DBPV {
+	return &DBPV{
+		privKey: privKey,
+		db:      db,
+	}
+}
+
+// GetAddress returns the address of the validator.
+// Implements PrivValidator.
+func (pv *DBPV) GetAddress() types.Address {
+	return pv.privKey.PubKey().Address()
+}
+
+// GetPubKey returns the public key of the validator.
+// Implements PrivValidator.
+func (pv *DBPV) GetPubKey() crypto.PubKey {
+	return pv.privKey.PubKey()
+}
+
+// SignVote signs a canonical representation of the vote, along with the
+// chainID. Implements PrivValidator.
+func (pv *DBPV) SignVote(chainID string, vote *types.Vote) error {
+	pv.mtx.Lock()
+	defer pv.mtx.Unlock()
+
+	if err := pv.signVote(chainID, vote); err != nil {
+		return fmt.Errorf("error signing vote: %w", err)
+	}
+	return nil
+}
+
+// SignProposal signs a canonical representation of the proposal, along with
+// the chainID. Implements PrivValidator.
+func (pv *DBPV) SignProposal(chainID string, proposal *types.Proposal) error {
+	pv.mtx.Lock()
+	defer pv.mtx.Unlock()
+
+	if err := pv.signProposal(chainID, proposal); err != nil {
+		return fmt.Errorf("error signing proposal: %w", err)
+	}
+	return nil
+}
+
+// LastSignState returns the last signed height/round/step, with its
+// signature, or nil if nothing was signed yet.
+func (pv *DBPV) LastSignState() *FilePVLastSignState {
+	pv.mtx.Lock()
+	defer pv.mtx.Unlock()
+
+	return pv.lastSigned()
+}
+
+// ImportLastSignState records lss, e.g. the last sign state of a FilePV
+// migrated to the DBPV, so that nothing before it gets signed.
+// It fails if anything was already signed at or after lss.
+func (pv *DBPV) ImportLastSignState(lss FilePVLastSignState) error {
+	pv.mtx.Lock()
+	defer pv.mtx.Unlock()
+
+	if lss.Step == stepNone {
+		return nil // nothing was signed.
+	}
+	if lss.SignBytes == nil || lss.Signature == nil {
+		return fmt.Errorf("missing signature at height %v round %v step %v", lss.Height, lss.Round, lss.Step)
+	}
+	if last := pv.lastSigned(); last != nil {
+		sameHRS, err := last.CheckHRS(lss.Height, lss.Round, lss.Step)
+		if err != nil {
+			return err
+		}
+		if sameHRS {
+			return fmt.Errorf("already signed at height %v round %v step %v", lss.Height, lss.Round, lss.Step)
+		}
+	}
+
+	pv.db.SetSync(signedKey(lss.Height, lss.Round, lss.Step), amino.MustMarshal(lss))
+	return nil
+}
+
+// String returns a string representation of the DBPV.
+func (pv *DBPV) String() string {
+	lss := pv.LastSignState()
+	if lss == nil {
+		return fmt.Sprintf("PrivValidator{%v}", pv.GetAddress())
+	}
+	return fmt.Sprintf("PrivValidator{%v LH:%v, LR:%v, LS:%v}", pv.GetAddress(), lss.Height, lss.Round, lss.Step)
+}
+
+//------------------------------------------------------------------------------------
+
+// signVote checks if the vote is good to sign and sets the vote signature.
+// If the same height/round/step was already signed, the previous signature
+// is reused, possibly with its timestamp.
+func (pv *DBPV) signVote(chainID string, vote *types.Vote) error {
+	height, round, step := vote.Height, vote.Round, voteToStep(vote)
+	signBytes := vote.SignBytes(chainID)
+
+	signed := pv.getSigned(height, round, step)
+	if signed != nil {
+		if bytes.Equal(signBytes, signed.SignBytes) {
+			vote.Signature = signed.Signature
+		} else if timestamp, ok := checkVotesOnlyDifferByTimestamp(signed.SignBytes, signBytes); ok {
+			vote.Timestamp = timestamp
+			vote.Signature = signed.Signature
+		} else {
+			return fmt.Errorf("conflicting data at height %v round %v step %v", height, round, step)
+		}
+		return nil
+	}
+
+	sig, err := pv.sign(height, round, step, signBytes)
+	if err != nil {
+		return err
+	}
+	vote.Signature = sig
+	return nil
+}
+
+// signProposal checks if the proposal is good to sign and sets the proposal
+// signature.
+// If the same height/round/step was already signed, the previous signature
+// is reused, possibly with its timestamp.
+func (pv *DBPV) signProposal(chainID string, proposal *types.Proposal) error {
+	height, round, step := proposal.Height, proposal.Round, stepPropose
+	signBytes := proposal.SignBytes(chainID)
+
+	signed := pv.getSigned(height, round, step)
+	if signed != nil {
+		if bytes.Equal(signBytes, signed.SignBytes) {
+			proposal.Signature = signed.Signature
+		} else if timestamp, ok := checkProposalsOnlyDifferByTimestamp(signed.SignBytes, signBytes); ok {
+			proposal.Timestamp = timestamp
+			proposal.Signature = signed.Signature
+		} else {
+			return fmt.Errorf("conflicting data at height %v round %v step %v", height, round, step)
+		}
+		return nil
+	}
+
+	sig, err := pv.sign(height, round, step, signBytes)
+	if err != nil {
+		return err
+	}
+	proposal.Signature = sig
+	return nil
+}
+
+// sign signs signBytes, which were not signed yet, if height/round/step
+// doesn't regress, and persists the signature before returning it.
+func (pv *DBPV) sign(height int64, round int, step int8, signBytes []byte) ([]byte, error) {
+	if last := pv.lastSigned(); last != nil {
+		if _, err := last.CheckHRS(height, round, step); err != nil {
+			return nil, err
+		}
+	}
+
+	sig, err := pv.privKey.Sign(signBytes)
+	if err != nil {
+		return nil, err
+	}
+	signed := FilePVLastSignState{
+		Height:    height,
+		Round:     round,
+		Step:      step,
+		Signature: sig,
+		SignBytes: signBytes,
+	}
+	pv.db.SetSync(signedKey(height, round, step), amino.MustMarshal(signed))
+	return sig, nil
+}
+
+// Returns the record signed at height/round/step, or nil.
+func (pv *DBPV) getSigned(height int64, round int, step int8) *FilePVLastSignState {
+	bz := pv.db.Get(signedKey(height, round, step))
+	if bz == nil {
+		return nil
+	}
+	return mustUnmarshalSigned(bz)
+}
+
+// Returns the record of the greatest height/round/step, or nil.
+func (pv *DBPV) lastSigned() *FilePVLastSignState {
+	itr := pv.db.ReverseIterator(signedKeyPrefix, prefixEnd(signedKeyPrefix))
+	defer itr.Close()
+
+	if !itr.Valid() {
+		return nil
+	}
+	return mustUnmarshalSigned(itr.Value())
+}
+
+func mustUnmarshalSigned(bz []byte) *FilePVLastSignState {
+	signed := new(FilePVLastSignState)
+	amino.MustUnmarshal(bz, signed)
+	return signed
+}
+
+// Returns the end of the domain of the keys starting with prefix.
+func prefixEnd(prefix []byte) []byte {
+	end := make([]byte, len(prefix))
+	copy(end, prefix)
+	end[len(end)-1]++
+	return end
+}
@@ -0,0 +1,129 @@
+package privval
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/gnolang/gno/tm2/pkg/crypto/ed25519"
+	dbm "github.com/gnolang/gno/tm2/pkg/db"
+)
+
+func TestDBPVSignVote(t *testing.T) {
+	assert := assert.New(t)
+
+	privVal := NewDBPV(ed25519.GenPrivKey(), dbm.NewMemDB())
+	addr := privVal.GetAddress()
+
+	block1 := types.BlockID{Hash: []byte{1, 2, 3}, PartsHeader: types.PartSetHeader{}}
+	block2 := types.BlockID{Hash: []byte{3, 2, 1}, PartsHeader: types.PartSetHeader{}}
+
+	height, round := int64(10), 1
+	voteType := byte(types.PrevoteType)
+
+	// sign a vote for first time
+	vote := newVote(addr, 0, height, round, voteType, block1)
+	err := privVal.SignVote("mychainid", vote)
+	assert.NoError(err, "expected no error signing vote")
+
+	// try to sign the same vote again; should be fine
+	err = privVal.SignVote("mychainid", vote)
+	assert.NoError(err, "expected no error on signing same vote")
+
+	// now try some bad votes
+	cases := []*types.Vote{
+		newVote(addr, 0, height, round-1, voteType, block1),   // round regression
+		newVote(addr, 0, height-1, round, voteType, block1),   // height regression
+		newVote(addr, 0, height-2, round+4, voteType, block1), // height regression and different round
+		newVote(addr, 0, height, round, voteType, block2),     // different block
+	}
+
+	for _, c := range cases {
+		err = privVal.SignVote("mychainid", c)
+		assert.Error(err, "expected error on signing conflicting vote")
+	}
+
+	// try signing a vote with a different time stamp
+	sig := vote.Signature
+	vote.Timestamp = vote.Timestamp.Add(time.Duration(1000))
+	err = privVal.SignVote("mychainid", vote)
+	assert.NoError(err)
+	assert.Equal(sig, vote.Signature)
+}
+
+func TestDBPVSignProposal(t *testing.T) {
+	assert := assert.New(t)
+
+	privVal := NewDBPV(ed25519.GenPrivKey(), dbm.NewMemDB())
+
+	block1 := types.BlockID{Hash: []byte{1, 2, 3}, PartsHeader: types.PartSetHeader{Total: 5, Hash: []byte{1, 2, 3}}}
+	block2 := types.BlockID{Hash: []byte{3, 2, 1}, PartsHeader: types.PartSetHeader{Total: 10, Hash: []byte{3, 2, 1}}}
+	height, round := int64(10), 1
+
+	// sign a proposal for first time
+	proposal := newProposal(height, round, block1)
+	err := privVal.SignProposal("mychainid", proposal)
+	assert.NoError(err, "expected no error signing proposal")
+
+	// now try some bad Proposals
+	cases := []*types.Proposal{
+		newProposal(height, round-1, block1),   // round regression
+		newProposal(height-1, round, block1),   // height regression
+		newProposal(height-2, round+4, block1), // height regression and different round
+		newProposal(height, round, block2),     // different block
+	}
+
+	for _, c := range cases {
+		err = privVal.SignProposal("mychainid", c)
+		assert.Error(err, "expected error on signing conflicting proposal")
+	}
+
+	// try signing a proposal with a different time stamp
+	sig := proposal.Signature
+	proposal.Timestamp = proposal.Timestamp.Add(time.Duration(1000))
+	err = privVal.SignProposal("mychainid", proposal)
+	assert.NoError(err)
+	assert.Equal(sig, proposal.Signature)
+}
+
+// A request of a sentry lagging behind gets the signature given to another
+// sentry, even after later steps were signed, and the history survives
+// restarts.
+func TestDBPVSignHistory(t *testing.T) {
+	db := dbm.NewMemDB()
+	privKey := ed25519.GenPrivKey()
+	privVal := NewDBPV(privKey, db)
+	addr := privVal.GetAddress()
+
+	block := types.BlockID{Hash: []byte{1, 2, 3}, PartsHeader: types.PartSetHeader{}}
+	height, round := int64(10), 1
+
+	prevote := newVote(addr, 0, height, round, byte(types.PrevoteType), block)
+	require.NoError(t, privVal.SignVote("mychainid", prevote))
+	precommit := newVote(addr, 0, height, round, byte(types.PrecommitType), block)
+	require.NoError(t, privVal.SignVote("mychainid", precommit))
+
+	// Restart the signer.
+	privVal = NewDBPV(privKey, db)
+	lss := privVal.LastSignState()
+	require.NotNil(t, lss)
+	assert.Equal(t, height, lss.Height)
+	assert.Equal(t, stepPrecommit, lss.Step)
+
+	// Same prevote from another sentry, with its own timestamp.
+	prevote2 := newVote(addr, 0, height, round, byte(types.PrevoteType), block)
+	prevote2.Timestamp = prevote.Timestamp.Add(time.Second)
+	require.NoError(t, privVal.SignVote("mychainid", prevote2))
+	assert.Equal(t, prevote.Signature, prevote2.Signature)
+	assert.Equal(t, prevote.Timestamp, prevote2.Timestamp)
+
+	// A conflicting prevote is refused.
+	other := types.BlockID{Hash: []byte{3, 2, 1}, PartsHeader: types.PartSetHeader{}}
+	assert.Error(t, privVal.SignVote("mychainid", newVote(addr, 0, height, round, byte(types.PrevoteType), other)))
+
+	// Later heights are signed.
+	assert.NoError(t, privVal.SignVote("mychainid", newVote(addr, 0, height+1, 0, byte(types.PrevoteType), block)))
+}
@@ -18,6 +18,9 @@ type AppConnConsensus interface {
 	DeliverTxAsync(abci.RequestDeliverTx) *abcicli.ReqRes
 	EndBlockSync(abci.RequestEndBlock) (abci.ResponseEndBlock, error)
 	CommitSync() (abci.ResponseCommit, error)
+
+	PrepareProposalSync(abci.RequestPrepareProposal) (abci.ResponsePrepareProposal, error)
+	ProcessProposalSync(abci.RequestProcessProposal) (abci.ResponseProcessProposal, error)
 }
 
 type AppConnMempool interface {
@@ -81,6 +84,14 @@ func (app *appConnConsensus) CommitSync() (abci.ResponseCommit, error) {
 	return app.appConn.CommitSync()
 }
 
+func (app *appConnConsensus) PrepareProposalSync(req abci.RequestPrepareProposal) (abci.ResponsePrepareProposal, error) {
+	return app.appConn.PrepareProposalSync(req)
+}
+
+func (app *appConnConsensus) ProcessProposalSync(req abci.RequestProcessProposal) (abci.ResponseProcessProposal, error) {
+	return app.appConn.ProcessProposalSync(req)
+}
+
 //------------------------------------------------
 // Implements AppConnMempool (subset of abcicli.Client)
 
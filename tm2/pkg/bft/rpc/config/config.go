@@ -34,7 +34,9 @@ type RPCConfig struct {
 	CORSAllowedHeaders []string `toml:"cors_allowed_headers"`
 
 	// TCP or UNIX socket address for the gRPC server to listen on
-	// NOTE: This server only supports /broadcast_tx_commit
+	// NOTE: The service (broadcast, query, status and block streaming) is
+	// defined in tm2/pkg/bft/rpc/grpc/coregrpc.proto, and is only served along
+	// with the RPC server (laddr)
 	GRPCListenAddress string `toml:"grpc_laddr"`
 
 	// Maximum number of simultaneous connections.
@@ -81,6 +81,8 @@ func BlockchainInfo(ctx *rpctypes.Context, minHeight, maxHeight int64) (*ctypes.
 	if err != nil {
 		return nil, err
 	}
+	// Skip the pruned blocks.
+	minHeight = maths.MaxInt64(minHeight, blockStore.Base())
 	logger.Debug("BlockchainInfoHandler", "maxHeight", maxHeight, "minHeight", minHeight)
 
 	blockMetas := []*types.BlockMeta{}
@@ -236,8 +238,7 @@ func filterMinMax(height, min, max, limit int64) (int64, int64, error) {
 //
 // ```
 func Block(ctx *rpctypes.Context, heightPtr *int64) (*ctypes.ResultBlock, error) {
-	storeHeight := blockStore.Height()
-	height, err := getHeight(storeHeight, heightPtr)
+	height, err := getBlockHeight(heightPtr)
 	if err != nil {
 		return nil, err
 	}
@@ -328,7 +329,7 @@ func Block(ctx *rpctypes.Context, heightPtr *int64) (*ctypes.ResultBlock, error)
 // ```
 func Commit(ctx *rpctypes.Context, heightPtr *int64) (*ctypes.ResultCommit, error) {
 	storeHeight := blockStore.Height()
-	height, err := getHeight(storeHeight, heightPtr)
+	height, err := getBlockHeight(heightPtr)
 	if err != nil {
 		return nil, err
 	}
@@ -400,8 +401,7 @@ func Commit(ctx *rpctypes.Context, heightPtr *int64) (*ctypes.ResultCommit, erro
 //
 // ```
 func BlockResults(ctx *rpctypes.Context, heightPtr *int64) (*ctypes.ResultBlockResults, error) {
-	storeHeight := blockStore.Height()
-	height, err := getHeight(storeHeight, heightPtr)
+	height, err := getBlockHeight(heightPtr)
 	if err != nil {
 		return nil, err
 	}
@@ -431,3 +431,16 @@ func getHeight(currentHeight int64, heightPtr *int64) (int64, error) {
 	}
 	return currentHeight, nil
 }
+
+// getBlockHeight is like getHeight for the heights of the block store,
+// which must not have been pruned.
+func getBlockHeight(heightPtr *int64) (int64, error) {
+	height, err := getHeight(blockStore.Height(), heightPtr)
+	if err != nil {
+		return 0, err
+	}
+	if base := blockStore.Base(); height < base {
+		return 0, fmt.Errorf("height %d is not available, blocks were pruned below height %d", height, base)
+	}
+	return height, nil
+}
@@ -58,6 +58,7 @@ import (
 //	  		"latest_app_hash": "0000000000000000",
 //	  		"latest_block_height": "18",
 //	  		"latest_block_time": "2018-09-17T11:42:19.149920551Z",
+//	  		"earliest_block_height": "1",
 //	  		"catching_up": false
 //	  	},
 //	  	"validator_info": {
@@ -102,11 +103,12 @@ func Status(ctx *rpctypes.Context) (*ctypes.ResultStatus, error) {
 	result := &ctypes.ResultStatus{
 		NodeInfo: p2pTransport.NodeInfo(),
 		SyncInfo: ctypes.SyncInfo{
-			LatestBlockHash:   latestBlockHash,
-			LatestAppHash:     latestAppHash,
-			LatestBlockHeight: latestHeight,
-			LatestBlockTime:   latestBlockTime,
-			CatchingUp:        consensusReactor.FastSync(),
+			LatestBlockHash:     latestBlockHash,
+			LatestAppHash:       latestAppHash,
+			LatestBlockHeight:   latestHeight,
+			LatestBlockTime:     latestBlockTime,
+			EarliestBlockHeight: blockStore.Base(),
+			CatchingUp:          consensusReactor.FastSync(),
 		},
 		ValidatorInfo: ctypes.ValidatorInfo{
 			Address:     pubKey.Address(),
@@ -58,11 +58,12 @@ func NewResultCommit(header *types.Header, commit *types.Commit,
 
 // Info about the node's syncing state
 type SyncInfo struct {
-	LatestBlockHash   []byte    `json:"latest_block_hash"`
-	LatestAppHash     []byte    `json:"latest_app_hash"`
-	LatestBlockHeight int64     `json:"latest_block_height"`
-	LatestBlockTime   time.Time `json:"latest_block_time"`
-	CatchingUp        bool      `json:"catching_up"`
+	LatestBlockHash     []byte    `json:"latest_block_hash"`
+	LatestAppHash       []byte    `json:"latest_app_hash"`
+	LatestBlockHeight   int64     `json:"latest_block_height"`
+	LatestBlockTime     time.Time `json:"latest_block_time"`
+	EarliestBlockHeight int64     `json:"earliest_block_height"`
+	CatchingUp          bool      `json:"catching_up"`
 }
 
 // Info about the node's validator
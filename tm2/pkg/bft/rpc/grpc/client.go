@@ -0,0 +1,99 @@
+package coregrpc
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	osm "github.com/gnolang/gno/tm2/pkg/os"
+)
+
+// Client is a client of the NodeService, served at the grpc_laddr of nodes.
+type Client struct {
+	conn *grpc.ClientConn
+}
+
+// NewClient returns a client of the node listening on laddr, either
+// tcp://<host:port> or unix://<path>.  Unless opts say otherwise, the
+// connection is not encrypted.
+func NewClient(laddr string, opts ...grpc.DialOption) (*Client, error) {
+	var target string
+	switch protocol, address := osm.ProtocolAndAddress(laddr); protocol {
+	case "tcp":
+		target = address
+	case "unix":
+		target = "unix://" + address
+	default:
+		return nil, fmt.Errorf("invalid address %q: expected either 'tcp' or 'unix' protocols", laddr)
+	}
+	opts = append([]grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(codec{})),
+	}, opts...)
+	conn, err := grpc.Dial(target, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{conn: conn}, nil
+}
+
+// Close closes the connection to the node.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+func (c *Client) BroadcastTx(ctx context.Context, req *RequestBroadcastTx) (*ResponseBroadcastTx, error) {
+	res := new(ResponseBroadcastTx)
+	if err := c.conn.Invoke(ctx, fullMethod("BroadcastTx"), req, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *Client) ABCIQuery(ctx context.Context, req *RequestABCIQuery) (*ResponseABCIQuery, error) {
+	res := new(ResponseABCIQuery)
+	if err := c.conn.Invoke(ctx, fullMethod("ABCIQuery"), req, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *Client) Status(ctx context.Context, req *RequestStatus) (*ResponseStatus, error) {
+	res := new(ResponseStatus)
+	if err := c.conn.Invoke(ctx, fullMethod("Status"), req, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Blocks returns a stream of the blocks requested by req, which ends when ctx
+// is done.
+func (c *Client) Blocks(ctx context.Context, req *RequestBlocks) (*BlockStream, error) {
+	stream, err := c.conn.NewStream(ctx, &serviceDesc.Streams[0], fullMethod("Blocks"))
+	if err != nil {
+		return nil, err
+	}
+	if err := stream.SendMsg(req); err != nil {
+		return nil, err
+	}
+	if err := stream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return &BlockStream{stream: stream}, nil
+}
+
+// BlockStream is a stream of blocks returned by Client.Blocks.
+type BlockStream struct {
+	stream grpc.ClientStream
+}
+
+// Recv returns the next block of the stream.
+func (bs *BlockStream) Recv() (*ResponseBlock, error) {
+	res := new(ResponseBlock)
+	if err := bs.stream.RecvMsg(res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
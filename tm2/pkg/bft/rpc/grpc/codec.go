@@ -0,0 +1,23 @@
+package coregrpc
+
+import (
+	"github.com/gnolang/gno/tm2/pkg/amino"
+)
+
+// codec encodes the gRPC messages with amino, whose binary encoding of the
+// registered types is the proto3 encoding of the generated schema.  Clients
+// generated from coregrpc.proto can therefore talk to the server.
+type codec struct{}
+
+func (codec) Marshal(v interface{}) ([]byte, error) {
+	return amino.Marshal(v)
+}
+
+func (codec) Unmarshal(data []byte, v interface{}) error {
+	return amino.Unmarshal(data, v)
+}
+
+// Name returns the content-subtype of the messages, which are proto3.
+func (codec) Name() string {
+	return "proto"
+}
@@ -0,0 +1,82 @@
+package coregrpc_test
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnolang/gno/tm2/pkg/bft/abci/example/kvstore"
+	coregrpc "github.com/gnolang/gno/tm2/pkg/bft/rpc/grpc"
+	rpctest "github.com/gnolang/gno/tm2/pkg/bft/rpc/test"
+)
+
+func TestMain(m *testing.M) {
+	// start a tendermint node in the background to test against
+	app := kvstore.NewKVStoreApplication()
+	node := rpctest.StartTendermint(app)
+
+	code := m.Run()
+
+	// and shut down proper at the end
+	rpctest.StopTendermint(node)
+	os.Exit(code)
+}
+
+func TestBroadcastTxAndQuery(t *testing.T) {
+	client := rpctest.GetGRPCClient()
+	defer client.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	res, err := client.BroadcastTx(ctx, &coregrpc.RequestBroadcastTx{Tx: []byte("grpc=yes"), Commit: true})
+	require.NoError(t, err)
+	assert.True(t, res.CheckTx.IsOK())
+	assert.True(t, res.DeliverTx.IsOK())
+	assert.NotZero(t, res.Height)
+	assert.NotEmpty(t, res.Hash)
+
+	// The transaction is checked again, and accepted by the kvstore.
+	res, err = client.BroadcastTx(ctx, &coregrpc.RequestBroadcastTx{Tx: []byte("grpc=sync")})
+	require.NoError(t, err)
+	assert.True(t, res.CheckTx.IsOK())
+	assert.Zero(t, res.Height)
+
+	qres, err := client.ABCIQuery(ctx, &coregrpc.RequestABCIQuery{Data: []byte("grpc")})
+	require.NoError(t, err)
+	assert.Equal(t, []byte("grpc"), qres.Response.Key)
+	assert.Equal(t, "exists", qres.Response.Log)
+}
+
+func TestStatus(t *testing.T) {
+	client := rpctest.GetGRPCClient()
+	defer client.Close()
+
+	res, err := client.Status(context.Background(), &coregrpc.RequestStatus{})
+	require.NoError(t, err)
+	assert.Equal(t, rpctest.GetConfig().Moniker, res.Moniker)
+	assert.NotEmpty(t, res.NodeID)
+	assert.NotEmpty(t, res.ValidatorAddress)
+	assert.EqualValues(t, 1, res.EarliestBlockHeight)
+}
+
+func TestBlocks(t *testing.T) {
+	client := rpctest.GetGRPCClient()
+	defer client.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	stream, err := client.Blocks(ctx, &coregrpc.RequestBlocks{FromHeight: 1})
+	require.NoError(t, err)
+
+	// Stored blocks, and then new blocks, are streamed in order.
+	for height := int64(1); height <= 3; height++ {
+		res, err := stream.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, height, res.Block.Height)
+		assert.Equal(t, res.Block.Hash(), res.BlockID.Hash)
+	}
+}
@@ -0,0 +1,37 @@
+package coregrpc
+
+import (
+	"github.com/gnolang/gno/tm2/pkg/amino"
+	abci "github.com/gnolang/gno/tm2/pkg/bft/abci/types"
+	"github.com/gnolang/gno/tm2/pkg/bft/types"
+)
+
+var Package = amino.RegisterPackage(amino.NewPackage(
+	"github.com/gnolang/gno/tm2/pkg/bft/rpc/grpc",
+	"coregrpc",
+	amino.GetCallersDirname(),
+).
+	WithGoPkgName("coregrpc").
+	WithDependencies(
+		abci.Package,
+		types.Package,
+	).
+	WithTypes(
+		RequestBroadcastTx{},
+		ResponseBroadcastTx{},
+		RequestABCIQuery{},
+		ResponseABCIQuery{},
+		RequestStatus{},
+		ResponseStatus{},
+		RequestBlocks{},
+		ResponseBlock{},
+	).
+	WithServices(amino.Service{
+		Name: serviceName,
+		Methods: []amino.Method{
+			{Name: "BroadcastTx", Request: RequestBroadcastTx{}, Response: ResponseBroadcastTx{}},
+			{Name: "ABCIQuery", Request: RequestABCIQuery{}, Response: ResponseABCIQuery{}},
+			{Name: "Status", Request: RequestStatus{}, Response: ResponseStatus{}},
+			{Name: "Blocks", Request: RequestBlocks{}, Response: ResponseBlock{}, ServerStreaming: true},
+		},
+	}))
@@ -0,0 +1,241 @@
+package coregrpc
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	abci "github.com/gnolang/gno/tm2/pkg/bft/abci/types"
+	rpccore "github.com/gnolang/gno/tm2/pkg/bft/rpc/core"
+	rpctypes "github.com/gnolang/gno/tm2/pkg/bft/rpc/lib/types"
+	sm "github.com/gnolang/gno/tm2/pkg/bft/state"
+	"github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/gnolang/gno/tm2/pkg/events"
+	"github.com/gnolang/gno/tm2/pkg/random"
+)
+
+const serviceName = "NodeService"
+
+// NewServer returns a gRPC server of the NodeService declared in package.go.
+// Transactions and queries are handled by rpccore, which must be set up, and
+// blocks are streamed from blockStore as evsw fires new blocks.
+func NewServer(blockStore sm.BlockStoreRPC, evsw events.EventSwitch, opts ...grpc.ServerOption) *grpc.Server {
+	opts = append(opts, grpc.ForceServerCodec(codec{}))
+	srv := grpc.NewServer(opts...)
+	srv.RegisterService(&serviceDesc, &nodeService{
+		blockStore: blockStore,
+		evsw:       evsw,
+	})
+	return srv
+}
+
+type nodeService struct {
+	blockStore sm.BlockStoreRPC
+	evsw       events.EventSwitch
+}
+
+func (ns *nodeService) BroadcastTx(_ context.Context, req *RequestBroadcastTx) (*ResponseBroadcastTx, error) {
+	ctx := &rpctypes.Context{}
+	if req.Commit {
+		res, err := rpccore.BroadcastTxCommit(ctx, req.Tx)
+		if err != nil {
+			return nil, err
+		}
+		return &ResponseBroadcastTx{
+			CheckTx:   res.CheckTx,
+			DeliverTx: res.DeliverTx,
+			Hash:      res.Hash,
+			Height:    res.Height,
+		}, nil
+	}
+	res, err := rpccore.BroadcastTxSync(ctx, req.Tx)
+	if err != nil {
+		return nil, err
+	}
+	return &ResponseBroadcastTx{
+		CheckTx: abci.ResponseCheckTx{
+			ResponseBase: abci.ResponseBase{
+				Error: res.Error,
+				Data:  res.Data,
+				Log:   res.Log,
+			},
+		},
+		Hash: res.Hash,
+	}, nil
+}
+
+func (ns *nodeService) ABCIQuery(_ context.Context, req *RequestABCIQuery) (*ResponseABCIQuery, error) {
+	res, err := rpccore.ABCIQuery(&rpctypes.Context{}, req.Path, req.Data, req.Height, req.Prove)
+	if err != nil {
+		return nil, err
+	}
+	return &ResponseABCIQuery{Response: res.Response}, nil
+}
+
+func (ns *nodeService) Status(_ context.Context, _ *RequestStatus) (*ResponseStatus, error) {
+	res, err := rpccore.Status(&rpctypes.Context{})
+	if err != nil {
+		return nil, err
+	}
+	var nodeID string
+	if res.NodeInfo.NetAddress != nil {
+		nodeID = res.NodeInfo.NetAddress.ID.String()
+	}
+	return &ResponseStatus{
+		NodeID:               nodeID,
+		Network:              res.NodeInfo.Network,
+		Moniker:              res.NodeInfo.Moniker,
+		Version:              res.NodeInfo.Version,
+		LatestBlockHash:      res.SyncInfo.LatestBlockHash,
+		LatestAppHash:        res.SyncInfo.LatestAppHash,
+		LatestBlockHeight:    res.SyncInfo.LatestBlockHeight,
+		LatestBlockTime:      res.SyncInfo.LatestBlockTime,
+		EarliestBlockHeight:  res.SyncInfo.EarliestBlockHeight,
+		CatchingUp:           res.SyncInfo.CatchingUp,
+		ValidatorAddress:     res.ValidatorInfo.Address.String(),
+		ValidatorVotingPower: res.ValidatorInfo.VotingPower,
+	}, nil
+}
+
+func (ns *nodeService) Blocks(req *RequestBlocks, stream grpc.ServerStream) error {
+	// Listen before reading the store, so that no new block is missed.
+	// A single pending notification is enough, as all the blocks up to the
+	// height of the store are sent after it.
+	newBlock := make(chan struct{}, 1)
+	listenerID := fmt.Sprintf("coregrpc#%v", random.RandStr(6))
+	ns.evsw.AddListener(listenerID, func(event events.Event) {
+		if _, ok := event.(types.EventNewBlock); !ok {
+			return
+		}
+		select {
+		case newBlock <- struct{}{}:
+		default:
+		}
+	})
+	defer ns.evsw.RemoveListener(listenerID)
+
+	height := req.FromHeight
+	switch {
+	case height < 0:
+		return status.Errorf(codes.InvalidArgument, "height must be non-negative, got %d", height)
+	case height == 0:
+		height = ns.blockStore.Height()
+		if height == 0 {
+			height = 1
+		}
+	case height < ns.blockStore.Base():
+		return status.Errorf(codes.OutOfRange, "height %d is not available, lowest height is %d",
+			height, ns.blockStore.Base())
+	}
+
+	for {
+		for ; height <= ns.blockStore.Height(); height++ {
+			meta := ns.blockStore.LoadBlockMeta(height)
+			block := ns.blockStore.LoadBlock(height)
+			if meta == nil || block == nil {
+				// Pruned while streaming.
+				return status.Errorf(codes.OutOfRange, "height %d is not available", height)
+			}
+			err := stream.SendMsg(&ResponseBlock{
+				BlockID: meta.BlockID,
+				Block:   block,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		select {
+		case <-newBlock:
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case <-ns.evsw.Quit():
+			return status.Error(codes.Unavailable, "node is stopping")
+		}
+	}
+}
+
+//----------------------------------------
+// Service description
+
+// nodeServiceServer is the server API of the NodeService.
+type nodeServiceServer interface {
+	BroadcastTx(context.Context, *RequestBroadcastTx) (*ResponseBroadcastTx, error)
+	ABCIQuery(context.Context, *RequestABCIQuery) (*ResponseABCIQuery, error)
+	Status(context.Context, *RequestStatus) (*ResponseStatus, error)
+	Blocks(*RequestBlocks, grpc.ServerStream) error
+}
+
+var serviceDesc = grpc.ServiceDesc{
+	ServiceName: fullServiceName(),
+	HandlerType: (*nodeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		unaryMethod("BroadcastTx",
+			func() interface{} { return new(RequestBroadcastTx) },
+			func(s nodeServiceServer, ctx context.Context, req interface{}) (interface{}, error) {
+				return s.BroadcastTx(ctx, req.(*RequestBroadcastTx))
+			}),
+		unaryMethod("ABCIQuery",
+			func() interface{} { return new(RequestABCIQuery) },
+			func(s nodeServiceServer, ctx context.Context, req interface{}) (interface{}, error) {
+				return s.ABCIQuery(ctx, req.(*RequestABCIQuery))
+			}),
+		unaryMethod("Status",
+			func() interface{} { return new(RequestStatus) },
+			func(s nodeServiceServer, ctx context.Context, req interface{}) (interface{}, error) {
+				return s.Status(ctx, req.(*RequestStatus))
+			}),
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName: "Blocks",
+			Handler: func(srv interface{}, stream grpc.ServerStream) error {
+				req := new(RequestBlocks)
+				if err := stream.RecvMsg(req); err != nil {
+					return err
+				}
+				return srv.(nodeServiceServer).Blocks(req, stream)
+			},
+			ServerStreams: true,
+		},
+	},
+	Metadata: Package.P3ImportPath,
+}
+
+// unaryMethod describes the unary method name, whose requests are allocated
+// with newReq and handled with call.
+func unaryMethod(
+	name string,
+	newReq func() interface{},
+	call func(s nodeServiceServer, ctx context.Context, req interface{}) (interface{}, error),
+) grpc.MethodDesc {
+	return grpc.MethodDesc{
+		MethodName: name,
+		Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+			req := newReq()
+			if err := dec(req); err != nil {
+				return nil, err
+			}
+			s := srv.(nodeServiceServer)
+			if interceptor == nil {
+				return call(s, ctx, req)
+			}
+			info := &grpc.UnaryServerInfo{Server: srv, FullMethod: fullMethod(name)}
+			return interceptor(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+				return call(s, ctx, req)
+			})
+		},
+	}
+}
+
+// fullServiceName returns the proto3 name of the NodeService.
+func fullServiceName() string {
+	return Package.P3PkgName + "." + serviceName
+}
+
+// fullMethod returns the gRPC path of the method name.
+func fullMethod(name string) string {
+	return "/" + fullServiceName() + "/" + name
+}
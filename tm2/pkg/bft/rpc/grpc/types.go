@@ -0,0 +1,65 @@
+package coregrpc
+
+import (
+	"time"
+
+	abci "github.com/gnolang/gno/tm2/pkg/bft/abci/types"
+	"github.com/gnolang/gno/tm2/pkg/bft/types"
+)
+
+// Broadcasts a transaction.  If Commit is set, waits for the transaction to
+// be included in a block, as with broadcast_tx_commit.
+type RequestBroadcastTx struct {
+	Tx     []byte
+	Commit bool
+}
+
+// DeliverTx and Height are only set for committed transactions.
+type ResponseBroadcastTx struct {
+	CheckTx   abci.ResponseCheckTx
+	DeliverTx abci.ResponseDeliverTx
+	Hash      []byte
+	Height    int64
+}
+
+type RequestABCIQuery struct {
+	Path   string
+	Data   []byte
+	Height int64
+	Prove  bool
+}
+
+type ResponseABCIQuery struct {
+	Response abci.ResponseQuery
+}
+
+type RequestStatus struct{}
+
+// A flattened ctypes.ResultStatus.
+type ResponseStatus struct {
+	NodeID  string
+	Network string
+	Moniker string
+	Version string
+
+	LatestBlockHash     []byte
+	LatestAppHash       []byte
+	LatestBlockHeight   int64
+	LatestBlockTime     time.Time
+	EarliestBlockHeight int64
+	CatchingUp          bool
+
+	ValidatorAddress     string
+	ValidatorVotingPower int64
+}
+
+// Streams the blocks from FromHeight, or from the latest block if zero, and
+// then the new blocks as they get committed.
+type RequestBlocks struct {
+	FromHeight int64
+}
+
+type ResponseBlock struct {
+	BlockID types.BlockID
+	Block   *types.Block
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/gnolang/gno/tm2/pkg/bft/privval"
 	"github.com/gnolang/gno/tm2/pkg/bft/proxy"
 	ctypes "github.com/gnolang/gno/tm2/pkg/bft/rpc/core/types"
+	coregrpc "github.com/gnolang/gno/tm2/pkg/bft/rpc/grpc"
 	rpcclient "github.com/gnolang/gno/tm2/pkg/bft/rpc/lib/client"
 	"github.com/gnolang/gno/tm2/pkg/log"
 	"github.com/gnolang/gno/tm2/pkg/p2p"
@@ -67,6 +68,7 @@ func createConfig() *cfg.Config {
 	// and we use random ports to run in parallel
 	c.P2P.ListenAddress = "tcp://127.0.0.1:0"
 	c.RPC.ListenAddress = "tcp://127.0.0.1:0"
+	c.RPC.GRPCListenAddress = "tcp://127.0.0.1:0"
 	c.RPC.CORSAllowedOrigins = []string{"https://tendermint.com/"}
 	// c.TxIndex.IndexTags = "app.creator,tx.height" // see kvstore application
 	return c
@@ -80,6 +82,15 @@ func GetConfig(forceCreate ...bool) *cfg.Config {
 	return globalConfig
 }
 
+// GetGRPCClient returns a client of the gRPC server of the test node.
+func GetGRPCClient() *coregrpc.Client {
+	client, err := coregrpc.NewClient(GetConfig().RPC.GRPCListenAddress)
+	if err != nil {
+		panic(err)
+	}
+	return client
+}
+
 // StartTendermint starts a test tendermint server in a go routine and returns when it is initialized
 func StartTendermint(app abci.Application, opts ...func(*Options)) *nm.Node {
 	nodeOpts := defaultOptions
@@ -0,0 +1,73 @@
+package config
+
+import "github.com/gnolang/gno/tm2/pkg/errors"
+
+//-----------------------------------------------------------------------------
+// PruningConfig
+
+// PruningConfig defines the configuration options for the pruning of old
+// blocks, and of the states of these blocks (validator sets, consensus params
+// and ABCI responses).
+//
+// The retain height, below which everything is pruned, is the lowest of the
+// heights requested by KeepRecent and by the application, if they are set.
+type PruningConfig struct {
+	// Number of recent blocks to keep; 0 keeps all blocks (archive node).
+	KeepRecent int64 `toml:"keep_recent"`
+	// Whether to prune below the retain height requested by the application
+	// in ResponseCommit.
+	AppRetainHeight bool `toml:"app_retain_height"`
+	// Number of blocks between two prunings.
+	Interval int64 `toml:"interval"`
+}
+
+// DefaultPruningConfig returns a default configuration for the pruning, which
+// keeps all blocks unless the application requests otherwise.
+func DefaultPruningConfig() *PruningConfig {
+	return &PruningConfig{
+		KeepRecent:      0,
+		AppRetainHeight: true,
+		Interval:        10,
+	}
+}
+
+// TestPruningConfig returns a configuration for testing the pruning.
+func TestPruningConfig() *PruningConfig {
+	cfg := DefaultPruningConfig()
+	cfg.Interval = 1
+	return cfg
+}
+
+// RetainHeight returns the height below which blocks must be pruned after
+// committing the block at height, given the retain height requested by the
+// application, or 0 if nothing must be pruned.
+func (cfg *PruningConfig) RetainHeight(height, appRetainHeight int64) int64 {
+	if cfg.Interval > 1 && height%cfg.Interval != 0 {
+		return 0
+	}
+	var retainHeight int64
+	if cfg.KeepRecent > 0 {
+		retainHeight = height - cfg.KeepRecent + 1
+	}
+	if cfg.AppRetainHeight && appRetainHeight > 0 && appRetainHeight <= height {
+		if retainHeight == 0 || appRetainHeight < retainHeight {
+			retainHeight = appRetainHeight
+		}
+	}
+	if retainHeight <= 1 {
+		return 0 // nothing to prune.
+	}
+	return retainHeight
+}
+
+// ValidateBasic performs basic validation (checking param bounds, etc.) and
+// returns an error if any check fails.
+func (cfg *PruningConfig) ValidateBasic() error {
+	if cfg.KeepRecent < 0 {
+		return errors.New("keep_recent can't be negative")
+	}
+	if cfg.Interval < 0 {
+		return errors.New("interval can't be negative")
+	}
+	return nil
+}
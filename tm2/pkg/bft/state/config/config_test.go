@@ -0,0 +1,46 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPruningRetainHeight(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name            string
+		keepRecent      int64
+		appRetainHeight bool
+		interval        int64
+		height          int64
+		appHeight       int64
+		want            int64
+	}{
+		{"archive", 0, false, 1, 100, 50, 0},
+		{"app only", 0, true, 1, 100, 50, 50},
+		{"app ignored", 0, false, 1, 100, 50, 0},
+		{"app above height", 0, true, 1, 100, 101, 0},
+		{"keep recent", 10, false, 1, 100, 0, 91},
+		{"keep recent below", 10, false, 1, 5, 0, 0},
+		{"lowest of app", 10, true, 1, 100, 50, 50},
+		{"lowest of keep recent", 10, true, 1, 100, 95, 91},
+		{"app within keep recent", 100, true, 1, 50, 40, 0},
+		{"interval", 10, true, 10, 105, 0, 0},
+		{"interval reached", 10, true, 10, 110, 0, 101},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := &PruningConfig{
+				KeepRecent:      c.keepRecent,
+				AppRetainHeight: c.appRetainHeight,
+				Interval:        c.interval,
+			}
+			assert.Equal(t, c.want, cfg.RetainHeight(c.height, c.appHeight))
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/gnolang/gno/tm2/pkg/bft/fail"
 	mempl "github.com/gnolang/gno/tm2/pkg/bft/mempool"
 	"github.com/gnolang/gno/tm2/pkg/bft/proxy"
+	smcfg "github.com/gnolang/gno/tm2/pkg/bft/state/config"
 	"github.com/gnolang/gno/tm2/pkg/bft/types"
 	typesver "github.com/gnolang/gno/tm2/pkg/bft/types/version"
 	tmver "github.com/gnolang/gno/tm2/pkg/bft/version"
@@ -37,11 +38,25 @@ type BlockExecutor struct {
 	// and update both with block results after commit.
 	mempool mempl.Mempool
 
+	// prune old blocks and states, if set.
+	blockStore BlockStore
+	pruning    *smcfg.PruningConfig
+
 	logger log.Logger
 }
 
 type BlockExecutorOption func(executor *BlockExecutor)
 
+// BlockExecutorWithPruning returns an option which prunes the blocks of
+// blockStore and their states below the retain height given by config, after
+// applying a block.
+func BlockExecutorWithPruning(blockStore BlockStore, config *smcfg.PruningConfig) BlockExecutorOption {
+	return func(blockExec *BlockExecutor) {
+		blockExec.blockStore = blockStore
+		blockExec.pruning = config
+	}
+}
+
 // NewBlockExecutor returns a new BlockExecutor with a NopEventBus.
 // Call SetEventBus to provide one.
 func NewBlockExecutor(db dbm.DB, logger log.Logger, proxyApp proxy.AppConnConsensus, mempool mempl.Mempool, options ...BlockExecutorOption) *BlockExecutor {
@@ -68,7 +83,8 @@ func (blockExec *BlockExecutor) SetEventSwitch(evsw events.EventSwitch) {
 	blockExec.evsw = evsw
 }
 
-// CreateProposalBlock calls state.MakeBlock with txs from the mempool.
+// CreateProposalBlock calls state.MakeBlock with txs from the mempool,
+// as prepared by the app.
 func (blockExec *BlockExecutor) CreateProposalBlock(
 	height int64,
 	state State, commit *types.Commit,
@@ -78,10 +94,76 @@ func (blockExec *BlockExecutor) CreateProposalBlock(
 	maxGas := state.ConsensusParams.Block.MaxGas
 
 	txs := blockExec.mempool.ReapMaxBytesMaxGas(maxDataBytes, maxGas)
+	txs = blockExec.prepareProposal(height, state, commit, proposerAddr, txs)
 
 	return state.MakeBlock(height, txs, commit, proposerAddr)
 }
 
+// prepareProposal lets the app reorder, drop or add txs to the reaped txs.
+// The reaped txs are proposed if the app fails, or if the prepared txs
+// exceed the max data bytes of the block.
+func (blockExec *BlockExecutor) prepareProposal(
+	height int64,
+	state State, commit *types.Commit,
+	proposerAddr crypto.Address,
+	txs types.Txs,
+) types.Txs {
+	maxDataBytes := state.ConsensusParams.Block.MaxDataBytes
+
+	res, err := blockExec.proxyApp.PrepareProposalSync(abci.RequestPrepareProposal{
+		Height:          height,
+		Time:            state.blockTime(height, commit),
+		ProposerAddress: proposerAddr,
+		Txs:             txsToBytes(txs),
+		MaxTxBytes:      maxDataBytes,
+	})
+	if err == nil && res.IsErr() {
+		err = res.Error
+	}
+	if err != nil {
+		blockExec.logger.Error("Error in proxyAppConn.PrepareProposal", "err", err)
+		return txs
+	}
+
+	prepared := make(types.Txs, len(res.Txs))
+	totalBytes := int64(0)
+	for i, tx := range res.Txs {
+		prepared[i] = tx
+		totalBytes += int64(len(tx))
+	}
+	if maxDataBytes > -1 && totalBytes > maxDataBytes {
+		blockExec.logger.Error("Prepared proposal txs exceed max data bytes",
+			"bytes", totalBytes, "max", maxDataBytes)
+		return txs
+	}
+	return prepared
+}
+
+// ProcessProposal lets the app accept or reject a proposed block, which
+// must have been validated. It returns an error if the block is rejected.
+func (blockExec *BlockExecutor) ProcessProposal(block *types.Block) error {
+	res, err := blockExec.proxyApp.ProcessProposalSync(abci.RequestProcessProposal{
+		Hash:   block.Hash(),
+		Header: block.Header.Copy(),
+		Txs:    txsToBytes(block.Txs),
+	})
+	if err != nil {
+		return ProxyAppConnError(err)
+	}
+	if res.IsErr() {
+		return fmt.Errorf("Proposal rejected by app: %w", res.Error)
+	}
+	return nil
+}
+
+func txsToBytes(txs types.Txs) [][]byte {
+	bz := make([][]byte, len(txs))
+	for i, tx := range txs {
+		bz[i] = tx
+	}
+	return bz
+}
+
 // ValidateBlock validates the given block against the given state.
 // If the block is invalid, it returns an error.
 // Validation does not mutate state, but does require historical information from the stateDB
@@ -128,7 +210,7 @@ func (blockExec *BlockExecutor) ApplyBlock(state State, blockID types.BlockID, b
 	}
 
 	// Lock mempool, commit app state, update mempoool.
-	appHash, err := blockExec.Commit(state, block, abciResponses.DeliverTxs)
+	appHash, appRetainHeight, err := blockExec.Commit(state, block, abciResponses.DeliverTxs)
 	if err != nil {
 		return state, fmt.Errorf("Commit failed for application: %w", err)
 	}
@@ -141,6 +223,13 @@ func (blockExec *BlockExecutor) ApplyBlock(state State, blockID types.BlockID, b
 
 	fail.Fail() // XXX
 
+	// Prune old blocks and states.
+	if blockExec.pruning != nil {
+		if retainHeight := blockExec.pruning.RetainHeight(block.Height, appRetainHeight); retainHeight > 0 {
+			blockExec.prune(retainHeight)
+		}
+	}
+
 	// Events are fired after everything else.
 	// NOTE: if we crash between Commit and Save, events wont be fired during replay
 	fireEvents(blockExec.logger, blockExec.evsw, block, abciResponses)
@@ -150,7 +239,8 @@ func (blockExec *BlockExecutor) ApplyBlock(state State, blockID types.BlockID, b
 
 // Commit locks the mempool, runs the ABCI Commit message, and updates the
 // mempool.
-// It returns the result of calling abci.Commit (the AppHash), and an error.
+// It returns the result of calling abci.Commit (the AppHash and the retain
+// height requested by the app), and an error.
 // The Mempool must be locked during commit and update because state is
 // typically reset on Commit and old txs must be replayed against committed
 // state before new txs are run in the mempool, lest they be invalid.
@@ -158,7 +248,7 @@ func (blockExec *BlockExecutor) Commit(
 	state State,
 	block *types.Block,
 	deliverTxResponses []abci.ResponseDeliverTx,
-) ([]byte, error) {
+) ([]byte, int64, error) {
 	blockExec.mempool.Lock()
 	defer blockExec.mempool.Unlock()
 
@@ -167,7 +257,7 @@ func (blockExec *BlockExecutor) Commit(
 	err := blockExec.mempool.FlushAppConn()
 	if err != nil {
 		blockExec.logger.Error("Client error during mempool.FlushAppConn", "err", err)
-		return nil, err
+		return nil, 0, err
 	}
 
 	// Commit block, get hash back
@@ -177,7 +267,7 @@ func (blockExec *BlockExecutor) Commit(
 			"Client error during proxyAppConn.CommitSync",
 			"err", err,
 		)
-		return nil, err
+		return nil, 0, err
 	}
 	// ResponseCommit has no error code - just data
 
@@ -191,13 +281,34 @@ func (blockExec *BlockExecutor) Commit(
 	// Update mempool.
 	err = blockExec.mempool.Update(
 		block.Height,
+		block.Time,
 		block.Txs,
 		deliverTxResponses,
 		TxPreCheck(state),
 		state.ConsensusParams.Block.MaxTxBytes,
 	)
 
-	return res.Data, err
+	return res.Data, res.RetainHeight, err
+}
+
+// prune removes the blocks and their states below retainHeight.
+// Failures are logged, as the block was applied anyway.
+func (blockExec *BlockExecutor) prune(retainHeight int64) {
+	base := blockExec.blockStore.Base()
+	if retainHeight <= base {
+		return
+	}
+	pruned, err := blockExec.blockStore.PruneBlocks(retainHeight)
+	if err != nil {
+		blockExec.logger.Error("Failed to prune blocks", "retainHeight", retainHeight, "err", err)
+		return
+	}
+	err = PruneStates(blockExec.db, base, retainHeight)
+	if err != nil {
+		blockExec.logger.Error("Failed to prune states", "retainHeight", retainHeight, "err", err)
+		return
+	}
+	blockExec.logger.Info("Pruned blocks", "pruned", pruned, "retainHeight", retainHeight)
 }
 
 //---------------------------------------------------------
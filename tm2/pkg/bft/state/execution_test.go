@@ -14,10 +14,13 @@ import (
 	"github.com/gnolang/gno/tm2/pkg/bft/mempool/mock"
 	"github.com/gnolang/gno/tm2/pkg/bft/proxy"
 	sm "github.com/gnolang/gno/tm2/pkg/bft/state"
+	smcfg "github.com/gnolang/gno/tm2/pkg/bft/state/config"
+	"github.com/gnolang/gno/tm2/pkg/bft/store"
 	"github.com/gnolang/gno/tm2/pkg/bft/types"
 	tmtime "github.com/gnolang/gno/tm2/pkg/bft/types/time"
 	"github.com/gnolang/gno/tm2/pkg/crypto/ed25519"
 	"github.com/gnolang/gno/tm2/pkg/crypto/secp256k1"
+	dbm "github.com/gnolang/gno/tm2/pkg/db"
 	"github.com/gnolang/gno/tm2/pkg/events"
 	"github.com/gnolang/gno/tm2/pkg/log"
 )
@@ -51,6 +54,45 @@ func TestApplyBlock(t *testing.T) {
 	// TODO check state and mempool
 }
 
+func TestApplyBlockPruning(t *testing.T) {
+	cc := proxy.NewLocalClientCreator(kvstore.NewKVStoreApplication())
+	proxyApp := proxy.NewAppConns(cc)
+	err := proxyApp.Start()
+	require.Nil(t, err)
+	defer proxyApp.Stop()
+
+	state, stateDB, privVals := makeState(1, 1)
+	blockStore := store.NewBlockStore(dbm.NewMemDB())
+	pruning := &smcfg.PruningConfig{KeepRecent: 3, Interval: 1}
+	blockExec := sm.NewBlockExecutor(stateDB, log.TestingLogger(), proxyApp.Consensus(), mock.Mempool{},
+		sm.BlockExecutorWithPruning(blockStore, pruning))
+
+	lastCommit := new(types.Commit)
+	proposerAddr := state.Validators.GetProposer().Address
+	for height := int64(1); height <= 10; height++ {
+		block, parts := state.MakeBlock(height, makeTxs(height), lastCommit, proposerAddr)
+		blockID := types.BlockID{Hash: block.Hash(), PartsHeader: parts.Header()}
+		seenCommit, err := makeValidCommit(height, blockID, state.Validators, privVals)
+		require.NoError(t, err)
+		blockStore.SaveBlock(block, parts, seenCommit)
+
+		state, err = blockExec.ApplyBlock(state, blockID, block)
+		require.NoError(t, err)
+		lastCommit = seenCommit
+	}
+
+	// Only the last 3 blocks, and their states, are kept.
+	assert.EqualValues(t, 8, blockStore.Base())
+	assert.Nil(t, blockStore.LoadBlock(7))
+	assert.NotNil(t, blockStore.LoadBlock(8))
+	_, err = sm.LoadABCIResponses(stateDB, 7)
+	assert.Error(t, err)
+	_, err = sm.LoadABCIResponses(stateDB, 8)
+	assert.NoError(t, err)
+	_, err = sm.LoadValidators(stateDB, 8)
+	assert.NoError(t, err)
+}
+
 // TestBeginBlockValidators ensures we send absent validators list.
 func TestBeginBlockValidators(t *testing.T) {
 	app := &testApp{}
@@ -248,6 +290,68 @@ func TestUpdateValidators(t *testing.T) {
 	}
 }
 
+// proposalApp drops the tx "drop" from and appends the tx "add" to proposed
+// blocks, and rejects the blocks with a tx "reject".
+type proposalApp struct {
+	abci.BaseApplication
+}
+
+func (proposalApp) PrepareProposal(req abci.RequestPrepareProposal) abci.ResponsePrepareProposal {
+	res := abci.ResponsePrepareProposal{}
+	for _, tx := range req.Txs {
+		if string(tx) != "drop" {
+			res.Txs = append(res.Txs, tx)
+		}
+	}
+	res.Txs = append(res.Txs, []byte("add"))
+	return res
+}
+
+func (proposalApp) ProcessProposal(req abci.RequestProcessProposal) abci.ResponseProcessProposal {
+	res := abci.ResponseProcessProposal{}
+	for _, tx := range req.Txs {
+		if string(tx) == "reject" {
+			res.Error = abci.StringError("rejected tx")
+		}
+	}
+	return res
+}
+
+// reapMempool reaps the same txs every time.
+type reapMempool struct {
+	mock.Mempool
+	txs types.Txs
+}
+
+func (mem reapMempool) ReapMaxBytesMaxGas(_, _ int64) types.Txs { return mem.txs }
+
+func TestCreateProposalBlock(t *testing.T) {
+	cc := proxy.NewLocalClientCreator(proposalApp{})
+	proxyApp := proxy.NewAppConns(cc)
+	err := proxyApp.Start()
+	require.Nil(t, err)
+	defer proxyApp.Stop()
+
+	state, stateDB, _ := makeState(1, 1)
+	commit := types.NewCommit(types.BlockID{}, nil)
+	proposerAddr := state.Validators.GetProposer().Address
+
+	mempool := reapMempool{txs: types.Txs{types.Tx("a"), types.Tx("drop"), types.Tx("b")}}
+	blockExec := sm.NewBlockExecutor(stateDB, log.TestingLogger(), proxyApp.Consensus(), mempool)
+	block, _ := blockExec.CreateProposalBlock(1, state, commit, proposerAddr)
+	assert.Equal(t, types.Txs{types.Tx("a"), types.Tx("b"), types.Tx("add")}, block.Txs)
+	assert.NoError(t, blockExec.ProcessProposal(block))
+
+	// prepared txs exceeding the max data bytes fall back to the reaped txs.
+	state.ConsensusParams.Block.MaxDataBytes = 3
+	block, _ = blockExec.CreateProposalBlock(1, state, commit, proposerAddr)
+	assert.Equal(t, mempool.txs, block.Txs)
+
+	mempool.txs = types.Txs{types.Tx("reject")}
+	block, _ = state.MakeBlock(1, mempool.txs, commit, proposerAddr)
+	assert.Error(t, blockExec.ProcessProposal(block))
+}
+
 // TestEndBlockValidatorUpdates ensures we update validator set and send an event.
 func TestEndBlockValidatorUpdates(t *testing.T) {
 	app := &testApp{}
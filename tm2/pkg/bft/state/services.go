@@ -14,6 +14,7 @@ import (
 
 // BlockStoreRPC is the block store interface used by the RPC.
 type BlockStoreRPC interface {
+	Base() int64
 	Height() int64
 
 	LoadBlockMeta(height int64) *types.BlockMeta
@@ -28,4 +29,5 @@ type BlockStoreRPC interface {
 type BlockStore interface {
 	BlockStoreRPC
 	SaveBlock(block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit)
+	PruneBlocks(height int64) (uint64, error)
 }
@@ -127,12 +127,7 @@ func (state State) MakeBlock(
 	block := types.MakeBlock(height, txs, commit)
 
 	// Set time.
-	var timestamp time.Time
-	if height == 1 {
-		timestamp = state.LastBlockTime // genesis time
-	} else {
-		timestamp = MedianTime(commit, state.LastValidators)
-	}
+	timestamp := state.blockTime(height, commit)
 
 	// Fill rest of header with state data.
 	block.Header.Populate(
@@ -147,6 +142,14 @@ func (state State) MakeBlock(
 	return block, block.MakePartSet(types.BlockPartSizeBytes)
 }
 
+// blockTime returns the time of the block at height with commit.
+func (state State) blockTime(height int64, commit *types.Commit) time.Time {
+	if height == 1 {
+		return state.LastBlockTime // genesis time
+	}
+	return MedianTime(commit, state.LastValidators)
+}
+
 // MedianTime computes a median time for a given Commit (based on Timestamp field of votes messages) and the
 // corresponding validator set. The computed time is always between timestamps of
 // the votes sent by honest processes, i.e., a faulty processes can not arbitrarily increase or decrease the
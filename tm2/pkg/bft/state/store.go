@@ -333,3 +333,93 @@ func saveConsensusParamsInfo(db dbm.DB, nextHeight, changeHeight int64, params a
 	}
 	db.Set(calcConsensusParamsKey(nextHeight), paramsInfo.Bytes())
 }
+
+// -----------------------------------------------------------------------------
+
+// PruneStates deletes the validator sets, consensus params and ABCI responses
+// of the heights from `from` (inclusive) to `to` (exclusive).  The validator
+// sets and consensus params of heights before `to`, which later heights refer
+// to as their last change or checkpoint, are kept.
+func PruneStates(db dbm.DB, from int64, to int64) error {
+	if from <= 0 || to <= 0 {
+		return fmt.Errorf("from height %v and to height %v must be greater than 0", from, to)
+	}
+	if from >= to {
+		return fmt.Errorf("from height %v must be lower than to height %v", from, to)
+	}
+	valInfo := loadValidatorsInfo(db, to)
+	if valInfo == nil {
+		return fmt.Errorf("validators at height %v not found", to)
+	}
+	paramsInfo := loadConsensusParamsInfo(db, to)
+	if paramsInfo == nil {
+		return fmt.Errorf("consensus params at height %v not found", to)
+	}
+
+	keepVals := make(map[int64]bool)
+	if valInfo.ValidatorSet == nil {
+		keepVals[valInfo.LastHeightChanged] = true
+		keepVals[lastStoredHeightFor(to, valInfo.LastHeightChanged)] = true // keep last checkpoint too
+	}
+	keepParams := make(map[int64]bool)
+	if amino.DeepEqual(abci.ConsensusParams{}, paramsInfo.ConsensusParams) {
+		keepParams[paramsInfo.LastHeightChanged] = true
+	}
+
+	batch := db.NewBatch()
+	defer func() {
+		batch.Close()
+	}()
+	pruned := uint64(0)
+	var err error
+
+	// We have to delete in reverse order, to avoid deleting previous heights
+	// that have validator sets and consensus params that we may need to
+	// retrieve.
+	for h := to - 1; h >= from; h-- {
+		// For heights we keep, we must make sure they have the full validator
+		// set or consensus params, otherwise they will panic if they're
+		// retrieved directly (instead of indirectly via a LastHeightChanged
+		// pointer).
+		if keepVals[h] {
+			v := loadValidatorsInfo(db, h)
+			if v != nil && v.ValidatorSet == nil {
+				v.ValidatorSet, err = LoadValidators(db, h)
+				if err != nil {
+					return err
+				}
+				v.LastHeightChanged = h
+				batch.Set(calcValidatorsKey(h), v.Bytes())
+			}
+		} else {
+			batch.Delete(calcValidatorsKey(h))
+		}
+
+		if keepParams[h] {
+			p := loadConsensusParamsInfo(db, h)
+			if p != nil && amino.DeepEqual(abci.ConsensusParams{}, p.ConsensusParams) {
+				p.ConsensusParams, err = LoadConsensusParams(db, h)
+				if err != nil {
+					return err
+				}
+				p.LastHeightChanged = h
+				batch.Set(calcConsensusParamsKey(h), p.Bytes())
+			}
+		} else {
+			batch.Delete(calcConsensusParamsKey(h))
+		}
+
+		batch.Delete(calcABCIResponsesKey(h))
+		pruned++
+
+		// avoid batches growing too large by flushing to database regularly
+		if pruned%1000 == 0 {
+			batch.Write()
+			batch.Close()
+			batch = db.NewBatch()
+		}
+	}
+
+	batch.WriteSync()
+	return nil
+}
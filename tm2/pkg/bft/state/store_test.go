@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	abci "github.com/gnolang/gno/tm2/pkg/bft/abci/types"
 	cfg "github.com/gnolang/gno/tm2/pkg/bft/config"
 	sm "github.com/gnolang/gno/tm2/pkg/bft/state"
 	"github.com/gnolang/gno/tm2/pkg/bft/types"
@@ -54,6 +55,64 @@ func TestStoreLoadValidators(t *testing.T) {
 	assert.NotZero(t, loadedVals.Size())
 }
 
+func TestPruneStates(t *testing.T) {
+	stateDB := dbm.NewMemDB()
+	vals1 := genValSet(1)
+	vals2 := genValSet(2)
+	params1 := makeConsensusParams(1, 1, 1, 1, 1)
+	params2 := makeConsensusParams(2, 2, 2, 2, 2)
+
+	// The validators change at height 5, the params at height 8.
+	for h := int64(1); h <= 20; h++ {
+		valsChanged, vals := int64(1), vals1
+		if h >= 5 {
+			valsChanged, vals = 5, vals2
+		}
+		paramsChanged, params := int64(1), params1
+		if h >= 8 {
+			paramsChanged, params = 8, params2
+		}
+		sm.SaveValidatorsInfo(stateDB, h, valsChanged, vals)
+		sm.SaveConsensusParamsInfo(stateDB, h, paramsChanged, params)
+		sm.SaveABCIResponses(stateDB, h, &sm.ABCIResponses{
+			DeliverTxs: []abci.ResponseDeliverTx{{GasUsed: h}},
+		})
+	}
+
+	require.Error(t, sm.PruneStates(stateDB, 0, 10))
+	require.Error(t, sm.PruneStates(stateDB, 10, 10))
+	require.Error(t, sm.PruneStates(stateDB, 1, 21))
+
+	require.NoError(t, sm.PruneStates(stateDB, 1, 10))
+
+	for h := int64(1); h < 10; h++ {
+		_, err := sm.LoadABCIResponses(stateDB, h)
+		assert.Error(t, err, "abci responses of height %v", h)
+	}
+	for h := int64(10); h <= 20; h++ {
+		res, err := sm.LoadABCIResponses(stateDB, h)
+		require.NoError(t, err)
+		assert.Equal(t, h, res.DeliverTxs[0].GasUsed)
+
+		vals, err := sm.LoadValidators(stateDB, h)
+		require.NoError(t, err)
+		assert.Equal(t, vals2.Hash(), vals.Hash())
+
+		params, err := sm.LoadConsensusParams(stateDB, h)
+		require.NoError(t, err)
+		assert.Equal(t, params2, params)
+	}
+	// The heights of the last changes are kept, other heights are pruned.
+	_, err := sm.LoadValidators(stateDB, 5)
+	assert.NoError(t, err)
+	_, err = sm.LoadValidators(stateDB, 6)
+	assert.Error(t, err)
+	_, err = sm.LoadConsensusParams(stateDB, 8)
+	assert.NoError(t, err)
+	_, err = sm.LoadConsensusParams(stateDB, 9)
+	assert.Error(t, err)
+}
+
 func BenchmarkLoadValidators(b *testing.B) {
 	const valSetSize = 100
 
@@ -29,6 +29,7 @@ type BlockStore struct {
 	db dbm.DB
 
 	mtx    sync.RWMutex
+	base   int64
 	height int64
 }
 
@@ -37,11 +38,20 @@ type BlockStore struct {
 func NewBlockStore(db dbm.DB) *BlockStore {
 	bsjson := LoadBlockStoreStateJSON(db)
 	return &BlockStore{
+		base:   bsjson.Base,
 		height: bsjson.Height,
 		db:     db,
 	}
 }
 
+// Base returns the first known contiguous block height, or 0 for empty block stores.
+// Blocks below it were pruned.
+func (bs *BlockStore) Base() int64 {
+	bs.mtx.RLock()
+	defer bs.mtx.RUnlock()
+	return bs.base
+}
+
 // Height returns the last known contiguous block height.
 func (bs *BlockStore) Height() int64 {
 	bs.mtx.RLock()
@@ -49,6 +59,16 @@ func (bs *BlockStore) Height() int64 {
 	return bs.height
 }
 
+// Size returns the number of blocks in the block store.
+func (bs *BlockStore) Size() int64 {
+	bs.mtx.RLock()
+	defer bs.mtx.RUnlock()
+	if bs.height == 0 {
+		return 0
+	}
+	return bs.height - bs.base + 1
+}
+
 // LoadBlock returns the block with the given height.
 // If no block is found for that height, it returns nil.
 func (bs *BlockStore) LoadBlock(height int64) *types.Block {
@@ -176,17 +196,76 @@ func (bs *BlockStore) SaveBlock(block *types.Block, blockParts *types.PartSet, s
 	bs.db.Set(calcSeenCommitKey(height), seenCommitBytes)
 
 	// Save new BlockStoreStateJSON descriptor
-	BlockStoreStateJSON{Height: height}.Save(bs.db)
-
-	// Done!
 	bs.mtx.Lock()
 	bs.height = height
+	if bs.base == 0 {
+		bs.base = height
+	}
+	BlockStoreStateJSON{Base: bs.base, Height: height}.Save(bs.db)
 	bs.mtx.Unlock()
 
 	// Flush
 	bs.db.SetSync(nil, nil)
 }
 
+// PruneBlocks removes the blocks below height, with their parts and commits,
+// and returns the number of pruned blocks.
+// The new base is height, so height must be at most the store height.
+func (bs *BlockStore) PruneBlocks(height int64) (uint64, error) {
+	if height <= 0 {
+		return 0, fmt.Errorf("height must be greater than 0")
+	}
+	bs.mtx.RLock()
+	base, storeHeight := bs.base, bs.height
+	bs.mtx.RUnlock()
+	if height > storeHeight {
+		return 0, fmt.Errorf("cannot prune beyond the latest height %v", storeHeight)
+	}
+	if height < base {
+		return 0, fmt.Errorf("cannot prune to height %v, it is lower than the base height %v",
+			height, base)
+	}
+
+	pruned := uint64(0)
+	batch := bs.db.NewBatch()
+	defer func() {
+		batch.Close()
+	}()
+	// Writes the batch with the new base, so that an interrupted pruning
+	// resumes from there.
+	flush := func(base int64) {
+		BlockStoreStateJSON{Base: base, Height: storeHeight}.saveBatch(batch)
+		batch.WriteSync()
+		bs.mtx.Lock()
+		bs.base = base
+		bs.mtx.Unlock()
+	}
+
+	for h := base; h < height; h++ {
+		meta := bs.LoadBlockMeta(h)
+		if meta == nil { // assume already deleted
+			continue
+		}
+		batch.Delete(calcBlockMetaKey(h))
+		batch.Delete(calcBlockCommitKey(h))
+		batch.Delete(calcSeenCommitKey(h))
+		for p := 0; p < meta.BlockID.PartsHeader.Total; p++ {
+			batch.Delete(calcBlockPartKey(h, p))
+		}
+		pruned++
+
+		// avoid batches growing too large by flushing to database regularly
+		if pruned%1000 == 0 {
+			flush(h + 1)
+			batch.Close()
+			batch = bs.db.NewBatch()
+		}
+	}
+
+	flush(height)
+	return pruned, nil
+}
+
 func (bs *BlockStore) saveBlockPart(height int64, index int, part *types.Part) {
 	if height != bs.Height()+1 {
 		panic(fmt.Sprintf("BlockStore can only save contiguous blocks. Wanted %v, got %v", bs.Height()+1, height))
@@ -219,16 +298,26 @@ var blockStoreKey = []byte("blockStore")
 
 // BlockStoreStateJSON is the block store state JSON structure.
 type BlockStoreStateJSON struct {
+	Base   int64 `json:"base"`
 	Height int64 `json:"height"`
 }
 
 // Save persists the blockStore state to the database as JSON.
 func (bsj BlockStoreStateJSON) Save(db dbm.DB) {
+	db.SetSync(blockStoreKey, bsj.bytes())
+}
+
+// saveBatch persists the blockStore state with the batch.
+func (bsj BlockStoreStateJSON) saveBatch(batch dbm.Batch) {
+	batch.Set(blockStoreKey, bsj.bytes())
+}
+
+func (bsj BlockStoreStateJSON) bytes() []byte {
 	bytes, err := amino.MarshalJSON(bsj)
 	if err != nil {
 		panic(fmt.Sprintf("Could not marshal state bytes: %v", err))
 	}
-	db.SetSync(blockStoreKey, bytes)
+	return bytes
 }
 
 // LoadBlockStoreStateJSON returns the BlockStoreStateJSON as loaded from disk.
@@ -245,5 +334,9 @@ func LoadBlockStoreStateJSON(db dbm.DB) BlockStoreStateJSON {
 	if err != nil {
 		panic(fmt.Sprintf("Could not unmarshal bytes: %X", bytes))
 	}
+	// Block stores saved before pruning was supported start at height 1.
+	if bsj.Height > 0 && bsj.Base == 0 {
+		bsj.Base = 1
+	}
 	return bsj
 }
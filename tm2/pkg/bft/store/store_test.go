@@ -60,12 +60,17 @@ func makeStateAndBlockStore(logger log.Logger) (sm.State, *BlockStore, cleanupFu
 func TestLoadBlockStoreStateJSON(t *testing.T) {
 	db := dbm.NewMemDB()
 
-	bsj := &BlockStoreStateJSON{Height: 1000}
+	bsj := &BlockStoreStateJSON{Base: 100, Height: 1000}
 	bsj.Save(db)
 
 	retrBSJ := LoadBlockStoreStateJSON(db)
 
 	assert.Equal(t, *bsj, retrBSJ, "expected the retrieved DBs to match")
+
+	// Stores saved without a base start at height 1.
+	db.Set(blockStoreKey, []byte(`{"height": "1000"}`))
+	retrBSJ = LoadBlockStoreStateJSON(db)
+	assert.Equal(t, BlockStoreStateJSON{Base: 1, Height: 1000}, retrBSJ)
 }
 
 func TestNewBlockStore(t *testing.T) {
@@ -407,6 +412,65 @@ func TestBlockFetchAtHeight(t *testing.T) {
 	require.Nil(t, blockAtHeightPlus2, "expecting an unsuccessful load of Height()+2")
 }
 
+func TestPruneBlocks(t *testing.T) {
+	state, bs, cleanup := makeStateAndBlockStore(log.NewTMLogger(new(bytes.Buffer)))
+	defer cleanup()
+	assert.EqualValues(t, 0, bs.Base())
+	assert.EqualValues(t, 0, bs.Size())
+
+	_, err := bs.PruneBlocks(1)
+	require.Error(t, err, "cannot prune an empty store")
+
+	// make more than 1000 blocks, to test batch deletions
+	for h := int64(1); h <= 1500; h++ {
+		block := makeBlock(h, state, new(types.Commit))
+		partSet := block.MakePartSet(2)
+		seenCommit := makeTestCommit(h, tmtime.Now())
+		bs.SaveBlock(block, partSet, seenCommit)
+	}
+	assert.EqualValues(t, 1, bs.Base())
+	assert.EqualValues(t, 1500, bs.Height())
+	assert.EqualValues(t, 1500, bs.Size())
+
+	pruned, err := bs.PruneBlocks(1200)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1199, pruned)
+	assert.EqualValues(t, 1200, bs.Base())
+	assert.EqualValues(t, 1500, bs.Height())
+	assert.EqualValues(t, 301, bs.Size())
+	assert.Equal(t, BlockStoreStateJSON{Base: 1200, Height: 1500}, LoadBlockStoreStateJSON(bs.db))
+
+	require.NotNil(t, bs.LoadBlock(1200))
+	require.Nil(t, bs.LoadBlock(1199))
+	require.Nil(t, bs.LoadBlockMeta(1199))
+	require.Nil(t, bs.LoadBlockPart(1199, 0))
+	require.Nil(t, bs.LoadBlockCommit(1198))
+	require.Nil(t, bs.LoadSeenCommit(1199))
+	require.Nil(t, bs.LoadBlock(1))
+
+	// the base survives restarts.
+	bs = NewBlockStore(bs.db)
+	assert.EqualValues(t, 1200, bs.Base())
+
+	// pruning to the base is a no-op.
+	pruned, err = bs.PruneBlocks(1200)
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, pruned)
+
+	// cannot prune below the base or beyond the height.
+	_, err = bs.PruneBlocks(1199)
+	require.Error(t, err)
+	_, err = bs.PruneBlocks(1501)
+	require.Error(t, err)
+
+	// pruning up to the latest height keeps it.
+	pruned, err = bs.PruneBlocks(1500)
+	require.NoError(t, err)
+	assert.EqualValues(t, 300, pruned)
+	assert.EqualValues(t, 1, bs.Size())
+	require.NotNil(t, bs.LoadBlock(1500))
+}
+
 func doFn(fn func() (interface{}, error)) (res interface{}, err error, panicErr error) {
 	defer func() {
 		if r := recover(); r != nil {
@@ -408,3 +408,60 @@ func TestBlockIDValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func makeTestBlock(tb testing.TB) *Block {
+	tb.Helper()
+
+	txs := []Tx{Tx("foo"), Tx("bar"), random.RandBytes(1000)}
+	h := int64(3)
+	voteSet, valSet, vals := randVoteSet(h-1, 1, PrecommitType, 10, 1)
+	commit, err := MakeCommit(makeBlockIDRandom(), h-1, 1, voteSet, vals)
+	require.NoError(tb, err)
+	block := MakeBlock(h, txs, commit)
+	block.ProposerAddress = valSet.GetProposer().Address
+	return block
+}
+
+// The generated binary2 methods encode blocks as the reflection codec does.
+func TestBlockBinary2(t *testing.T) {
+	t.Parallel()
+
+	block := makeTestBlock(t)
+	cdc := amino.NewCodec()
+	cdc2 := amino.NewCodec().WithBinary2()
+	bz, err := cdc.Marshal(block)
+	require.NoError(t, err)
+	bz2, err := cdc2.Marshal(block)
+	require.NoError(t, err)
+	require.Equal(t, bz, bz2)
+
+	block2 := new(Block)
+	require.NoError(t, cdc2.Unmarshal(bz2, block2))
+	require.Equal(t, block.Hash(), block2.Hash())
+	bz3, err := cdc.Marshal(block2)
+	require.NoError(t, err)
+	require.Equal(t, bz, bz3)
+}
+
+func BenchmarkBlockBinary(b *testing.B) {
+	block := makeTestBlock(b)
+	for _, c := range []struct {
+		name string
+		cdc  *amino.Codec
+	}{
+		{"reflect", amino.NewCodec()},
+		{"binary2", amino.NewCodec().WithBinary2()},
+	} {
+		bz := c.cdc.MustMarshal(block)
+		b.Run(c.name+":encode", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				c.cdc.MustMarshal(block)
+			}
+		})
+		b.Run(c.name+":decode", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				c.cdc.MustUnmarshal(bz, new(Block))
+			}
+		})
+	}
+}
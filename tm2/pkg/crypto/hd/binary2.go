@@ -0,0 +1,200 @@
+// Code generated by gengo. DO NOT EDIT.
+
+package hd
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/gnolang/gno/tm2/pkg/amino"
+)
+
+func (goo BIP44Params) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field Purpose (#1)
+	if goo.Purpose != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeUvarint(buf, uint64(goo.Purpose)); err != nil {
+			return
+		}
+	}
+	// Field CoinType (#2)
+	if goo.CoinType != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeUvarint(buf, uint64(goo.CoinType)); err != nil {
+			return
+		}
+	}
+	// Field Account (#3)
+	if goo.Account != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeUvarint(buf, uint64(goo.Account)); err != nil {
+			return
+		}
+	}
+	// Field Change (#4)
+	if goo.Change {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 4, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeBool(buf, bool(goo.Change)); err != nil {
+			return
+		}
+	}
+	// Field AddressIndex (#5)
+	if goo.AddressIndex != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 5, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeUvarint(buf, uint64(goo.AddressIndex)); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (goo *BIP44Params) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field Purpose (#1)
+	if len(bz) == 0 {
+		goo.Purpose = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Purpose = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of hd.BIP44Params, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 1 of hd.BIP44Params, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeUvarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Purpose = uint32(v)
+	}
+	// Field CoinType (#2)
+	if len(bz) == 0 {
+		goo.CoinType = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.CoinType = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of hd.BIP44Params, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 2 of hd.BIP44Params, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeUvarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.CoinType = uint32(v)
+	}
+	// Field Account (#3)
+	if len(bz) == 0 {
+		goo.Account = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.Account = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of hd.BIP44Params, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 3 of hd.BIP44Params, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeUvarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Account = uint32(v)
+	}
+	// Field Change (#4)
+	if len(bz) == 0 {
+		goo.Change = false
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 4 {
+		goo.Change = false
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 4 {
+			return fmt.Errorf("expected field # 4 of hd.BIP44Params, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 4 of hd.BIP44Params, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeBool(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.Change = bool(v)
+	}
+	// Field AddressIndex (#5)
+	if len(bz) == 0 {
+		goo.AddressIndex = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 5 {
+		goo.AddressIndex = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 5 {
+			return fmt.Errorf("expected field # 5 of hd.BIP44Params, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 5 of hd.BIP44Params, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeUvarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.AddressIndex = uint32(v)
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
@@ -10,6 +10,7 @@ import (
 	gno "github.com/gnolang/gno/gnovm/pkg/gnolang"
 	"github.com/gnolang/gno/tm2/pkg/amino"
 	"github.com/gnolang/gno/tm2/pkg/commands"
+	"github.com/gnolang/gno/tm2/pkg/crypto"
 	"github.com/gnolang/gno/tm2/pkg/crypto/keys"
 	"github.com/gnolang/gno/tm2/pkg/errors"
 	"github.com/gnolang/gno/tm2/pkg/sdk/vm"
@@ -105,12 +106,6 @@ func execAddPkg(cfg *addPkgCfg, args []string, io *commands.IO) error {
 		panic(err)
 	}
 
-	// parse gas wanted & fee.
-	gaswanted := cfg.rootCfg.gasWanted
-	gasfee, err := std.ParseCoin(cfg.rootCfg.gasFee)
-	if err != nil {
-		panic(err)
-	}
 	// construct msg & tx and marshal.
 	msg := vm.MsgAddPackage{
 		Creator: creator,
@@ -119,40 +114,79 @@ func execAddPkg(cfg *addPkgCfg, args []string, io *commands.IO) error {
 	}
 	tx := std.Tx{
 		Msgs:       []std.Msg{msg},
-		Fee:        std.NewFee(gaswanted, gasfee),
 		Signatures: nil,
 		Memo:       cfg.rootCfg.memo,
 	}
 
-	if cfg.rootCfg.broadcast {
-		err := signAndBroadcast(cfg.rootCfg, args, tx, io)
+	return execMakeTx(cfg.rootCfg, args, tx, io)
+}
+
+func signAndBroadcast(
+	cfg *makeTxCfg,
+	args []string,
+	tx std.Tx,
+	io *commands.IO,
+) error {
+	baseopts := cfg.rootCfg
+
+	// sign tx
+	nameOrBech32 := args[0]
+	signedTx, err := signTx(cfg, nameOrBech32, tx, "Enter password.", io)
+	if err != nil {
+		return err
+	}
+
+	// co-sign tx as fee payer, if not the signer.
+	if tx.FeePayer != nil && !signedBy(signedTx, *tx.FeePayer) {
+		signedTx, err = signTx(cfg, tx.FeePayer.String(), *signedTx, "Enter password of fee payer.", io)
 		if err != nil {
-			return err
+			return errors.Wrap(err, "co-sign tx as fee payer (the fee payer key must be local to broadcast)")
 		}
-	} else {
-		fmt.Println(string(amino.MustMarshalJSON(tx)))
 	}
+
+	// broadcast signed tx
+	bopts := &broadcastCfg{
+		rootCfg: baseopts,
+		tx:      signedTx,
+	}
+	bres, err := broadcastHandler(bopts)
+	if err != nil {
+		return errors.Wrap(err, "broadcast tx")
+	}
+	if bres.CheckTx.IsErr() {
+		return errors.Wrap(bres.CheckTx.Error, "check transaction failed: log:%s", bres.CheckTx.Log)
+	}
+	if bres.DeliverTx.IsErr() {
+		return errors.Wrap(bres.DeliverTx.Error, "deliver transaction failed: log:%s", bres.DeliverTx.Log)
+	}
+	io.Println(string(bres.DeliverTx.Data))
+	io.Println("OK!")
+	io.Println("GAS WANTED:", bres.DeliverTx.GasWanted)
+	io.Println("GAS USED:  ", bres.DeliverTx.GasUsed)
+
 	return nil
 }
 
-func signAndBroadcast(
+// signTx signs tx with the key nameOrBech32, using the account number and
+// sequence of its remote account.
+func signTx(
 	cfg *makeTxCfg,
-	args []string,
+	nameOrBech32 string,
 	tx std.Tx,
+	prompt string,
 	io *commands.IO,
-) error {
+) (*std.Tx, error) {
 	baseopts := cfg.rootCfg
 	txopts := cfg
 
 	// query account
-	nameOrBech32 := args[0]
 	kb, err := keys.NewKeyBaseFromDir(baseopts.Home)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	info, err := kb.GetByNameOrAddress(nameOrBech32)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	accountAddr := info.GetAddress()
 
@@ -162,12 +196,12 @@ func signAndBroadcast(
 	}
 	qres, err := queryHandler(qopts)
 	if err != nil {
-		return errors.Wrap(err, "query account")
+		return nil, errors.Wrap(err, "query account")
 	}
 	var qret struct{ BaseAccount std.BaseAccount }
 	err = amino.UnmarshalJSON(qres.Response.Data, &qret)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// sign tx
@@ -184,36 +218,25 @@ func signAndBroadcast(
 	if baseopts.Quiet {
 		sopts.pass, err = io.GetPassword("", baseopts.InsecurePasswordStdin)
 	} else {
-		sopts.pass, err = io.GetPassword("Enter password.", baseopts.InsecurePasswordStdin)
+		sopts.pass, err = io.GetPassword(prompt, baseopts.InsecurePasswordStdin)
 	}
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	signedTx, err := SignHandler(sopts)
 	if err != nil {
-		return errors.Wrap(err, "sign tx")
+		return nil, errors.Wrap(err, "sign tx")
 	}
+	return signedTx, nil
+}
 
-	// broadcast signed tx
-	bopts := &broadcastCfg{
-		rootCfg: baseopts,
-		tx:      signedTx,
-	}
-	bres, err := broadcastHandler(bopts)
-	if err != nil {
-		return errors.Wrap(err, "broadcast tx")
-	}
-	if bres.CheckTx.IsErr() {
-		return errors.Wrap(bres.CheckTx.Error, "check transaction failed: log:%s", bres.CheckTx.Log)
-	}
-	if bres.DeliverTx.IsErr() {
-		return errors.Wrap(bres.DeliverTx.Error, "deliver transaction failed: log:%s", bres.DeliverTx.Log)
+// signedBy returns true if the signature slot of addr in tx is filled.
+func signedBy(tx *std.Tx, addr crypto.Address) bool {
+	for i, signer := range tx.GetSigners() {
+		if signer == addr {
+			return i < len(tx.Signatures) && tx.Signatures[i].PubKey != nil
+		}
 	}
-	io.Println(string(bres.DeliverTx.Data))
-	io.Println("OK!")
-	io.Println("GAS WANTED:", bres.DeliverTx.GasWanted)
-	io.Println("GAS USED:  ", bres.DeliverTx.GasUsed)
-
-	return nil
+	return false
 }
@@ -119,6 +119,9 @@ func simulateTx(cli client.ABCIClient, tx []byte) (*ctypes.ResultBroadcastTxComm
 	if err != nil {
 		return nil, errors.Wrap(err, "simulate tx")
 	}
+	if bres.Response.Error != nil {
+		return nil, errors.Wrap(bres.Response.Error, "simulate tx")
+	}
 
 	var result abci.ResponseDeliverTx
 	err = amino.Unmarshal(bres.Response.Value, &result)
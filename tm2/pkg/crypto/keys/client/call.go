@@ -3,9 +3,7 @@ package client
 import (
 	"context"
 	"flag"
-	"fmt"
 
-	"github.com/gnolang/gno/tm2/pkg/amino"
 	"github.com/gnolang/gno/tm2/pkg/commands"
 	"github.com/gnolang/gno/tm2/pkg/crypto/keys"
 	"github.com/gnolang/gno/tm2/pkg/errors"
@@ -79,12 +77,6 @@ func execCall(cfg *callCfg, args []string, io *commands.IO) error {
 	if len(args) != 1 {
 		return flag.ErrHelp
 	}
-	if cfg.rootCfg.gasWanted == 0 {
-		return errors.New("gas-wanted not specified")
-	}
-	if cfg.rootCfg.gasFee == "" {
-		return errors.New("gas-fee not specified")
-	}
 
 	// read statement.
 	fnc := cfg.funcName
@@ -108,13 +100,6 @@ func execCall(cfg *callCfg, args []string, io *commands.IO) error {
 		return errors.Wrap(err, "parsing send coins")
 	}
 
-	// parse gas wanted & fee.
-	gaswanted := cfg.rootCfg.gasWanted
-	gasfee, err := std.ParseCoin(cfg.rootCfg.gasFee)
-	if err != nil {
-		return errors.Wrap(err, "parsing gas fee coin")
-	}
-
 	// construct msg & tx and marshal.
 	msg := vm.MsgCall{
 		Caller:  caller,
@@ -125,18 +110,9 @@ func execCall(cfg *callCfg, args []string, io *commands.IO) error {
 	}
 	tx := std.Tx{
 		Msgs:       []std.Msg{msg},
-		Fee:        std.NewFee(gaswanted, gasfee),
 		Signatures: nil,
 		Memo:       cfg.rootCfg.memo,
 	}
 
-	if cfg.rootCfg.broadcast {
-		err := signAndBroadcast(cfg.rootCfg, args, tx, io)
-		if err != nil {
-			return err
-		}
-	} else {
-		fmt.Println(string(amino.MustMarshalJSON(tx)))
-	}
-	return nil
+	return execMakeTx(cfg.rootCfg, args, tx, io)
 }
@@ -2,17 +2,33 @@ package client
 
 import (
 	"flag"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
 
+	"github.com/gnolang/gno/tm2/pkg/amino"
+	"github.com/gnolang/gno/tm2/pkg/bft/rpc/client"
+	ctypes "github.com/gnolang/gno/tm2/pkg/bft/rpc/core/types"
 	"github.com/gnolang/gno/tm2/pkg/commands"
+	"github.com/gnolang/gno/tm2/pkg/crypto"
+	"github.com/gnolang/gno/tm2/pkg/crypto/keys"
+	"github.com/gnolang/gno/tm2/pkg/errors"
+	"github.com/gnolang/gno/tm2/pkg/std"
 )
 
 type makeTxCfg struct {
 	rootCfg *baseCfg
 
-	gasWanted int64
-	gasFee    string
-	memo      string
+	gasWanted     gasWantedValue
+	gasFee        string
+	gasAdjustment float64
+	memo          string
+	feePayer      string
+	timeoutHeight int64
+	timeoutTime   int64
 
+	simulate  bool
 	broadcast bool
 	chainID   string
 }
@@ -42,18 +58,24 @@ func newMakeTxCmd(rootCfg *baseCfg) *commands.Command {
 }
 
 func (c *makeTxCfg) RegisterFlags(fs *flag.FlagSet) {
-	fs.Int64Var(
+	fs.Var(
 		&c.gasWanted,
 		"gas-wanted",
-		0,
-		"gas requested for tx",
+		"gas requested for tx, or \"auto\" to estimate it by simulating the tx",
 	)
 
 	fs.StringVar(
 		&c.gasFee,
 		"gas-fee",
 		"",
-		"gas payment fee",
+		"gas payment fee, or \"auto\" to derive it from the minimum gas prices of the remote node",
+	)
+
+	fs.Float64Var(
+		&c.gasAdjustment,
+		"gas-adjustment",
+		1.5,
+		"factor applied to the simulated gas, with -gas-wanted auto",
 	)
 
 	fs.StringVar(
@@ -63,6 +85,34 @@ func (c *makeTxCfg) RegisterFlags(fs *flag.FlagSet) {
 		"any descriptive text",
 	)
 
+	fs.StringVar(
+		&c.feePayer,
+		"fee-payer",
+		"",
+		"key name or address of the account paying the fees, which must co-sign the tx",
+	)
+
+	fs.Int64Var(
+		&c.timeoutHeight,
+		"timeout-height",
+		0,
+		"last block height the tx can be included in, or 0 for none",
+	)
+
+	fs.Int64Var(
+		&c.timeoutTime,
+		"timeout-time",
+		0,
+		"last block time (unix seconds) the tx can be included at, or 0 for none",
+	)
+
+	fs.BoolVar(
+		&c.simulate,
+		"simulate",
+		false,
+		"simulate the tx on the remote node and print the gas used, without signing it",
+	)
+
 	fs.BoolVar(
 		&c.broadcast,
 		"broadcast",
@@ -77,3 +127,176 @@ func (c *makeTxCfg) RegisterFlags(fs *flag.FlagSet) {
 		"chainid to sign for (only useful if --broadcast)",
 	)
 }
+
+// gasWantedValue is the value of the -gas-wanted flag, either an amount of
+// gas or "auto".
+type gasWantedValue struct {
+	auto bool
+	gas  int64
+}
+
+func (v *gasWantedValue) String() string {
+	if v.auto {
+		return "auto"
+	}
+	return strconv.FormatInt(v.gas, 10)
+}
+
+func (v *gasWantedValue) Set(s string) error {
+	if s == "auto" {
+		v.auto, v.gas = true, 0
+		return nil
+	}
+	gas, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return errors.New("invalid gas-wanted %q, expected an integer or \"auto\"", s)
+	}
+	v.auto, v.gas = false, gas
+	return nil
+}
+
+// execMakeTx sets the gas and fee of tx from the flags, simulating it if
+// they need to be estimated, and then either prints its simulation, signs
+// and broadcasts it, or prints it as JSON for signing.
+func execMakeTx(cfg *makeTxCfg, args []string, tx std.Tx, io *commands.IO) error {
+	if !cfg.gasWanted.auto && cfg.gasWanted.gas == 0 && !cfg.simulate {
+		return errors.New("gas-wanted not specified")
+	}
+	if cfg.gasFee == "" {
+		return errors.New("gas-fee not specified")
+	}
+
+	// set the fee payer, to be co-signed.
+	if cfg.feePayer != "" {
+		feePayer, err := resolveAddress(cfg.rootCfg, cfg.feePayer)
+		if err != nil {
+			return errors.Wrap(err, "resolving fee payer")
+		}
+		tx.FeePayer = &feePayer
+	}
+
+	// set the expiry of the tx.
+	tx.TimeoutHeight = cfg.timeoutHeight
+	tx.TimeoutTime = cfg.timeoutTime
+
+	// parse gas fee, or fetch the minimum gas price to derive it.
+	var (
+		gasfee   std.Coin
+		gasPrice std.GasPrice
+		err      error
+	)
+	if cfg.gasFee == "auto" {
+		gasPrice, err = queryMinGasPrice(cfg.rootCfg)
+		if err != nil {
+			return err
+		}
+		// a non-zero fee, so that its deduction is simulated.
+		gasfee = std.NewCoin(gasPrice.Price.Denom, 1)
+	} else {
+		gasfee, err = std.ParseCoin(cfg.gasFee)
+		if err != nil {
+			return errors.Wrap(err, "parsing gas fee coin")
+		}
+	}
+
+	gaswanted := cfg.gasWanted.gas
+	if cfg.simulate || cfg.gasWanted.auto {
+		tx.Fee = std.NewFee(gaswanted, gasfee)
+		res, err := simulateUnsignedTx(cfg.rootCfg, tx)
+		if err != nil {
+			return err
+		}
+		if res.DeliverTx.IsErr() {
+			return errors.Wrap(res.DeliverTx.Error, "simulate transaction failed: log:%s", res.DeliverTx.Log)
+		}
+		if cfg.simulate {
+			io.Println(string(res.DeliverTx.Data))
+			io.Println("OK!")
+			io.Println("GAS USED:  ", res.DeliverTx.GasUsed)
+			if res.DeliverTx.Log != "" {
+				io.Println("LOG:       ", res.DeliverTx.Log)
+			}
+			return nil
+		}
+		gaswanted = int64(math.Ceil(float64(res.DeliverTx.GasUsed) * cfg.gasAdjustment))
+	}
+	if cfg.gasFee == "auto" {
+		gasfee = feeForGas(gaswanted, gasPrice)
+	}
+	tx.Fee = std.NewFee(gaswanted, gasfee)
+
+	if cfg.broadcast {
+		return signAndBroadcast(cfg, args, tx, io)
+	}
+	io.Println(string(amino.MustMarshalJSON(tx)))
+	return nil
+}
+
+// simulateUnsignedTx simulates tx on the remote node. Signatures aren't
+// verified by simulations, so tx is given empty signatures, one per
+// signer.
+func simulateUnsignedTx(cfg *baseCfg, tx std.Tx) (*ctypes.ResultBroadcastTxCommit, error) {
+	remote := cfg.Remote
+	if remote == "" || remote == "y" {
+		return nil, errors.New("missing remote url")
+	}
+	tx.Signatures = make([]std.Signature, len(tx.GetSigners()))
+	bz, err := amino.Marshal(tx)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshaling tx binary bytes")
+	}
+	cli := client.NewHTTP(remote, "/websocket")
+	return simulateTx(cli, bz)
+}
+
+// queryMinGasPrice returns the first minimum gas price of the remote node.
+func queryMinGasPrice(cfg *baseCfg) (std.GasPrice, error) {
+	qres, err := queryHandler(&queryCfg{
+		rootCfg: cfg,
+		path:    ".app/min_gas_prices",
+	})
+	if err != nil {
+		return std.GasPrice{}, errors.Wrap(err, "query min gas prices")
+	}
+	if qres.Response.Error != nil {
+		return std.GasPrice{}, errors.Wrap(qres.Response.Error, "query min gas prices")
+	}
+	var gasPrices []std.GasPrice
+	if err := amino.UnmarshalJSON(qres.Response.Value, &gasPrices); err != nil {
+		return std.GasPrice{}, errors.Wrap(err, "unmarshaling min gas prices")
+	}
+	if len(gasPrices) == 0 {
+		return std.GasPrice{}, errors.New("remote node has no minimum gas prices, specify the gas-fee")
+	}
+	return gasPrices[0], nil
+}
+
+// feeForGas returns the smallest fee paying gas at gasPrice.
+func feeForGas(gas int64, gasPrice std.GasPrice) std.Coin {
+	// fee = ceil(gas * price / price gas)
+	fee := new(big.Int).Mul(big.NewInt(gas), big.NewInt(gasPrice.Price.Amount))
+	priceGas := big.NewInt(gasPrice.Gas)
+	fee.Add(fee, new(big.Int).Sub(priceGas, big.NewInt(1)))
+	fee.Quo(fee, priceGas)
+	if !fee.IsInt64() {
+		panic(fmt.Sprintf("fee overflow for %d gas at %v", gas, gasPrice))
+	}
+	return std.NewCoin(gasPrice.Price.Denom, fee.Int64())
+}
+
+// resolveAddress returns the address nameOrBech32, or of the local key
+// named nameOrBech32.
+func resolveAddress(cfg *baseCfg, nameOrBech32 string) (crypto.Address, error) {
+	if addr, err := crypto.AddressFromBech32(nameOrBech32); err == nil {
+		return addr, nil
+	}
+	kb, err := keys.NewKeyBaseFromDir(cfg.Home)
+	if err != nil {
+		return crypto.Address{}, err
+	}
+	info, err := kb.GetByName(nameOrBech32)
+	if err != nil {
+		return crypto.Address{}, err
+	}
+	return info.GetAddress(), nil
+}
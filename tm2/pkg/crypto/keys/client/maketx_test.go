@@ -0,0 +1,43 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/gnolang/gno/tm2/pkg/std"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGasWantedValue(t *testing.T) {
+	t.Parallel()
+
+	var v gasWantedValue
+	require.NoError(t, v.Set("2000000"))
+	assert.Equal(t, gasWantedValue{gas: 2000000}, v)
+	assert.Equal(t, "2000000", v.String())
+
+	require.NoError(t, v.Set("auto"))
+	assert.Equal(t, gasWantedValue{auto: true}, v)
+	assert.Equal(t, "auto", v.String())
+
+	assert.Error(t, v.Set("lots"))
+}
+
+func TestFeeForGas(t *testing.T) {
+	t.Parallel()
+
+	gasPrice, err := std.ParseGasPrice("1ugnot/10gas")
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		gas int64
+		fee int64
+	}{
+		{0, 0},
+		{10, 1},
+		{11, 2}, // rounded up.
+		{123456, 12346},
+	} {
+		assert.Equal(t, std.NewCoin("ugnot", tc.fee), feeForGas(tc.gas, gasPrice))
+	}
+}
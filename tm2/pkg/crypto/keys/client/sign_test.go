@@ -12,6 +12,7 @@ import (
 	"github.com/gnolang/gno/tm2/pkg/std"
 	"github.com/gnolang/gno/tm2/pkg/testutils"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_execSign(t *testing.T) {
@@ -85,3 +86,66 @@ func Test_execSign(t *testing.T) {
 	err = execSign(cfg, args, io)
 	assert.NoError(t, err)
 }
+
+func Test_SignHandlerFeePayer(t *testing.T) {
+	t.Parallel()
+
+	// make new test dir
+	kbHome, kbCleanUp := testutils.NewTestCaseDir(t)
+	assert.NotNil(t, kbHome)
+	defer kbCleanUp()
+
+	rootCfg := &baseCfg{
+		BaseOptions: BaseOptions{
+			Home:                  kbHome,
+			InsecurePasswordStdin: true,
+		},
+	}
+	encPassword := "12345678"
+
+	// add the signer and fee payer accounts to keybase.
+	kb, err := keys.NewKeyBaseFromDir(kbHome)
+	require.NoError(t, err)
+	signer, err := kb.CreateAccount("signer", testMnemonic, "", encPassword, 0, 0)
+	require.NoError(t, err)
+	payer, err := kb.CreateAccount("payer", testMnemonic, "", encPassword, 0, 1)
+	require.NoError(t, err)
+
+	// the fee payer is resolved by name or address.
+	addr, err := resolveAddress(rootCfg, "payer")
+	require.NoError(t, err)
+	assert.Equal(t, payer.GetAddress(), addr)
+	addr, err = resolveAddress(rootCfg, payer.GetAddress().String())
+	require.NoError(t, err)
+	assert.Equal(t, payer.GetAddress(), addr)
+	_, err = resolveAddress(rootCfg, "unknown")
+	assert.Error(t, err)
+
+	// create a sponsored tx to sign.
+	msg := sdkutils.NewTestMsg(signer.GetAddress())
+	fee := std.NewFee(1, std.NewCoin("ugnot", 1000000))
+	tx := std.NewTx([]std.Msg{msg}, fee, nil, "")
+	payerAddr := payer.GetAddress()
+	tx.FeePayer = &payerAddr
+
+	// signed by the signer, then co-signed by the fee payer.
+	for i, name := range []string{"signer", "payer"} {
+		signedTx, err := SignHandler(&signCfg{
+			rootCfg:      rootCfg,
+			chainID:      "dev",
+			nameOrBech32: name,
+			txJSON:       amino.MustMarshalJSON(tx),
+			pass:         encPassword,
+		})
+		require.NoError(t, err)
+		require.Len(t, signedTx.Signatures, 2)
+		assert.NotNil(t, signedTx.Signatures[i].PubKey)
+		tx = *signedTx
+	}
+	assert.True(t, signedBy(&tx, signer.GetAddress()))
+	assert.True(t, signedBy(&tx, payer.GetAddress()))
+	for i, sig := range tx.Signatures {
+		signBytes := tx.GetSignBytes("dev", 0, 0)
+		assert.True(t, sig.PubKey.VerifyBytes(signBytes, sig.Signature), "signature %d", i)
+	}
+}
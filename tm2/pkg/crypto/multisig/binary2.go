@@ -0,0 +1,69 @@
+// Code generated by gengo. DO NOT EDIT.
+
+package multisig
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+
+	"github.com/gnolang/gno/tm2/pkg/amino"
+)
+
+func (goo PubKeyMultisigThreshold) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	rv := reflect.ValueOf(goo)
+	// Field K (#1)
+	if goo.K != 0 {
+		if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3Varint); err != nil {
+			return
+		}
+		if err = amino.EncodeUvarint(buf, uint64(goo.K)); err != nil {
+			return
+		}
+	}
+	// Field PubKeys (#2)
+	if err = cdc.EncodeReflectBinaryField(buf, rv, 1); err != nil {
+		return
+	}
+	return nil
+}
+
+func (goo *PubKeyMultisigThreshold) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	rv := reflect.ValueOf(goo).Elem()
+	// Field K (#1)
+	if len(bz) == 0 {
+		goo.K = 0
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.K = 0
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of multisig.PubKeyMultisigThreshold, got %v", fnum)
+		}
+		if typ != amino.Typ3Varint {
+			return fmt.Errorf("expected field type %v for # 1 of multisig.PubKeyMultisigThreshold, got %v", amino.Typ3Varint, typ)
+		}
+		v, n, err := amino.DecodeUvarint(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		goo.K = uint(v)
+	}
+	// Field PubKeys (#2)
+	if n, err := cdc.DecodeReflectBinaryField(bz, rv, 1, &lastFieldNum); err != nil {
+		return err
+	} else {
+		bz = bz[n:]
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
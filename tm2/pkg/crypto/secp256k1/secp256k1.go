@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"crypto/sha256"
 	"crypto/subtle"
+	"errors"
 	"io"
 	"math/big"
 
@@ -144,3 +145,35 @@ func (pubKey PubKeySecp256k1) Equals(other crypto.PubKey) bool {
 	}
 	return false
 }
+
+// RecoverPubKey recovers the public key which produced sig over
+// SHA256(msg). The signature must be of the form R || S || V, where V is
+// the recovery id (0 or 1). Like VerifyBytes, it rejects signatures which
+// are not in lower-S form.
+func RecoverPubKey(msg []byte, sig []byte) (PubKeySecp256k1, error) {
+	if len(sig) != 65 {
+		return PubKeySecp256k1{}, errors.New("invalid recoverable signature size")
+	}
+	v := sig[64]
+	if v >= 27 {
+		v -= 27 // also accept the ethereum-style 27/28 recovery ids.
+	}
+	if v > 1 {
+		return PubKeySecp256k1{}, errors.New("invalid recovery id")
+	}
+	s := new(big.Int).SetBytes(sig[32:64])
+	if s.Cmp(new(big.Int).Rsh(secp256k1.S256().N, 1)) > 0 {
+		return PubKeySecp256k1{}, errors.New("signature is not in lower-S form")
+	}
+	// btcec expects <27+v+4 (compressed)> || R || S
+	compact := make([]byte, 65)
+	compact[0] = 27 + 4 + v
+	copy(compact[1:], sig[:64])
+	pub, _, err := secp256k1.RecoverCompact(secp256k1.S256(), compact, crypto.Sha256(msg))
+	if err != nil {
+		return PubKeySecp256k1{}, err
+	}
+	var pubKey PubKeySecp256k1
+	copy(pubKey[:], pub.SerializeCompressed())
+	return pubKey, nil
+}
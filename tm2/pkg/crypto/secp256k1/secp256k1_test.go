@@ -65,6 +65,36 @@ func TestSignAndValidateSecp256k1(t *testing.T) {
 	assert.False(t, pubKey.VerifyBytes(msg, sig))
 }
 
+func TestRecoverPubKeySecp256k1(t *testing.T) {
+	privKey := secp256k1.GenPrivKey()
+	pubKey := privKey.PubKey()
+
+	msg := crypto.CRandBytes(128)
+	priv, _ := underlyingSecp256k1.PrivKeyFromBytes(underlyingSecp256k1.S256(), privKey[:])
+	compact, err := underlyingSecp256k1.SignCompact(underlyingSecp256k1.S256(), priv, crypto.Sha256(msg), true)
+	require.Nil(t, err)
+
+	// Convert <27+v+4> || R || S to R || S || V.
+	sig := append(compact[1:], compact[0]-27-4)
+	recovered, err := secp256k1.RecoverPubKey(msg, sig)
+	require.Nil(t, err)
+	assert.Equal(t, pubKey, recovered)
+	assert.True(t, recovered.VerifyBytes(msg, sig[:64]))
+
+	// Another message recovers another key.
+	recovered, err = secp256k1.RecoverPubKey(append(msg, 0x00), sig)
+	if err == nil {
+		assert.NotEqual(t, pubKey, recovered)
+	}
+
+	// Invalid recovery id and size.
+	sig[64] = 2
+	_, err = secp256k1.RecoverPubKey(msg, sig)
+	assert.NotNil(t, err)
+	_, err = secp256k1.RecoverPubKey(msg, sig[:64])
+	assert.NotNil(t, err)
+}
+
 // This test is intended to justify the removal of calls to the underlying library
 // in creating the privkey.
 func TestSecp256k1LoadPrivkeyAndSerializeIsIdentity(t *testing.T) {
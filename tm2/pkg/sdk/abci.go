@@ -16,3 +16,11 @@ type BeginBlocker func(ctx Context, req abci.RequestBeginBlock) abci.ResponseBeg
 // Note: applications which set create_empty_blocks=false will not have regular block timing and should use
 // e.g. BFT timestamps rather than block height for any periodic EndBlock logic
 type EndBlocker func(ctx Context, req abci.RequestEndBlock) abci.ResponseEndBlock
+
+// PrepareProposer selects the txs of a block to propose, e.g. reordering,
+// dropping or injecting txs. Changes to the state of ctx are discarded.
+type PrepareProposer func(ctx Context, req abci.RequestPrepareProposal) abci.ResponsePrepareProposal
+
+// ProcessProposer rejects a proposed block by returning an error in the
+// response. Changes to the state of ctx are discarded.
+type ProcessProposer func(ctx Context, req abci.RequestProcessProposal) abci.ResponseProcessProposal
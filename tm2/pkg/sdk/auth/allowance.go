@@ -0,0 +1,62 @@
+package auth
+
+import (
+	"fmt"
+
+	"github.com/gnolang/gno/tm2/pkg/amino"
+	"github.com/gnolang/gno/tm2/pkg/crypto"
+	"github.com/gnolang/gno/tm2/pkg/sdk"
+	"github.com/gnolang/gno/tm2/pkg/std"
+)
+
+// FeeAllowance caps the fees a granter pays for the txs of a grantee,
+// when the granter is set as their fee payer.
+type FeeAllowance struct {
+	SpendLimit std.Coins `json:"spend_limit" yaml:"spend_limit"` // remaining
+}
+
+// GetFeeAllowance returns the fee allowance granted by granter to
+// grantee, if any.
+func (ak AccountKeeper) GetFeeAllowance(ctx sdk.Context, granter, grantee crypto.Address) (FeeAllowance, bool) {
+	stor := ctx.Store(ak.key)
+	bz := stor.Get(FeeAllowanceStoreKey(granter, grantee))
+	if bz == nil {
+		return FeeAllowance{}, false
+	}
+	var allowance FeeAllowance
+	amino.MustUnmarshal(bz, &allowance)
+	return allowance, true
+}
+
+// SetFeeAllowance sets (or replaces) the fee allowance granted by granter
+// to grantee.
+func (ak AccountKeeper) SetFeeAllowance(ctx sdk.Context, granter, grantee crypto.Address, allowance FeeAllowance) {
+	stor := ctx.Store(ak.key)
+	stor.Set(FeeAllowanceStoreKey(granter, grantee), amino.MustMarshal(allowance))
+}
+
+// RemoveFeeAllowance revokes the fee allowance granted by granter to
+// grantee.
+func (ak AccountKeeper) RemoveFeeAllowance(ctx sdk.Context, granter, grantee crypto.Address) {
+	stor := ctx.Store(ak.key)
+	stor.Delete(FeeAllowanceStoreKey(granter, grantee))
+}
+
+// UseFeeAllowance deducts fees from the fee allowance granted by granter
+// to grantee, if there is one. Without an allowance, the fees are not
+// capped.
+func (ak AccountKeeper) UseFeeAllowance(ctx sdk.Context, granter, grantee crypto.Address, fees std.Coins) error {
+	allowance, ok := ak.GetFeeAllowance(ctx, granter, grantee)
+	if !ok {
+		return nil
+	}
+	remaining := allowance.SpendLimit.SubUnsafe(fees)
+	if !remaining.IsValid() {
+		return std.ErrInsufficientFunds(fmt.Sprintf(
+			"fee allowance of %s granted by %s exceeded; %s < %s",
+			grantee, granter, allowance.SpendLimit, fees))
+	}
+	allowance.SpendLimit = remaining
+	ak.SetFeeAllowance(ctx, granter, grantee, allowance)
+	return nil
+}
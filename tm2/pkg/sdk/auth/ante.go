@@ -39,8 +39,10 @@ type AnteOptions struct {
 }
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
-// numbers, checks signatures & account numbers, and deducts fees from the first
-// signer.
+// numbers, checks signatures & account numbers, and deducts fees from the fee
+// payer: the first signer, or the tx FeePayer if set. A fee payer other than
+// the first signer is limited by the fee allowance it granted to the first
+// signer, if any.
 func NewAnteHandler(ak AccountKeeper, bank BankKeeperI, sigGasConsumer SignatureVerificationGasConsumer, opts AnteOptions) sdk.AnteHandler {
 	return func(
 		ctx sdk.Context, tx std.Tx, simulate bool,
@@ -111,27 +113,40 @@ func NewAnteHandler(ak AccountKeeper, bank BankKeeperI, sigGasConsumer Signature
 			return newCtx, res, true
 		}
 
+		if res := ValidateTimeout(ctx, tx); !res.IsOK() {
+			return newCtx, res, true
+		}
+
 		// stdSigs contains the sequence number, account number, and signatures.
 		// When simulating, this would just be a 0-length slice.
 		signerAddrs := tx.GetSigners()
 		signerAccs := make([]std.Account, len(signerAddrs))
 		isGenesis := ctx.BlockHeight() == 0
 
-		// fetch first signer, who's going to pay the fees
-		signerAccs[0], res = GetSignerAcc(newCtx, ak, signerAddrs[0])
+		// fetch the fee payer, who's going to pay the fees
+		payer := feePayerIndex(tx, signerAddrs)
+		signerAccs[payer], res = GetSignerAcc(newCtx, ak, signerAddrs[payer])
 		if !res.IsOK() {
 			return newCtx, res, true
 		}
 
 		// deduct the fees
 		if !tx.Fee.GasFee.IsZero() {
-			res = DeductFees(bank, newCtx, signerAccs[0], std.Coins{tx.Fee.GasFee})
+			fees := std.Coins{tx.Fee.GasFee}
+			if payer != 0 {
+				// sponsored tx, within the allowance granted to the first signer.
+				err := ak.UseFeeAllowance(newCtx, signerAddrs[payer], signerAddrs[0], fees)
+				if err != nil {
+					return newCtx, abciResult(err), true
+				}
+			}
+			res = DeductFees(bank, newCtx, signerAccs[payer], fees)
 			if !res.IsOK() {
 				return newCtx, res, true
 			}
 
 			// reload the account as fees have been deducted
-			signerAccs[0] = ak.GetAccount(newCtx, signerAccs[0].GetAddress())
+			signerAccs[payer] = ak.GetAccount(newCtx, signerAccs[payer].GetAddress())
 		}
 
 		// stdSigs contains the sequence number, account number, and signatures.
@@ -140,7 +155,7 @@ func NewAnteHandler(ak AccountKeeper, bank BankKeeperI, sigGasConsumer Signature
 
 		for i := 0; i < len(stdSigs); i++ {
 			// skip the fee payer, account is cached and fees were deducted already
-			if i != 0 {
+			if i != payer {
 				signerAccs[i], res = GetSignerAcc(newCtx, ak, signerAddrs[i])
 				if !res.IsOK() {
 					return newCtx, res, true
@@ -167,6 +182,18 @@ func NewAnteHandler(ak AccountKeeper, bank BankKeeperI, sigGasConsumer Signature
 	}
 }
 
+// feePayerIndex returns the index of the fee payer of tx in signerAddrs,
+// as returned by tx.GetSigners().
+func feePayerIndex(tx std.Tx, signerAddrs []crypto.Address) int {
+	feePayer := tx.GetFeePayer()
+	for i, addr := range signerAddrs {
+		if addr == feePayer {
+			return i
+		}
+	}
+	panic("fee payer not in signers") // should not happen
+}
+
 // GetSignerAcc returns an account for a given address that is expected to sign
 // a transaction.
 func GetSignerAcc(ctx sdk.Context, ak AccountKeeper, addr crypto.Address) (std.Account, sdk.Result) {
@@ -209,6 +236,21 @@ func ValidateMemo(tx std.Tx, params Params) sdk.Result {
 	return sdk.Result{}
 }
 
+// ValidateTimeout validates that the tx is not expired at the block height
+// and time of ctx.
+func ValidateTimeout(ctx sdk.Context, tx std.Tx) sdk.Result {
+	if tx.IsExpired(ctx.BlockHeight(), ctx.BlockTime()) {
+		return abciResult(std.ErrTxExpired(
+			fmt.Sprintf(
+				"tx timeout height %d or time %d passed at height %d and time %d",
+				tx.TimeoutHeight, tx.TimeoutTime, ctx.BlockHeight(), ctx.BlockTime().Unix(),
+			),
+		))
+	}
+
+	return sdk.Result{}
+}
+
 // verify the signature and increment the sequence. If the account doesn't
 // have a pubkey, set it.
 func processSig(
@@ -346,7 +388,7 @@ func consumeMultisignatureVerificationGas(meter store.GasMeter,
 // NOTE: We could use the CoinKeeper (in addition to the AccountKeeper, because
 // the CoinKeeper doesn't give us accounts), but it seems easier to do this.
 func DeductFees(bank BankKeeperI, ctx sdk.Context, acc std.Account, fees std.Coins) sdk.Result {
-	coins := acc.GetCoins()
+	coins := std.SpendableCoins(acc, ctx.BlockTime())
 
 	if !fees.IsValid() {
 		return abciResult(std.ErrInsufficientFee(fmt.Sprintf("invalid fee amount: %s", fees)))
@@ -432,7 +474,8 @@ func GetSignBytes(chainID string, tx std.Tx, acc std.Account, genesis bool) []by
 		accNum = acc.GetAccountNumber()
 	}
 	signbz := std.SignBytes(
-		chainID, accNum, acc.GetSequence(), tx.Fee, tx.Msgs, tx.Memo,
+		chainID, accNum, acc.GetSequence(), tx.Fee, tx.Msgs, tx.Memo, tx.FeePayer,
+		tx.TimeoutHeight, tx.TimeoutTime,
 	)
 	return signbz
 }
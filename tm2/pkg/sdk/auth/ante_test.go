@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -339,6 +340,64 @@ func TestAnteHandlerFees(t *testing.T) {
 	require.Equal(t, env.acck.GetAccount(ctx, addr1).GetCoins().AmountOf("atom"), int64(0))
 }
 
+// Test logic around fee payers and fee allowances.
+func TestAnteHandlerFeePayer(t *testing.T) {
+	// setup
+	env := setupTestEnv()
+	ctx := env.ctx
+	anteHandler := NewAnteHandler(env.acck, env.bank, DefaultSigVerificationGasConsumer, defaultAnteOptions())
+
+	// keys and addresses
+	priv1, _, addr1 := tu.KeyTestPubAddr()
+	priv2, _, addr2 := tu.KeyTestPubAddr()
+
+	// set the accounts, only the payer has funds
+	acc1 := env.acck.NewAccountWithAddress(ctx, addr1)
+	env.acck.SetAccount(ctx, acc1)
+	acc2 := env.acck.NewAccountWithAddress(ctx, addr2)
+	acc2.SetCoins(std.NewCoins(std.NewCoin("atom", 300)))
+	env.acck.SetAccount(ctx, acc2)
+
+	// msg and signatures
+	var tx std.Tx
+	msgs := []std.Msg{tu.NewTestMsg(addr1)}
+	fee := tu.NewTestFee()
+
+	// the fee payer must sign
+	privs, accnums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx = tu.NewTestTxWithFeePayer(ctx.ChainID(), msgs, privs, accnums, seqs, fee, addr2)
+	checkInvalidTx(t, anteHandler, ctx, tx, false, std.UnauthorizedError{})
+
+	// the fee payer must be signed over
+	// (on a cached ctx, as fees are deducted before signatures are checked)
+	privs, accnums, seqs = []crypto.PrivKey{priv1, priv2}, []uint64{0, 1}, []uint64{0, 0}
+	tx = tu.NewTestTx(ctx.ChainID(), msgs, privs, accnums, seqs, fee)
+	tx.FeePayer = &addr2
+	cctx, _ := ctx.CacheContext()
+	checkInvalidTx(t, anteHandler, cctx, tx, false, std.UnauthorizedError{})
+
+	// the fee payer pays
+	tx = tu.NewTestTxWithFeePayer(ctx.ChainID(), msgs, privs, accnums, seqs, fee, addr2)
+	checkValidTx(t, anteHandler, ctx, tx, false)
+	require.Equal(t, int64(0), env.acck.GetAccount(ctx, addr1).GetCoins().AmountOf("atom"))
+	require.Equal(t, int64(150), env.acck.GetAccount(ctx, addr2).GetCoins().AmountOf("atom"))
+	require.Equal(t, uint64(1), env.acck.GetAccount(ctx, addr1).GetSequence())
+	require.Equal(t, uint64(1), env.acck.GetAccount(ctx, addr2).GetSequence())
+
+	// a fee allowance caps the fees paid for the first signer
+	env.acck.SetFeeAllowance(ctx, addr2, addr1, FeeAllowance{SpendLimit: std.NewCoins(std.NewCoin("atom", 100))})
+	seqs = []uint64{1, 1}
+	tx = tu.NewTestTxWithFeePayer(ctx.ChainID(), msgs, privs, accnums, seqs, fee, addr2)
+	checkInvalidTx(t, anteHandler, ctx, tx, false, std.InsufficientFundsError{})
+
+	env.acck.SetFeeAllowance(ctx, addr2, addr1, FeeAllowance{SpendLimit: std.NewCoins(std.NewCoin("atom", 200))})
+	checkValidTx(t, anteHandler, ctx, tx, false)
+	allowance, ok := env.acck.GetFeeAllowance(ctx, addr2, addr1)
+	require.True(t, ok)
+	require.Equal(t, int64(50), allowance.SpendLimit.AmountOf("atom"))
+	require.Equal(t, int64(0), env.acck.GetAccount(ctx, addr2).GetCoins().AmountOf("atom"))
+}
+
 // Test logic around memo gas consumption.
 func TestAnteHandlerMemoGas(t *testing.T) {
 	// setup
@@ -380,6 +439,46 @@ func TestAnteHandlerMemoGas(t *testing.T) {
 	checkValidTx(t, anteHandler, ctx, tx, false)
 }
 
+func TestAnteHandlerTimeout(t *testing.T) {
+	// setup
+	env := setupTestEnv()
+	anteHandler := NewAnteHandler(env.acck, env.bank, DefaultSigVerificationGasConsumer, defaultAnteOptions())
+	ctx := env.ctx.WithBlockHeader(&bft.Header{Height: 10, Time: time.Unix(1000, 0), ChainID: "test-chain-id"})
+
+	// keys and addresses
+	priv1, _, addr1 := tu.KeyTestPubAddr()
+
+	// set the accounts
+	acc1 := env.acck.NewAccountWithAddress(ctx, addr1)
+	require.NoError(t, acc1.SetAccountNumber(0))
+	require.NoError(t, acc1.SetCoins(tu.NewTestCoins()))
+	env.acck.SetAccount(ctx, acc1)
+
+	// msg and signatures
+	var tx std.Tx
+	msg := tu.NewTestMsg(addr1)
+	privs, accnums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	fee := tu.NewTestFee()
+
+	// past timeout height
+	tx = tu.NewTestTxWithTimeout(ctx.ChainID(), []std.Msg{msg}, privs, accnums, seqs, fee, 9, 0)
+	checkInvalidTx(t, anteHandler, ctx, tx, false, std.TxExpiredError{})
+
+	// past timeout time
+	tx = tu.NewTestTxWithTimeout(ctx.ChainID(), []std.Msg{msg}, privs, accnums, seqs, fee, 0, 999)
+	checkInvalidTx(t, anteHandler, ctx, tx, false, std.TxExpiredError{})
+
+	// timeouts are signed
+	tx = tu.NewTestTxWithTimeout(ctx.ChainID(), []std.Msg{msg}, privs, accnums, seqs, fee, 10, 1000)
+	tx.TimeoutHeight = 11
+	cctx, _ := ctx.CacheContext()
+	checkInvalidTx(t, anteHandler, cctx, tx, false, std.UnauthorizedError{})
+
+	// valid up to the timeout height and time
+	tx = tu.NewTestTxWithTimeout(ctx.ChainID(), []std.Msg{msg}, privs, accnums, seqs, fee, 10, 1000)
+	checkValidTx(t, anteHandler, ctx, tx, false)
+}
+
 func TestAnteHandlerMultiSigner(t *testing.T) {
 	// setup
 	env := setupTestEnv()
@@ -488,7 +587,7 @@ func TestAnteHandlerBadSignBytes(t *testing.T) {
 	for _, cs := range cases {
 		tx := tu.NewTestTxWithSignBytes(
 			msgs, privs, accnums, seqs, fee,
-			std.SignBytes(cs.chainID, cs.accnum, cs.seq, cs.fee, cs.msgs, ""),
+			std.SignBytes(cs.chainID, cs.accnum, cs.seq, cs.fee, cs.msgs, "", nil, 0, 0),
 			"",
 		)
 		checkInvalidTx(t, anteHandler, ctx, tx, false, cs.err)
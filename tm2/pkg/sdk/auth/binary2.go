@@ -0,0 +1,305 @@
+// Code generated by gengo. DO NOT EDIT.
+
+package auth
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/gnolang/gno/tm2/pkg/amino"
+	"github.com/gnolang/gno/tm2/pkg/crypto"
+)
+
+func (goo FeeAllowance) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field SpendLimit (#1)
+	if len(goo.SpendLimit) != 0 {
+		var repr string
+		if repr, err = goo.SpendLimit.MarshalAmino(); err != nil {
+			return
+		}
+		if repr != "" {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeString(buf, repr); err != nil {
+				return
+			}
+		}
+	}
+	return nil
+}
+
+func (goo *FeeAllowance) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field SpendLimit (#1)
+	if len(bz) == 0 {
+		goo.SpendLimit = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.SpendLimit = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of auth.FeeAllowance, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of auth.FeeAllowance, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.SpendLimit.UnmarshalAmino(v); err != nil {
+			return err
+		}
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo MsgGrantFeeAllowance) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field Granter (#1)
+	{
+		var repr string
+		if repr, err = goo.Granter.MarshalAmino(); err != nil {
+			return
+		}
+		if repr != "" {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeString(buf, repr); err != nil {
+				return
+			}
+		}
+	}
+	// Field Grantee (#2)
+	{
+		var repr string
+		if repr, err = goo.Grantee.MarshalAmino(); err != nil {
+			return
+		}
+		if repr != "" {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeString(buf, repr); err != nil {
+				return
+			}
+		}
+	}
+	// Field SpendLimit (#3)
+	if len(goo.SpendLimit) != 0 {
+		var repr string
+		if repr, err = goo.SpendLimit.MarshalAmino(); err != nil {
+			return
+		}
+		if repr != "" {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 3, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeString(buf, repr); err != nil {
+				return
+			}
+		}
+	}
+	return nil
+}
+
+func (goo *MsgGrantFeeAllowance) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field Granter (#1)
+	if len(bz) == 0 {
+		goo.Granter = crypto.Address{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Granter = crypto.Address{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of auth.MsgGrantFeeAllowance, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of auth.MsgGrantFeeAllowance, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Granter.UnmarshalAmino(v); err != nil {
+			return err
+		}
+	}
+	// Field Grantee (#2)
+	if len(bz) == 0 {
+		goo.Grantee = crypto.Address{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.Grantee = crypto.Address{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of auth.MsgGrantFeeAllowance, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of auth.MsgGrantFeeAllowance, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Grantee.UnmarshalAmino(v); err != nil {
+			return err
+		}
+	}
+	// Field SpendLimit (#3)
+	if len(bz) == 0 {
+		goo.SpendLimit = nil
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 3 {
+		goo.SpendLimit = nil
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 3 {
+			return fmt.Errorf("expected field # 3 of auth.MsgGrantFeeAllowance, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 3 of auth.MsgGrantFeeAllowance, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.SpendLimit.UnmarshalAmino(v); err != nil {
+			return err
+		}
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
+
+func (goo MsgRevokeFeeAllowance) MarshalBinary2(cdc *amino.Codec, buf *bytes.Buffer) (err error) {
+	// Field Granter (#1)
+	{
+		var repr string
+		if repr, err = goo.Granter.MarshalAmino(); err != nil {
+			return
+		}
+		if repr != "" {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 1, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeString(buf, repr); err != nil {
+				return
+			}
+		}
+	}
+	// Field Grantee (#2)
+	{
+		var repr string
+		if repr, err = goo.Grantee.MarshalAmino(); err != nil {
+			return
+		}
+		if repr != "" {
+			if err = amino.EncodeFieldNumberAndTyp3(buf, 2, amino.Typ3ByteLength); err != nil {
+				return
+			}
+			if err = amino.EncodeString(buf, repr); err != nil {
+				return
+			}
+		}
+	}
+	return nil
+}
+
+func (goo *MsgRevokeFeeAllowance) UnmarshalBinary2(cdc *amino.Codec, bz []byte) error {
+	var lastFieldNum uint32
+	// Field Granter (#1)
+	if len(bz) == 0 {
+		goo.Granter = crypto.Address{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 1 {
+		goo.Granter = crypto.Address{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 1 {
+			return fmt.Errorf("expected field # 1 of auth.MsgRevokeFeeAllowance, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 1 of auth.MsgRevokeFeeAllowance, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Granter.UnmarshalAmino(v); err != nil {
+			return err
+		}
+	}
+	// Field Grantee (#2)
+	if len(bz) == 0 {
+		goo.Grantee = crypto.Address{}
+	} else if fnum, typ, n, err := amino.DecodeFieldNumberAndTyp3(bz); fnum > 2 {
+		goo.Grantee = crypto.Address{}
+	} else {
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if fnum <= lastFieldNum {
+			return fmt.Errorf("encountered fieldNum: %v, but we have already seen fnum: %v\nbytes:%X", fnum, lastFieldNum, bz)
+		}
+		lastFieldNum = fnum
+		if fnum != 2 {
+			return fmt.Errorf("expected field # 2 of auth.MsgRevokeFeeAllowance, got %v", fnum)
+		}
+		if typ != amino.Typ3ByteLength {
+			return fmt.Errorf("expected field type %v for # 2 of auth.MsgRevokeFeeAllowance, got %v", amino.Typ3ByteLength, typ)
+		}
+		v, n, err := amino.DecodeString(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+		if err := goo.Grantee.UnmarshalAmino(v); err != nil {
+			return err
+		}
+	}
+	_, err := amino.ConsumeFields(bz, lastFieldNum)
+	return err
+}
@@ -20,6 +20,9 @@ const (
 	// AddressStoreKeyPrefix prefix for account-by-address store
 	AddressStoreKeyPrefix = "/a/"
 
+	// FeeAllowanceStoreKeyPrefix prefix for fee-allowance-by-granter-and-grantee store
+	FeeAllowanceStoreKeyPrefix = "/fa/"
+
 	// param key for global account number
 	GlobalAccountNumberKey = "globalAccountNumber"
 )
@@ -29,6 +32,13 @@ func AddressStoreKey(addr crypto.Address) []byte {
 	return append([]byte(AddressStoreKeyPrefix), addr.Bytes()...)
 }
 
+// FeeAllowanceStoreKey turns a granter and grantee address pair to the key
+// used to get the fee allowance from the store.
+func FeeAllowanceStoreKey(granter, grantee crypto.Address) []byte {
+	key := append([]byte(FeeAllowanceStoreKeyPrefix), granter.Bytes()...)
+	return append(key, grantee.Bytes()...)
+}
+
 // NOTE: do not modify.
 // XXX: consider parameterization at the keeper level.
 var feeCollector crypto.Address
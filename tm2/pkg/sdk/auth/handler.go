@@ -23,9 +23,32 @@ func NewHandler(acck AccountKeeper) authHandler {
 }
 
 func (ah authHandler) Process(ctx sdk.Context, msg std.Msg) sdk.Result {
-	// no messages supported yet.
-	errMsg := fmt.Sprintf("unrecognized auth message type: %T", msg)
-	return abciResult(std.ErrUnknownRequest(errMsg))
+	switch msg := msg.(type) {
+	case MsgGrantFeeAllowance:
+		return ah.handleMsgGrantFeeAllowance(ctx, msg)
+	case MsgRevokeFeeAllowance:
+		return ah.handleMsgRevokeFeeAllowance(ctx, msg)
+	default:
+		errMsg := fmt.Sprintf("unrecognized auth message type: %T", msg)
+		return abciResult(std.ErrUnknownRequest(errMsg))
+	}
+}
+
+// Handle MsgGrantFeeAllowance.
+func (ah authHandler) handleMsgGrantFeeAllowance(ctx sdk.Context, msg MsgGrantFeeAllowance) sdk.Result {
+	allowance := FeeAllowance{SpendLimit: msg.SpendLimit}
+	ah.acck.SetFeeAllowance(ctx, msg.Granter, msg.Grantee, allowance)
+	return sdk.Result{}
+}
+
+// Handle MsgRevokeFeeAllowance.
+func (ah authHandler) handleMsgRevokeFeeAllowance(ctx sdk.Context, msg MsgRevokeFeeAllowance) sdk.Result {
+	if _, ok := ah.acck.GetFeeAllowance(ctx, msg.Granter, msg.Grantee); !ok {
+		return abciResult(std.ErrUnknownRequest(fmt.Sprintf(
+			"no fee allowance granted by %s to %s", msg.Granter, msg.Grantee)))
+	}
+	ah.acck.RemoveFeeAllowance(ctx, msg.Granter, msg.Grantee)
+	return sdk.Result{}
 }
 
 //----------------------------------------
@@ -34,10 +57,15 @@ func (ah authHandler) Process(ctx sdk.Context, msg std.Msg) sdk.Result {
 // query account path
 const QueryAccount = "accounts"
 
+// query fee allowance path
+const QueryFeeAllowance = "allowances"
+
 func (ah authHandler) Query(ctx sdk.Context, req abci.RequestQuery) (res abci.ResponseQuery) {
 	switch secondPart(req.Path) {
 	case QueryAccount:
 		return ah.queryAccount(ctx, req)
+	case QueryFeeAllowance:
+		return ah.queryFeeAllowance(ctx, req)
 	default:
 		res = sdk.ABCIResponseQueryFromError(
 			std.ErrUnknownRequest("unknown auth query endpoint"))
@@ -72,6 +100,42 @@ func (ah authHandler) queryAccount(ctx sdk.Context, req abci.RequestQuery) (res
 	return
 }
 
+// queryFeeAllowance fetches the fee allowance granted by the granter to the
+// grantee, passed as path components.
+func (ah authHandler) queryFeeAllowance(ctx sdk.Context, req abci.RequestQuery) (res abci.ResponseQuery) {
+	// parse addrs from path.
+	b32granter, b32grantee := thirdPart(req.Path), fourthPart(req.Path)
+	granter, err := crypto.AddressFromBech32(b32granter)
+	if err != nil {
+		res = sdk.ABCIResponseQueryFromError(
+			std.ErrInvalidAddress(
+				"invalid query granter address " + b32granter))
+		return
+	}
+	grantee, err := crypto.AddressFromBech32(b32grantee)
+	if err != nil {
+		res = sdk.ABCIResponseQueryFromError(
+			std.ErrInvalidAddress(
+				"invalid query grantee address " + b32grantee))
+		return
+	}
+
+	// get fee allowance, null if none.
+	var allowance *FeeAllowance
+	if fa, ok := ah.acck.GetFeeAllowance(ctx, granter, grantee); ok {
+		allowance = &fa
+	}
+	bz, err := amino.MarshalJSONIndent(allowance, "", "  ")
+	if err != nil {
+		res = sdk.ABCIResponseQueryFromError(
+			std.ErrInternal(fmt.Sprintf("could not marshal result to JSON: %s", err.Error())))
+		return
+	}
+
+	res.Data = bz
+	return
+}
+
 //----------------------------------------
 // misc
 
@@ -94,3 +158,13 @@ func thirdPart(path string) string {
 		return parts[2]
 	}
 }
+
+// returns the fourth component of a path.
+func fourthPart(path string) string {
+	parts := strings.Split(path, "/")
+	if len(parts) < 4 {
+		return ""
+	} else {
+		return parts[3]
+	}
+}
@@ -0,0 +1,58 @@
+package auth
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnolang/gno/tm2/pkg/amino"
+	abci "github.com/gnolang/gno/tm2/pkg/bft/abci/types"
+	"github.com/gnolang/gno/tm2/pkg/crypto"
+	"github.com/gnolang/gno/tm2/pkg/std"
+)
+
+func TestFeeAllowanceMsgs(t *testing.T) {
+	env := setupTestEnv()
+	h := NewHandler(env.acck)
+	granter := crypto.AddressFromPreimage([]byte("granter"))
+	grantee := crypto.AddressFromPreimage([]byte("grantee"))
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("auth/%s/%s/%s", QueryFeeAllowance, granter, grantee),
+	}
+
+	// no allowance yet.
+	res := h.Query(env.ctx, req)
+	require.Nil(t, res.Error)
+	require.Equal(t, "null", string(res.Data))
+
+	// grant.
+	limit := std.NewCoins(std.NewCoin("ugnot", 1000))
+	msg := NewMsgGrantFeeAllowance(granter, grantee, limit)
+	require.NoError(t, msg.ValidateBasic())
+	require.True(t, h.Process(env.ctx, msg).IsOK())
+
+	res = h.Query(env.ctx, req)
+	require.Nil(t, res.Error)
+	var allowance FeeAllowance
+	require.NoError(t, amino.UnmarshalJSON(res.Data, &allowance))
+	require.Equal(t, limit, allowance.SpendLimit)
+
+	// spend within, then beyond the allowance.
+	fees := std.NewCoins(std.NewCoin("ugnot", 600))
+	require.NoError(t, env.acck.UseFeeAllowance(env.ctx, granter, grantee, fees))
+	require.Error(t, env.acck.UseFeeAllowance(env.ctx, granter, grantee, fees))
+	allowance, _ = env.acck.GetFeeAllowance(env.ctx, granter, grantee)
+	require.Equal(t, int64(400), allowance.SpendLimit.AmountOf("ugnot"))
+
+	// revoke.
+	revoke := NewMsgRevokeFeeAllowance(granter, grantee)
+	require.True(t, h.Process(env.ctx, revoke).IsOK())
+	_, ok := env.acck.GetFeeAllowance(env.ctx, granter, grantee)
+	require.False(t, ok)
+	require.False(t, h.Process(env.ctx, revoke).IsOK())
+
+	// invalid msgs.
+	require.Error(t, NewMsgGrantFeeAllowance(granter, granter, limit).ValidateBasic())
+	require.Error(t, NewMsgGrantFeeAllowance(granter, crypto.Address{}, limit).ValidateBasic())
+}
@@ -0,0 +1,99 @@
+package auth
+
+import (
+	"github.com/gnolang/gno/tm2/pkg/amino"
+	"github.com/gnolang/gno/tm2/pkg/crypto"
+	"github.com/gnolang/gno/tm2/pkg/std"
+)
+
+// RouterKey is the name of the auth module
+const RouterKey = ModuleName
+
+// MsgGrantFeeAllowance - caps the fees Granter pays as the fee payer of the
+// txs of Grantee to SpendLimit.
+type MsgGrantFeeAllowance struct {
+	Granter    crypto.Address `json:"granter" yaml:"granter"`
+	Grantee    crypto.Address `json:"grantee" yaml:"grantee"`
+	SpendLimit std.Coins      `json:"spend_limit" yaml:"spend_limit"`
+}
+
+var _ std.Msg = MsgGrantFeeAllowance{}
+
+// NewMsgGrantFeeAllowance - construct a fee allowance grant msg.
+func NewMsgGrantFeeAllowance(granter, grantee crypto.Address, spendLimit std.Coins) MsgGrantFeeAllowance {
+	return MsgGrantFeeAllowance{Granter: granter, Grantee: grantee, SpendLimit: spendLimit}
+}
+
+// Route Implements Msg.
+func (msg MsgGrantFeeAllowance) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgGrantFeeAllowance) Type() string { return "grant_fee_allowance" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgGrantFeeAllowance) ValidateBasic() error {
+	if msg.Granter.IsZero() {
+		return std.ErrInvalidAddress("missing granter address")
+	}
+	if msg.Grantee.IsZero() {
+		return std.ErrInvalidAddress("missing grantee address")
+	}
+	if msg.Granter == msg.Grantee {
+		return std.ErrInvalidAddress("granter and grantee must differ")
+	}
+	if !msg.SpendLimit.IsValid() {
+		return std.ErrInvalidCoins("spend limit is invalid: " + msg.SpendLimit.String())
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgGrantFeeAllowance) GetSignBytes() []byte {
+	return std.MustSortJSON(amino.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgGrantFeeAllowance) GetSigners() []crypto.Address {
+	return []crypto.Address{msg.Granter}
+}
+
+// MsgRevokeFeeAllowance - revokes the fee allowance granted by Granter to
+// Grantee.
+type MsgRevokeFeeAllowance struct {
+	Granter crypto.Address `json:"granter" yaml:"granter"`
+	Grantee crypto.Address `json:"grantee" yaml:"grantee"`
+}
+
+var _ std.Msg = MsgRevokeFeeAllowance{}
+
+// NewMsgRevokeFeeAllowance - construct a fee allowance revocation msg.
+func NewMsgRevokeFeeAllowance(granter, grantee crypto.Address) MsgRevokeFeeAllowance {
+	return MsgRevokeFeeAllowance{Granter: granter, Grantee: grantee}
+}
+
+// Route Implements Msg.
+func (msg MsgRevokeFeeAllowance) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgRevokeFeeAllowance) Type() string { return "revoke_fee_allowance" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgRevokeFeeAllowance) ValidateBasic() error {
+	if msg.Granter.IsZero() {
+		return std.ErrInvalidAddress("missing granter address")
+	}
+	if msg.Grantee.IsZero() {
+		return std.ErrInvalidAddress("missing grantee address")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgRevokeFeeAllowance) GetSignBytes() []byte {
+	return std.MustSortJSON(amino.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgRevokeFeeAllowance) GetSigners() []crypto.Address {
+	return []crypto.Address{msg.Granter}
+}
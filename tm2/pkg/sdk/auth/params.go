@@ -18,6 +18,15 @@ const (
 	DefaultSigVerifyCostSecp256k1 int64 = 1000
 )
 
+// Upper bounds of the parameter values, above which ordinary transactions,
+// including the ones needed to update them again, run out of gas.
+const (
+	MaxTxSigLimit             int64 = 100
+	MaxTxSizeCostPerByte      int64 = 100
+	MaxSigVerifyCostED25519   int64 = 10000
+	MaxSigVerifyCostSecp256k1 int64 = 10000
+)
+
 // Params defines the parameters for the auth module.
 type Params struct {
 	MaxMemoBytes           int64 `json:"max_memo_bytes" yaml:"max_memo_bytes"`
@@ -45,6 +54,26 @@ func (p Params) Equals(p2 Params) bool {
 	return amino.DeepEqual(p, p2)
 }
 
+// ValidateBasic performs basic validation on auth parameters.
+func (p Params) ValidateBasic() error {
+	if p.MaxMemoBytes <= 0 {
+		return fmt.Errorf("invalid max memo bytes: %d", p.MaxMemoBytes)
+	}
+	if p.TxSigLimit <= 0 || p.TxSigLimit > MaxTxSigLimit {
+		return fmt.Errorf("invalid tx signature limit: %d, must be between 1 and %d", p.TxSigLimit, MaxTxSigLimit)
+	}
+	if p.TxSizeCostPerByte < 0 || p.TxSizeCostPerByte > MaxTxSizeCostPerByte {
+		return fmt.Errorf("invalid tx size cost per byte: %d, must be between 0 and %d", p.TxSizeCostPerByte, MaxTxSizeCostPerByte)
+	}
+	if p.SigVerifyCostED25519 < 0 || p.SigVerifyCostED25519 > MaxSigVerifyCostED25519 {
+		return fmt.Errorf("invalid ED25519 signature verification cost: %d, must be between 0 and %d", p.SigVerifyCostED25519, MaxSigVerifyCostED25519)
+	}
+	if p.SigVerifyCostSecp256k1 < 0 || p.SigVerifyCostSecp256k1 > MaxSigVerifyCostSecp256k1 {
+		return fmt.Errorf("invalid Secp256k1 signature verification cost: %d, must be between 0 and %d", p.SigVerifyCostSecp256k1, MaxSigVerifyCostSecp256k1)
+	}
+	return nil
+}
+
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
 	return Params{
@@ -98,13 +98,18 @@ func (bh bankHandler) handleMsgMultiSend(ctx sdk.Context, msg MsgMultiSend) sdk.
 //----------------------------------------
 // Query
 
-// query balance path
-const QueryBalance = "balances"
+// query balance paths
+const (
+	QueryBalance          = "balances"
+	QuerySpendableBalance = "spendable_balances"
+)
 
 func (bh bankHandler) Query(ctx sdk.Context, req abci.RequestQuery) (res abci.ResponseQuery) {
 	switch secondPart(req.Path) {
 	case QueryBalance:
-		return bh.queryBalance(ctx, req)
+		return bh.queryBalance(ctx, req, bh.bank.GetCoins)
+	case QuerySpendableBalance:
+		return bh.queryBalance(ctx, req, bh.bank.GetSpendableCoins)
 	default:
 		res = sdk.ABCIResponseQueryFromError(
 			std.ErrUnknownRequest("unknown bank query endpoint"))
@@ -112,19 +117,21 @@ func (bh bankHandler) Query(ctx sdk.Context, req abci.RequestQuery) (res abci.Re
 	}
 }
 
-// queryBalance fetch an account's balance for the supplied height.
-// Account address is passed as path component.
-func (bh bankHandler) queryBalance(ctx sdk.Context, req abci.RequestQuery) (res abci.ResponseQuery) {
+// queryBalance fetch an account's balance for the supplied height, total or
+// spendable depending on getCoins. Account address is passed as path
+// component.
+func (bh bankHandler) queryBalance(ctx sdk.Context, req abci.RequestQuery, getCoins func(sdk.Context, crypto.Address) std.Coins) (res abci.ResponseQuery) {
 	// parse addr from path.
 	b32addr := thirdPart(req.Path)
 	addr, err := crypto.AddressFromBech32(b32addr)
 	if err != nil {
 		res = sdk.ABCIResponseQueryFromError(
 			std.ErrInvalidAddress("invalid query address " + b32addr))
+		return
 	}
 
 	// get coins from addr.
-	bz, err := amino.MarshalJSONIndent(bh.bank.GetCoins(ctx, addr), "", "  ")
+	bz, err := amino.MarshalJSONIndent(getCoins(ctx, addr), "", "  ")
 	if err != nil {
 		res = sdk.ABCIResponseQueryFromError(
 			std.ErrInternal(fmt.Sprintf("could not marshal result to JSON: %s", err.Error())))
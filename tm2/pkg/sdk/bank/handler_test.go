@@ -48,6 +48,13 @@ func TestBalances(t *testing.T) {
 	require.NotNil(t, res)
 	require.NoError(t, amino.UnmarshalJSON(res.Data, &coins))
 	require.True(t, coins.AmountOf("foo") == 10)
+
+	// without vesting, all the coins are spendable.
+	req.Path = fmt.Sprintf("bank/%s/%s", QuerySpendableBalance, addr.String())
+	res = h.Query(env.ctx, req)
+	require.Nil(t, res.Error)
+	require.NoError(t, amino.UnmarshalJSON(res.Data, &coins))
+	require.True(t, coins.AmountOf("foo") == 10)
 }
 
 func TestQuerierRouteNotFound(t *testing.T) {
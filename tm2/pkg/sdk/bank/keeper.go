@@ -115,25 +115,26 @@ func (bank BankKeeper) SendCoins(ctx sdk.Context, fromAddr crypto.Address, toAdd
 
 // SubtractCoins subtracts amt from the coins at the addr.
 //
-// CONTRACT: If the account is a vesting account, the amount has to be spendable.
+// If the account is a vesting account, the amount has to be spendable.
 func (bank BankKeeper) SubtractCoins(ctx sdk.Context, addr crypto.Address, amt std.Coins) (std.Coins, error) {
 	if !amt.IsValid() {
 		return nil, std.ErrInvalidCoins(amt.String())
 	}
 
-	oldCoins := std.NewCoins()
+	oldCoins, spendable := std.NewCoins(), std.NewCoins()
 	acc := bank.acck.GetAccount(ctx, addr)
 	if acc != nil {
 		oldCoins = acc.GetCoins()
+		spendable = std.SpendableCoins(acc, ctx.BlockTime())
 	}
 
-	newCoins := oldCoins.SubUnsafe(amt)
-	if !newCoins.IsValid() {
+	if !spendable.SubUnsafe(amt).IsValid() {
 		err := std.ErrInsufficientCoins(
-			fmt.Sprintf("insufficient account funds; %s < %s", oldCoins, amt),
+			fmt.Sprintf("insufficient account funds; %s < %s", spendable, amt),
 		)
 		return nil, err
 	}
+	newCoins := oldCoins.SubUnsafe(amt)
 	err := bank.SetCoins(ctx, addr, newCoins)
 
 	return newCoins, err
@@ -185,6 +186,7 @@ func (bank BankKeeper) SetCoins(ctx sdk.Context, addr crypto.Address, amt std.Co
 // account balances.
 type ViewKeeperI interface {
 	GetCoins(ctx sdk.Context, addr crypto.Address) std.Coins
+	GetSpendableCoins(ctx sdk.Context, addr crypto.Address) std.Coins
 	HasCoins(ctx sdk.Context, addr crypto.Address, amt std.Coins) bool
 }
 
@@ -214,6 +216,16 @@ func (view ViewKeeper) GetCoins(ctx sdk.Context, addr crypto.Address) std.Coins
 	return acc.GetCoins()
 }
 
+// GetSpendableCoins returns the coins at the addr which are not locked by
+// vesting.
+func (view ViewKeeper) GetSpendableCoins(ctx sdk.Context, addr crypto.Address) std.Coins {
+	acc := view.acck.GetAccount(ctx, addr)
+	if acc == nil {
+		return std.NewCoins()
+	}
+	return std.SpendableCoins(acc, ctx.BlockTime())
+}
+
 // HasCoins returns whether or not an account has at least amt coins.
 func (view ViewKeeper) HasCoins(ctx sdk.Context, addr crypto.Address, amt std.Coins) bool {
 	return view.GetCoins(ctx, addr).IsAllGTE(amt)
@@ -42,6 +42,9 @@ type BaseApp struct {
 	beginBlocker BeginBlocker // logic to run before any txs
 	endBlocker   EndBlocker   // logic to run after all txs, and to determine valset changes
 
+	prepareProposer PrepareProposer // logic to select the txs of a proposed block
+	processProposer ProcessProposer // logic to accept or reject a proposed block
+
 	// --------------------
 	// Volatile state
 	// checkState is set on initialization and reset on Commit.
@@ -68,6 +71,9 @@ type BaseApp struct {
 	// minimum block time (in Unix seconds) at which to halt the chain and gracefully shutdown
 	haltTime uint64
 
+	// minimum number of recent blocks the node must keep; 0 keeps all blocks
+	minRetainBlocks uint64
+
 	// application's version string
 	appVersion string
 }
@@ -212,6 +218,20 @@ func (app *BaseApp) setHaltTime(haltTime uint64) {
 	app.haltTime = haltTime
 }
 
+func (app *BaseApp) setMinRetainBlocks(minRetainBlocks uint64) {
+	app.minRetainBlocks = minRetainBlocks
+}
+
+// GetBlockRetentionHeight returns the height below which the blocks may be
+// pruned by the node, after committing the block at commitHeight.  It returns
+// 0, keeping all blocks, if minRetainBlocks is not set.
+func (app *BaseApp) GetBlockRetentionHeight(commitHeight int64) int64 {
+	if app.minRetainBlocks == 0 || uint64(commitHeight) < app.minRetainBlocks {
+		return 0
+	}
+	return commitHeight - int64(app.minRetainBlocks) + 1
+}
+
 // Returns a read-only (cache) MultiStore.
 // This may be used by keepers for initialization upon restart.
 func (app *BaseApp) GetCacheMultiStore() store.MultiStore {
@@ -419,6 +439,10 @@ func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) (res abc
 			res.Height = req.Height
 			res.Value = []byte(app.appVersion)
 			return res
+		case "min_gas_prices":
+			res.Height = req.Height
+			res.Value = amino.MustMarshalJSON(app.minGasPrices)
+			return res
 		default:
 			res.Error = ABCIError(std.ErrUnknownRequest(fmt.Sprintf("Unknown query: %s", path)))
 			return
@@ -565,6 +589,8 @@ func (app *BaseApp) CheckTx(req abci.RequestCheckTx) (res abci.ResponseCheckTx)
 		res.ResponseBase = result.ResponseBase
 		res.GasWanted = result.GasWanted
 		res.GasUsed = result.GasUsed
+		res.TimeoutHeight = tx.TimeoutHeight
+		res.TimeoutTime = tx.TimeoutTime
 		return
 	}
 }
@@ -845,6 +871,41 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 	return
 }
 
+// PrepareProposal implements the ABCI interface. Without a PrepareProposer,
+// it proposes the requested txs.
+func (app *BaseApp) PrepareProposal(req abci.RequestPrepareProposal) (res abci.ResponsePrepareProposal) {
+	if app.prepareProposer == nil {
+		res.Txs = req.Txs
+		return
+	}
+
+	header := &bft.Header{
+		ChainID:         app.checkState.ctx.ChainID(),
+		Height:          req.Height,
+		Time:            req.Time,
+		ProposerAddress: req.ProposerAddress,
+	}
+	return app.prepareProposer(app.proposalContext(header), req)
+}
+
+// ProcessProposal implements the ABCI interface. Without a ProcessProposer,
+// it accepts every proposal.
+func (app *BaseApp) ProcessProposal(req abci.RequestProcessProposal) (res abci.ResponseProcessProposal) {
+	if app.processProposer == nil {
+		return
+	}
+
+	return app.processProposer(app.proposalContext(req.Header), req)
+}
+
+// proposalContext returns a context over a branch of the last committed
+// state, which is never written.
+func (app *BaseApp) proposalContext(header abci.Header) Context {
+	ms := app.cms.MultiCacheWrap()
+	return NewContext(RunTxModeCheck, ms, header, app.logger).
+		WithConsensusParams(app.consensusParams)
+}
+
 // Commit implements the ABCI interface. It will commit all state that exists in
 // the deliver state's multi-store and includes the resulting commit ID in the
 // returned abci.ResponseCommit. Commit will set the check state based on the
@@ -900,6 +961,7 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 
 	// return.
 	res.Data = commitID.Hash
+	res.RetainHeight = app.GetBlockRetentionHeight(header.GetHeight())
 	return
 }
 
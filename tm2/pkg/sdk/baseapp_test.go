@@ -252,6 +252,12 @@ func TestBaseAppOptionSeal(t *testing.T) {
 	require.Panics(t, func() {
 		app.SetEndBlocker(nil)
 	})
+	require.Panics(t, func() {
+		app.SetPrepareProposer(nil)
+	})
+	require.Panics(t, func() {
+		app.SetProcessProposer(nil)
+	})
 	require.Panics(t, func() {
 		app.SetAnteHandler(nil)
 	})
@@ -263,6 +269,13 @@ func TestSetMinGasPrices(t *testing.T) {
 	db := dbm.NewMemDB()
 	app := newBaseApp(t.Name(), db, SetMinGasPrices("5000stake/10gas"))
 	require.Equal(t, minGasPrices, app.minGasPrices)
+
+	// the minimum gas prices are queryable, for fee estimation.
+	res := app.Query(abci.RequestQuery{Path: ".app/min_gas_prices"})
+	require.True(t, res.IsOK())
+	var queried []GasPrice
+	require.NoError(t, amino.UnmarshalJSON(res.Value, &queried))
+	require.Equal(t, minGasPrices, queried)
 }
 
 func TestInitChainer(t *testing.T) {
@@ -332,6 +345,48 @@ func TestInitChainer(t *testing.T) {
 	require.Equal(t, value, res.Value)
 }
 
+func TestPrepareProcessProposal(t *testing.T) {
+	key, value := []byte("hello"), []byte("goodbye")
+	txs := [][]byte{[]byte("a"), []byte("b")}
+
+	// without proposers, the txs are proposed and accepted as is.
+	app := setupBaseApp(t)
+	app.InitChain(abci.RequestInitChain{ChainID: "test-chain"})
+	require.Equal(t, txs, app.PrepareProposal(abci.RequestPrepareProposal{Height: 1, Txs: txs}).Txs)
+	require.True(t, app.ProcessProposal(abci.RequestProcessProposal{Txs: txs}).IsOK())
+
+	proposerOpt := func(bapp *BaseApp) {
+		bapp.SetPrepareProposer(func(ctx Context, req abci.RequestPrepareProposal) abci.ResponsePrepareProposal {
+			require.Equal(t, "test-chain", ctx.ChainID())
+			require.Equal(t, req.Height, ctx.BlockHeight())
+			ctx.Store(mainKey).Set(key, value)
+			return abci.ResponsePrepareProposal{Txs: req.Txs[1:]}
+		})
+		bapp.SetProcessProposer(func(ctx Context, req abci.RequestProcessProposal) abci.ResponseProcessProposal {
+			ctx.Store(mainKey).Set(key, value)
+			if len(req.Txs) > 1 {
+				return abci.ResponseProcessProposal{
+					ResponseBase: abci.ResponseBase{Error: abci.StringError("too many txs")},
+				}
+			}
+			return abci.ResponseProcessProposal{}
+		})
+	}
+	app = setupBaseApp(t, proposerOpt)
+	app.InitChain(abci.RequestInitChain{ChainID: "test-chain"})
+	app.Commit()
+
+	res := app.PrepareProposal(abci.RequestPrepareProposal{Height: 2, Txs: txs})
+	require.Equal(t, txs[1:], res.Txs)
+	header := &bft.Header{ChainID: "test-chain", Height: 2}
+	require.True(t, app.ProcessProposal(abci.RequestProcessProposal{Header: header, Txs: res.Txs}).IsOK())
+	require.True(t, app.ProcessProposal(abci.RequestProcessProposal{Header: header, Txs: txs}).IsErr())
+
+	// the state changes of the proposers are discarded.
+	queryRes := app.Query(abci.RequestQuery{Path: ".store/main/key", Data: key})
+	require.Equal(t, 0, len(queryRes.Value))
+}
+
 type testTxData struct {
 	FailOnAnte bool
 	Counter    int64
@@ -1145,3 +1200,13 @@ func TestGetMaximumBlockGas(t *testing.T) {
 	app.setConsensusParams(&abci.ConsensusParams{Block: &abci.BlockParams{MaxGas: -5000000}})
 	require.Panics(t, func() { app.getMaximumBlockGas() })
 }
+
+func TestGetBlockRetentionHeight(t *testing.T) {
+	app := setupBaseApp(t)
+	require.Equal(t, int64(0), app.GetBlockRetentionHeight(100))
+
+	app.setMinRetainBlocks(10)
+	require.Equal(t, int64(0), app.GetBlockRetentionHeight(9))
+	require.Equal(t, int64(1), app.GetBlockRetentionHeight(10))
+	require.Equal(t, int64(91), app.GetBlockRetentionHeight(100))
+}
@@ -44,6 +44,13 @@ func SetHaltTime(haltTime uint64) func(*BaseApp) {
 	return func(bap *BaseApp) { bap.setHaltTime(haltTime) }
 }
 
+// SetMinRetainBlocks returns a BaseApp option function that sets the minimum
+// number of recent blocks the node must keep, requesting the pruning of the
+// older ones in ResponseCommit.
+func SetMinRetainBlocks(minRetainBlocks uint64) func(*BaseApp) {
+	return func(bap *BaseApp) { bap.setMinRetainBlocks(minRetainBlocks) }
+}
+
 func (app *BaseApp) SetName(name string) {
 	if app.sealed {
 		panic("SetName() on sealed BaseApp")
@@ -94,6 +101,20 @@ func (app *BaseApp) SetEndBlocker(endBlocker EndBlocker) {
 	app.endBlocker = endBlocker
 }
 
+func (app *BaseApp) SetPrepareProposer(prepareProposer PrepareProposer) {
+	if app.sealed {
+		panic("SetPrepareProposer() on sealed BaseApp")
+	}
+	app.prepareProposer = prepareProposer
+}
+
+func (app *BaseApp) SetProcessProposer(processProposer ProcessProposer) {
+	if app.sealed {
+		panic("SetProcessProposer() on sealed BaseApp")
+	}
+	app.processProposer = processProposer
+}
+
 func (app *BaseApp) SetAnteHandler(ah AnteHandler) {
 	if app.sealed {
 		panic("SetAnteHandler() on sealed BaseApp")
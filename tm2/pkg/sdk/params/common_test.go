@@ -0,0 +1,46 @@
+package params
+
+import (
+	bft "github.com/gnolang/gno/tm2/pkg/bft/types"
+	dbm "github.com/gnolang/gno/tm2/pkg/db"
+	"github.com/gnolang/gno/tm2/pkg/log"
+
+	"github.com/gnolang/gno/tm2/pkg/sdk"
+	"github.com/gnolang/gno/tm2/pkg/store"
+	"github.com/gnolang/gno/tm2/pkg/store/iavl"
+)
+
+type testEnv struct {
+	ctx  sdk.Context
+	prmk ParamsKeeper
+}
+
+func setupTestEnv() testEnv {
+	db := dbm.NewMemDB()
+
+	paramsCapKey := store.NewStoreKey("paramsCapKey")
+
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(paramsCapKey, iavl.StoreConstructor, db)
+	ms.LoadLatestVersion()
+
+	ctx := sdk.NewContext(sdk.RunTxModeDeliver, ms, &bft.Header{ChainID: "test-chain-id"}, log.NewNopLogger())
+	prmk := NewParamsKeeper(paramsCapKey)
+	prmk.Register("test", testParams{MaxFoo: 10, Enabled: true, Admin: "admin"})
+
+	return testEnv{ctx: ctx, prmk: prmk}
+}
+
+type testParams struct {
+	MaxFoo  int64  `json:"max_foo"`
+	Bar     uint32 `json:"bar"`
+	Enabled bool   `json:"enabled"`
+	Admin   string `json:"admin"`
+}
+
+func (p testParams) ValidateBasic() error {
+	if p.MaxFoo <= 0 {
+		return ErrInvalidParams("max_foo must be positive")
+	}
+	return nil
+}
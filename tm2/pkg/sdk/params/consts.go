@@ -0,0 +1,18 @@
+package params
+
+const (
+	// module name
+	ModuleName = "params"
+
+	// RouterKey is the message and query route for params
+	RouterKey = ModuleName
+
+	// ParamsStoreKeyPrefix prefix for module-params-by-name store
+	ParamsStoreKeyPrefix = "/pm/"
+)
+
+// ParamsStoreKey turns a module name to the key used to get its
+// params from the store.
+func ParamsStoreKey(module string) []byte {
+	return append([]byte(ParamsStoreKeyPrefix), module...)
+}
@@ -0,0 +1,33 @@
+package params
+
+import "github.com/gnolang/gno/tm2/pkg/errors"
+
+// for convenience:
+type abciError struct{}
+
+func (abciError) AssertABCIError() {}
+
+// declare all params errors.
+// NOTE: these are meant to be used in conjunction with pkgs/errors.
+type UnknownModuleError struct{ abciError }
+
+type (
+	UnknownParamError  struct{ abciError }
+	InvalidParamsError struct{ abciError }
+)
+
+func (e UnknownModuleError) Error() string { return "unknown params module" }
+func (e UnknownParamError) Error() string  { return "unknown param" }
+func (e InvalidParamsError) Error() string { return "invalid params" }
+
+func ErrUnknownModule(msg string) error {
+	return errors.Wrap(UnknownModuleError{}, msg)
+}
+
+func ErrUnknownParam(msg string) error {
+	return errors.Wrap(UnknownParamError{}, msg)
+}
+
+func ErrInvalidParams(msg string) error {
+	return errors.Wrap(InvalidParamsError{}, msg)
+}
@@ -0,0 +1,74 @@
+package params
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/gnolang/gno/tm2/pkg/amino"
+	abci "github.com/gnolang/gno/tm2/pkg/bft/abci/types"
+	"github.com/gnolang/gno/tm2/pkg/sdk"
+	"github.com/gnolang/gno/tm2/pkg/std"
+)
+
+type paramsHandler struct {
+	prmk ParamsKeeper
+}
+
+// NewHandler returns a handler for "params" type messages.
+// Params are only updated by other modules (e.g. from realms through
+// the VM), so no messages are supported.
+func NewHandler(prmk ParamsKeeper) paramsHandler {
+	return paramsHandler{
+		prmk: prmk,
+	}
+}
+
+func (ph paramsHandler) Process(ctx sdk.Context, msg std.Msg) sdk.Result {
+	errMsg := fmt.Sprintf("unrecognized params message type: %T", msg)
+	return abciResult(std.ErrUnknownRequest(errMsg))
+}
+
+//----------------------------------------
+// Query
+
+// Query returns the current params of the module named in the path,
+// e.g. "params/auth".
+func (ph paramsHandler) Query(ctx sdk.Context, req abci.RequestQuery) (res abci.ResponseQuery) {
+	module := secondPart(req.Path)
+	defaults, ok := ph.prmk.modules[module]
+	if !ok {
+		res = sdk.ABCIResponseQueryFromError(
+			std.ErrUnknownRequest("unknown params module " + module))
+		return
+	}
+
+	prv := reflect.New(reflect.TypeOf(defaults))
+	ph.prmk.GetParams(ctx, module, prv.Interface())
+	bz, err := amino.MarshalJSONIndent(prv.Elem().Interface(), "", "  ")
+	if err != nil {
+		res = sdk.ABCIResponseQueryFromError(
+			std.ErrInternal(fmt.Sprintf("could not marshal result to JSON: %s", err.Error())))
+		return
+	}
+
+	res.Data = bz
+	return
+}
+
+//----------------------------------------
+// misc
+
+func abciResult(err error) sdk.Result {
+	return sdk.ABCIResultFromError(err)
+}
+
+// returns the second component of a path.
+func secondPart(path string) string {
+	parts := strings.Split(path, "/")
+	if len(parts) < 2 {
+		return ""
+	} else {
+		return parts[1]
+	}
+}
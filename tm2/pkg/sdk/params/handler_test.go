@@ -0,0 +1,45 @@
+package params
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnolang/gno/tm2/pkg/amino"
+	abci "github.com/gnolang/gno/tm2/pkg/bft/abci/types"
+	tu "github.com/gnolang/gno/tm2/pkg/sdk/testutils"
+)
+
+func TestInvalidMsg(t *testing.T) {
+	env := setupTestEnv()
+	h := NewHandler(env.prmk)
+	res := h.Process(env.ctx, tu.NewTestMsg())
+	require.False(t, res.IsOK())
+	require.True(t, strings.Contains(res.Log, "unrecognized params message type"))
+}
+
+func TestQueryParams(t *testing.T) {
+	env := setupTestEnv()
+	h := NewHandler(env.prmk)
+	req := abci.RequestQuery{
+		Path: "params/test",
+		Data: []byte{},
+	}
+
+	res := h.Query(env.ctx, req)
+	require.Nil(t, res.Error)
+	var params testParams
+	require.NoError(t, amino.UnmarshalJSON(res.Data, &params))
+	require.Equal(t, testParams{MaxFoo: 10, Enabled: true, Admin: "admin"}, params)
+
+	require.NoError(t, env.prmk.SetParam(env.ctx, "test", "max_foo", "11"))
+	res = h.Query(env.ctx, req)
+	require.Nil(t, res.Error)
+	require.NoError(t, amino.UnmarshalJSON(res.Data, &params))
+	require.Equal(t, int64(11), params.MaxFoo)
+
+	req.Path = "params/notfound"
+	res = h.Query(env.ctx, req)
+	require.NotNil(t, res.Error)
+}
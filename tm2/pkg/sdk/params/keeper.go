@@ -0,0 +1,180 @@
+package params
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/gnolang/gno/tm2/pkg/amino"
+	"github.com/gnolang/gno/tm2/pkg/errors"
+	"github.com/gnolang/gno/tm2/pkg/log"
+	"github.com/gnolang/gno/tm2/pkg/sdk"
+	"github.com/gnolang/gno/tm2/pkg/store"
+)
+
+// ParamSet is implemented by the (struct) parameters of a module.
+// Fields are addressed by their json tag name in SetParam.
+type ParamSet interface {
+	ValidateBasic() error
+}
+
+// ParamsKeeperI is the interface other modules use to read
+// and update module parameters.
+type ParamsKeeperI interface {
+	GetParams(ctx sdk.Context, module string, ptr interface{}) bool
+	SetParams(ctx sdk.Context, module string, params ParamSet) error
+	SetParam(ctx sdk.Context, module, key, value string) error
+}
+
+var _ ParamsKeeperI = ParamsKeeper{}
+
+// Concrete implementation of ParamsKeeper.
+type ParamsKeeper struct {
+	// The (unexposed) key used to access the store from the Context.
+	key store.StoreKey
+
+	// The registered module params defaults, by module name.
+	modules map[string]ParamSet
+}
+
+// NewParamsKeeper returns a new ParamsKeeper that uses go-amino to
+// (binary) encode and decode module parameters.
+func NewParamsKeeper(key store.StoreKey) ParamsKeeper {
+	return ParamsKeeper{
+		key:     key,
+		modules: make(map[string]ParamSet),
+	}
+}
+
+// Logger returns a module-specific logger.
+func (pk ParamsKeeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", ModuleName)
+}
+
+// Register registers the params type of module, along with its default
+// values. The params of a module must be registered before they can be
+// set, or queried over ABCI.
+func (pk ParamsKeeper) Register(module string, defaults ParamSet) {
+	if _, exists := pk.modules[module]; exists {
+		panic(fmt.Sprintf("params of module %s already registered", module))
+	}
+	if rt := reflect.TypeOf(defaults); rt.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("params of module %s must be a struct, got %v", module, rt))
+	}
+	if err := defaults.ValidateBasic(); err != nil {
+		panic(fmt.Sprintf("invalid default params of module %s: %v", module, err))
+	}
+	pk.modules[module] = defaults
+}
+
+// GetParams decodes the stored params of module into ptr.
+// If none were stored yet, ptr is set to the registered defaults,
+// if any, and otherwise left untouched.
+// Returns true if ptr was set.
+func (pk ParamsKeeper) GetParams(ctx sdk.Context, module string, ptr interface{}) bool {
+	stor := ctx.Store(pk.key)
+	bz := stor.Get(ParamsStoreKey(module))
+	if bz == nil {
+		defaults, ok := pk.modules[module]
+		if !ok {
+			return false
+		}
+		reflect.ValueOf(ptr).Elem().Set(reflect.ValueOf(defaults))
+		return true
+	}
+	amino.MustUnmarshal(bz, ptr)
+	return true
+}
+
+// SetParams validates and stores the params of module.
+func (pk ParamsKeeper) SetParams(ctx sdk.Context, module string, params ParamSet) error {
+	defaults, ok := pk.modules[module]
+	if !ok {
+		return ErrUnknownModule(module)
+	}
+	if reflect.TypeOf(params) != reflect.TypeOf(defaults) {
+		return ErrInvalidParams(fmt.Sprintf(
+			"expected %T params for module %s, got %T", defaults, module, params))
+	}
+	if err := params.ValidateBasic(); err != nil {
+		return ErrInvalidParams(err.Error())
+	}
+	stor := ctx.Store(pk.key)
+	stor.Set(ParamsStoreKey(module), amino.MustMarshal(params))
+	return nil
+}
+
+// SetParam sets a single field of the params of module, identified by
+// its json tag name, to value parsed according to the field's kind.
+func (pk ParamsKeeper) SetParam(ctx sdk.Context, module, key, value string) error {
+	defaults, ok := pk.modules[module]
+	if !ok {
+		return ErrUnknownModule(module)
+	}
+	prv := reflect.New(reflect.TypeOf(defaults))
+	pk.GetParams(ctx, module, prv.Interface())
+	frv, ok := fieldByJSONName(prv.Elem(), key)
+	if !ok {
+		return ErrUnknownParam(fmt.Sprintf("%s.%s", module, key))
+	}
+	if err := setFieldValue(frv, value); err != nil {
+		return ErrInvalidParams(fmt.Sprintf(
+			"invalid value %q for %s.%s: %v", value, module, key, err))
+	}
+	params := prv.Elem().Interface().(ParamSet)
+	err := pk.SetParams(ctx, module, params)
+	if err == nil {
+		pk.Logger(ctx).Info("Param updated",
+			"module", module, "key", key, "value", value)
+	}
+	return err
+}
+
+// fieldByJSONName returns the exported field of struct value rv
+// whose json tag name is name.
+func fieldByJSONName(rv reflect.Value, name string) (reflect.Value, bool) {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if field.PkgPath != "" {
+			continue // unexported
+		}
+		tag := strings.Split(field.Tag.Get("json"), ",")[0]
+		if tag == "" {
+			tag = field.Name
+		}
+		if tag == name {
+			return rv.Field(i), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+func setFieldValue(frv reflect.Value, value string) error {
+	switch frv.Kind() {
+	case reflect.String:
+		frv.SetString(value)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		frv.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(value, 10, frv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		frv.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(value, 10, frv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		frv.SetUint(u)
+	default:
+		return errors.New("unsupported param kind %v", frv.Kind())
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package params
+
+import (
+	"testing"
+
+	"github.com/gnolang/gno/tm2/pkg/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsKeeper(t *testing.T) {
+	env := setupTestEnv()
+	ctx, prmk := env.ctx, env.prmk
+
+	// defaults before anything is stored.
+	var params testParams
+	require.True(t, prmk.GetParams(ctx, "test", &params))
+	assert.Equal(t, testParams{MaxFoo: 10, Enabled: true, Admin: "admin"}, params)
+
+	// unknown module leaves ptr untouched.
+	other := testParams{MaxFoo: 1}
+	assert.False(t, prmk.GetParams(ctx, "other", &other))
+	assert.Equal(t, testParams{MaxFoo: 1}, other)
+
+	// set whole params.
+	require.NoError(t, prmk.SetParams(ctx, "test", testParams{MaxFoo: 20}))
+	require.True(t, prmk.GetParams(ctx, "test", &params))
+	assert.Equal(t, testParams{MaxFoo: 20}, params)
+
+	// invalid params are rejected, and not stored.
+	err := prmk.SetParams(ctx, "test", testParams{MaxFoo: -1})
+	assert.True(t, errors.Cause(err) == InvalidParamsError{})
+	err = prmk.SetParams(ctx, "other", testParams{MaxFoo: 1})
+	assert.True(t, errors.Cause(err) == UnknownModuleError{})
+	prmk.GetParams(ctx, "test", &params)
+	assert.Equal(t, int64(20), params.MaxFoo)
+}
+
+func TestParamsKeeperSetParam(t *testing.T) {
+	env := setupTestEnv()
+	ctx, prmk := env.ctx, env.prmk
+
+	require.NoError(t, prmk.SetParam(ctx, "test", "max_foo", "42"))
+	require.NoError(t, prmk.SetParam(ctx, "test", "bar", "7"))
+	require.NoError(t, prmk.SetParam(ctx, "test", "enabled", "false"))
+	require.NoError(t, prmk.SetParam(ctx, "test", "admin", "g1admin"))
+
+	var params testParams
+	prmk.GetParams(ctx, "test", &params)
+	assert.Equal(t, testParams{MaxFoo: 42, Bar: 7, Enabled: false, Admin: "g1admin"}, params)
+
+	cases := []struct {
+		module, key, value string
+		errType            error
+	}{
+		{"other", "max_foo", "1", UnknownModuleError{}},
+		{"test", "MaxFoo", "1", UnknownParamError{}},
+		{"test", "max_foo", "abc", InvalidParamsError{}},
+		{"test", "max_foo", "0", InvalidParamsError{}},
+		{"test", "bar", "-1", InvalidParamsError{}},
+		{"test", "enabled", "maybe", InvalidParamsError{}},
+	}
+	for _, tc := range cases {
+		err := prmk.SetParam(ctx, tc.module, tc.key, tc.value)
+		assert.True(t, errors.Cause(err) == tc.errType, "%s.%s=%s: %v", tc.module, tc.key, tc.value, err)
+	}
+
+	// failed updates did not change anything.
+	prmk.GetParams(ctx, "test", &params)
+	assert.Equal(t, testParams{MaxFoo: 42, Bar: 7, Enabled: false, Admin: "g1admin"}, params)
+}
@@ -56,7 +56,7 @@ func KeyTestPubAddr() (crypto.PrivKey, crypto.PubKey, crypto.Address) {
 func NewTestTx(chainID string, msgs []std.Msg, privs []crypto.PrivKey, accNums []uint64, seqs []uint64, fee std.Fee) std.Tx {
 	sigs := make([]std.Signature, len(privs))
 	for i, priv := range privs {
-		signBytes := std.SignBytes(chainID, accNums[i], seqs[i], fee, msgs, "")
+		signBytes := std.SignBytes(chainID, accNums[i], seqs[i], fee, msgs, "", nil, 0, 0)
 
 		sig, err := priv.Sign(signBytes)
 		if err != nil {
@@ -73,7 +73,7 @@ func NewTestTx(chainID string, msgs []std.Msg, privs []crypto.PrivKey, accNums [
 func NewTestTxWithMemo(chainID string, msgs []std.Msg, privs []crypto.PrivKey, accNums []uint64, seqs []uint64, fee std.Fee, memo string) std.Tx {
 	sigs := make([]std.Signature, len(privs))
 	for i, priv := range privs {
-		signBytes := std.SignBytes(chainID, accNums[i], seqs[i], fee, msgs, memo)
+		signBytes := std.SignBytes(chainID, accNums[i], seqs[i], fee, msgs, memo, nil, 0, 0)
 
 		sig, err := priv.Sign(signBytes)
 		if err != nil {
@@ -87,6 +87,47 @@ func NewTestTxWithMemo(chainID string, msgs []std.Msg, privs []crypto.PrivKey, a
 	return tx
 }
 
+// NewTestTxWithFeePayer returns a tx whose fees are paid by feePayer, the
+// last of privs, who signs the tx along with the msg signers.
+func NewTestTxWithFeePayer(chainID string, msgs []std.Msg, privs []crypto.PrivKey, accNums []uint64, seqs []uint64, fee std.Fee, feePayer crypto.Address) std.Tx {
+	sigs := make([]std.Signature, len(privs))
+	for i, priv := range privs {
+		signBytes := std.SignBytes(chainID, accNums[i], seqs[i], fee, msgs, "", &feePayer, 0, 0)
+
+		sig, err := priv.Sign(signBytes)
+		if err != nil {
+			panic(err)
+		}
+
+		sigs[i] = std.Signature{PubKey: priv.PubKey(), Signature: sig}
+	}
+
+	tx := std.NewTx(msgs, fee, sigs, "")
+	tx.FeePayer = &feePayer
+	return tx
+}
+
+// NewTestTxWithTimeout returns a tx only valid up to timeoutHeight and
+// timeoutTime, in unix seconds.
+func NewTestTxWithTimeout(chainID string, msgs []std.Msg, privs []crypto.PrivKey, accNums []uint64, seqs []uint64, fee std.Fee, timeoutHeight, timeoutTime int64) std.Tx {
+	sigs := make([]std.Signature, len(privs))
+	for i, priv := range privs {
+		signBytes := std.SignBytes(chainID, accNums[i], seqs[i], fee, msgs, "", nil, timeoutHeight, timeoutTime)
+
+		sig, err := priv.Sign(signBytes)
+		if err != nil {
+			panic(err)
+		}
+
+		sigs[i] = std.Signature{PubKey: priv.PubKey(), Signature: sig}
+	}
+
+	tx := std.NewTx(msgs, fee, sigs, "")
+	tx.TimeoutHeight = timeoutHeight
+	tx.TimeoutTime = timeoutTime
+	return tx
+}
+
 func NewTestTxWithSignBytes(msgs []std.Msg, privs []crypto.PrivKey, accNums []uint64, seqs []uint64, fee std.Fee, signBytes []byte, memo string) std.Tx {
 	sigs := make([]std.Signature, len(privs))
 	for i, priv := range privs {
@@ -0,0 +1,40 @@
+package upgrade
+
+import (
+	bft "github.com/gnolang/gno/tm2/pkg/bft/types"
+	dbm "github.com/gnolang/gno/tm2/pkg/db"
+	"github.com/gnolang/gno/tm2/pkg/log"
+
+	"github.com/gnolang/gno/tm2/pkg/sdk"
+	"github.com/gnolang/gno/tm2/pkg/sdk/params"
+	"github.com/gnolang/gno/tm2/pkg/store"
+	"github.com/gnolang/gno/tm2/pkg/store/iavl"
+)
+
+type testEnv struct {
+	ctx  sdk.Context
+	prmk params.ParamsKeeper
+	upk  UpgradeKeeper
+}
+
+func setupTestEnv() testEnv {
+	db := dbm.NewMemDB()
+
+	upgradeCapKey := store.NewStoreKey("upgradeCapKey")
+
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(upgradeCapKey, iavl.StoreConstructor, db)
+	ms.LoadLatestVersion()
+
+	ctx := sdk.NewContext(sdk.RunTxModeDeliver, ms, &bft.Header{ChainID: "test-chain-id", Height: 10}, log.NewNopLogger())
+	prmk := params.NewParamsKeeper(upgradeCapKey)
+	prmk.Register(ModuleName, DefaultParams())
+	upk := NewUpgradeKeeper(upgradeCapKey, prmk)
+
+	return testEnv{ctx: ctx, prmk: prmk, upk: upk}
+}
+
+// withHeight returns ctx at block height.
+func withHeight(ctx sdk.Context, height int64) sdk.Context {
+	return ctx.WithBlockHeader(&bft.Header{ChainID: "test-chain-id", Height: height})
+}
@@ -0,0 +1,21 @@
+package upgrade
+
+const (
+	// module name
+	ModuleName = "upgrade"
+
+	// RouterKey is the message and query route for upgrade
+	RouterKey = ModuleName
+
+	// PlanStoreKey is the key of the scheduled upgrade plan
+	PlanStoreKey = "/up/plan"
+
+	// DoneStoreKeyPrefix prefix for applied-upgrade-height-by-name store
+	DoneStoreKeyPrefix = "/up/done/"
+)
+
+// DoneStoreKey turns an upgrade name to the key used to get the height
+// at which it was applied from the store.
+func DoneStoreKey(name string) []byte {
+	return append([]byte(DoneStoreKeyPrefix), name...)
+}
@@ -0,0 +1,26 @@
+package upgrade
+
+import "github.com/gnolang/gno/tm2/pkg/errors"
+
+// for convenience:
+type abciError struct{}
+
+func (abciError) AssertABCIError() {}
+
+// declare all upgrade errors.
+// NOTE: these are meant to be used in conjunction with pkgs/errors.
+type (
+	InvalidPlanError struct{ abciError }
+	NoPlanError      struct{ abciError }
+)
+
+func (e InvalidPlanError) Error() string { return "invalid upgrade plan" }
+func (e NoPlanError) Error() string      { return "no upgrade plan" }
+
+func ErrInvalidPlan(msg string) error {
+	return errors.Wrap(InvalidPlanError{}, msg)
+}
+
+func ErrNoPlan(msg string) error {
+	return errors.Wrap(NoPlanError{}, msg)
+}
@@ -0,0 +1,151 @@
+package upgrade
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gnolang/gno/tm2/pkg/amino"
+	abci "github.com/gnolang/gno/tm2/pkg/bft/abci/types"
+	"github.com/gnolang/gno/tm2/pkg/crypto"
+	"github.com/gnolang/gno/tm2/pkg/sdk"
+	"github.com/gnolang/gno/tm2/pkg/std"
+)
+
+type upgradeHandler struct {
+	upk UpgradeKeeper
+}
+
+// NewHandler returns a handler for "upgrade" type messages.
+func NewHandler(upk UpgradeKeeper) upgradeHandler {
+	return upgradeHandler{
+		upk: upk,
+	}
+}
+
+func (uh upgradeHandler) Process(ctx sdk.Context, msg std.Msg) sdk.Result {
+	switch msg := msg.(type) {
+	case MsgScheduleUpgrade:
+		return uh.handleMsgScheduleUpgrade(ctx, msg)
+
+	case MsgCancelUpgrade:
+		return uh.handleMsgCancelUpgrade(ctx, msg)
+
+	default:
+		errMsg := fmt.Sprintf("unrecognized upgrade message type: %T", msg)
+		return abciResult(std.ErrUnknownRequest(errMsg))
+	}
+}
+
+// Handle MsgScheduleUpgrade.
+func (uh upgradeHandler) handleMsgScheduleUpgrade(ctx sdk.Context, msg MsgScheduleUpgrade) sdk.Result {
+	if err := uh.checkAuthority(ctx, msg.Authority); err != nil {
+		return abciResult(err)
+	}
+	if err := uh.upk.ScheduleUpgrade(ctx, msg.Plan); err != nil {
+		return abciResult(err)
+	}
+	return sdk.Result{}
+}
+
+// Handle MsgCancelUpgrade.
+func (uh upgradeHandler) handleMsgCancelUpgrade(ctx sdk.Context, msg MsgCancelUpgrade) sdk.Result {
+	if err := uh.checkAuthority(ctx, msg.Authority); err != nil {
+		return abciResult(err)
+	}
+	if _, ok := uh.upk.GetUpgradePlan(ctx); !ok {
+		return abciResult(ErrNoPlan("no upgrade plan to cancel"))
+	}
+	uh.upk.ClearUpgradePlan(ctx)
+	return sdk.Result{}
+}
+
+// checkAuthority returns an error unless addr is the upgrade authority
+// set in the params.
+func (uh upgradeHandler) checkAuthority(ctx sdk.Context, addr crypto.Address) error {
+	authority := uh.upk.GetParams(ctx).Authority
+	if authority == "" || authority != addr.String() {
+		return std.ErrUnauthorized(fmt.Sprintf("%s is not the upgrade authority", addr))
+	}
+	return nil
+}
+
+//----------------------------------------
+// Query
+
+// query paths
+const (
+	QueryPlan = "plan"
+	QueryDone = "done"
+)
+
+func (uh upgradeHandler) Query(ctx sdk.Context, req abci.RequestQuery) (res abci.ResponseQuery) {
+	switch secondPart(req.Path) {
+	case QueryPlan:
+		return uh.queryPlan(ctx, req)
+	case QueryDone:
+		return uh.queryDone(ctx, req)
+	default:
+		res = sdk.ABCIResponseQueryFromError(
+			std.ErrUnknownRequest("unknown upgrade query endpoint"))
+		return
+	}
+}
+
+// queryPlan returns the scheduled upgrade plan, or null if none.
+func (uh upgradeHandler) queryPlan(ctx sdk.Context, req abci.RequestQuery) (res abci.ResponseQuery) {
+	var ptr *Plan
+	if plan, ok := uh.upk.GetUpgradePlan(ctx); ok {
+		ptr = &plan
+	}
+	bz, err := amino.MarshalJSONIndent(ptr, "", "  ")
+	if err != nil {
+		res = sdk.ABCIResponseQueryFromError(
+			std.ErrInternal(fmt.Sprintf("could not marshal result to JSON: %s", err.Error())))
+		return
+	}
+
+	res.Data = bz
+	return
+}
+
+// queryDone returns the height at which the upgrade named in the path
+// was applied, or 0, e.g. "upgrade/done/v2".
+func (uh upgradeHandler) queryDone(ctx sdk.Context, req abci.RequestQuery) (res abci.ResponseQuery) {
+	name := thirdPart(req.Path)
+	bz, err := amino.MarshalJSONIndent(uh.upk.GetDoneHeight(ctx, name), "", "  ")
+	if err != nil {
+		res = sdk.ABCIResponseQueryFromError(
+			std.ErrInternal(fmt.Sprintf("could not marshal result to JSON: %s", err.Error())))
+		return
+	}
+
+	res.Data = bz
+	return
+}
+
+//----------------------------------------
+// misc
+
+func abciResult(err error) sdk.Result {
+	return sdk.ABCIResultFromError(err)
+}
+
+// returns the second component of a path.
+func secondPart(path string) string {
+	parts := strings.Split(path, "/")
+	if len(parts) < 2 {
+		return ""
+	} else {
+		return parts[1]
+	}
+}
+
+// returns the third component of a path.
+func thirdPart(path string) string {
+	parts := strings.Split(path, "/")
+	if len(parts) < 3 {
+		return ""
+	} else {
+		return parts[2]
+	}
+}
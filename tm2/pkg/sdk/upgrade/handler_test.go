@@ -0,0 +1,84 @@
+package upgrade
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnolang/gno/tm2/pkg/amino"
+	abci "github.com/gnolang/gno/tm2/pkg/bft/abci/types"
+	"github.com/gnolang/gno/tm2/pkg/sdk"
+	tu "github.com/gnolang/gno/tm2/pkg/sdk/testutils"
+)
+
+func TestUpgradeMsgs(t *testing.T) {
+	env := setupTestEnv()
+	ctx := env.ctx
+	h := NewHandler(env.upk)
+	_, _, authority := tu.KeyTestPubAddr()
+	_, _, other := tu.KeyTestPubAddr()
+	plan := Plan{Name: "v2", Height: 20}
+
+	// nobody is authorized by default.
+	res := h.Process(ctx, NewMsgScheduleUpgrade(authority, plan))
+	require.False(t, res.IsOK())
+	assert.True(t, strings.Contains(res.Log, "is not the upgrade authority"))
+
+	require.NoError(t, env.prmk.SetParam(ctx, ModuleName, "authority", authority.String()))
+
+	res = h.Process(ctx, NewMsgScheduleUpgrade(other, plan))
+	require.False(t, res.IsOK())
+
+	res = h.Process(ctx, NewMsgScheduleUpgrade(authority, plan))
+	require.True(t, res.IsOK(), res.Log)
+	got, ok := env.upk.GetUpgradePlan(ctx)
+	require.True(t, ok)
+	assert.Equal(t, plan, got)
+
+	res = h.Process(ctx, NewMsgCancelUpgrade(other))
+	require.False(t, res.IsOK())
+
+	res = h.Process(ctx, NewMsgCancelUpgrade(authority))
+	require.True(t, res.IsOK(), res.Log)
+	_, ok = env.upk.GetUpgradePlan(ctx)
+	assert.False(t, ok)
+
+	// nothing left to cancel.
+	res = h.Process(ctx, NewMsgCancelUpgrade(authority))
+	require.False(t, res.IsOK())
+
+	res = h.Process(ctx, tu.NewTestMsg())
+	require.False(t, res.IsOK())
+	assert.True(t, strings.Contains(res.Log, "unrecognized upgrade message type"))
+}
+
+func TestQueryPlan(t *testing.T) {
+	env := setupTestEnv()
+	ctx := env.ctx
+	h := NewHandler(env.upk)
+
+	req := abci.RequestQuery{Path: fmt.Sprintf("upgrade/%s", QueryPlan)}
+	res := h.Query(ctx, req)
+	require.Nil(t, res.Error)
+	assert.Equal(t, "null", string(res.Data))
+
+	plan := Plan{Name: "v2", Height: 20}
+	require.NoError(t, env.upk.ScheduleUpgrade(ctx, plan))
+	res = h.Query(ctx, req)
+	require.Nil(t, res.Error)
+	var got Plan
+	require.NoError(t, amino.UnmarshalJSON(res.Data, &got))
+	assert.Equal(t, plan, got)
+
+	env.upk.SetUpgradeHandler("v2", func(_ sdk.Context, _ Plan) error { return nil })
+	env.upk.BeginBlock(withHeight(ctx, 20))
+	res = h.Query(ctx, abci.RequestQuery{Path: fmt.Sprintf("upgrade/%s/v2", QueryDone)})
+	require.Nil(t, res.Error)
+	assert.Equal(t, `"20"`, string(res.Data))
+
+	res = h.Query(ctx, abci.RequestQuery{Path: "upgrade/notfound"})
+	require.Error(t, res.Error)
+}
@@ -0,0 +1,147 @@
+package upgrade
+
+import (
+	"fmt"
+
+	"github.com/gnolang/gno/tm2/pkg/amino"
+	"github.com/gnolang/gno/tm2/pkg/log"
+	"github.com/gnolang/gno/tm2/pkg/sdk"
+	"github.com/gnolang/gno/tm2/pkg/sdk/params"
+	"github.com/gnolang/gno/tm2/pkg/store"
+)
+
+// UpgradeHandler migrates the state for an upgrade plan, e.g. through
+// ctx.MultiStore(), when the plan height is reached.
+type UpgradeHandler func(ctx sdk.Context, plan Plan) error
+
+// UpgradeKeeperI is the interface other modules use to schedule
+// upgrades.
+type UpgradeKeeperI interface {
+	GetUpgradePlan(ctx sdk.Context) (Plan, bool)
+	ScheduleUpgrade(ctx sdk.Context, plan Plan) error
+	ClearUpgradePlan(ctx sdk.Context)
+	GetDoneHeight(ctx sdk.Context, name string) int64
+}
+
+var _ UpgradeKeeperI = UpgradeKeeper{}
+
+// Concrete implementation of UpgradeKeeper.
+type UpgradeKeeper struct {
+	// The (unexposed) key used to access the store from the Context.
+	key store.StoreKey
+
+	// The params keeper, for the upgrade authority.
+	prmk params.ParamsKeeperI
+
+	// The handlers of the upgrades the binary can apply, by plan name.
+	handlers map[string]UpgradeHandler
+}
+
+// NewUpgradeKeeper returns a new UpgradeKeeper.
+func NewUpgradeKeeper(key store.StoreKey, prmk params.ParamsKeeperI) UpgradeKeeper {
+	return UpgradeKeeper{
+		key:      key,
+		prmk:     prmk,
+		handlers: make(map[string]UpgradeHandler),
+	}
+}
+
+// Logger returns a module-specific logger.
+func (uk UpgradeKeeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", ModuleName)
+}
+
+// SetUpgradeHandler registers the handler applying the upgrade plan
+// named name. It must be called before the plan height is reached,
+// typically when the app is constructed.
+func (uk UpgradeKeeper) SetUpgradeHandler(name string, handler UpgradeHandler) {
+	if _, exists := uk.handlers[name]; exists {
+		panic(fmt.Sprintf("upgrade handler %s already registered", name))
+	}
+	uk.handlers[name] = handler
+}
+
+// GetParams returns the current upgrade params, or the defaults if none
+// were set.
+func (uk UpgradeKeeper) GetParams(ctx sdk.Context) Params {
+	params := DefaultParams()
+	uk.prmk.GetParams(ctx, ModuleName, &params)
+	return params
+}
+
+// GetUpgradePlan returns the scheduled upgrade plan, if any.
+func (uk UpgradeKeeper) GetUpgradePlan(ctx sdk.Context) (plan Plan, ok bool) {
+	stor := ctx.Store(uk.key)
+	bz := stor.Get([]byte(PlanStoreKey))
+	if bz == nil {
+		return Plan{}, false
+	}
+	amino.MustUnmarshal(bz, &plan)
+	return plan, true
+}
+
+// ScheduleUpgrade schedules plan, replacing any scheduled plan.
+// The plan height must be in the future, and its name must not have been
+// applied already.
+func (uk UpgradeKeeper) ScheduleUpgrade(ctx sdk.Context, plan Plan) error {
+	if err := plan.ValidateBasic(); err != nil {
+		return err
+	}
+	if plan.Height <= ctx.BlockHeight() {
+		return ErrInvalidPlan(fmt.Sprintf(
+			"height %d must be after the current height %d", plan.Height, ctx.BlockHeight()))
+	}
+	if height := uk.GetDoneHeight(ctx, plan.Name); height > 0 {
+		return ErrInvalidPlan(fmt.Sprintf(
+			"upgrade %s already applied at height %d", plan.Name, height))
+	}
+	stor := ctx.Store(uk.key)
+	stor.Set([]byte(PlanStoreKey), amino.MustMarshal(plan))
+	return nil
+}
+
+// ClearUpgradePlan removes the scheduled upgrade plan, if any.
+func (uk UpgradeKeeper) ClearUpgradePlan(ctx sdk.Context) {
+	stor := ctx.Store(uk.key)
+	stor.Delete([]byte(PlanStoreKey))
+}
+
+// GetDoneHeight returns the height at which the upgrade named name was
+// applied, or 0 if it was not.
+func (uk UpgradeKeeper) GetDoneHeight(ctx sdk.Context, name string) int64 {
+	stor := ctx.Store(uk.key)
+	bz := stor.Get(DoneStoreKey(name))
+	if bz == nil {
+		return 0
+	}
+	var height int64
+	amino.MustUnmarshal(bz, &height)
+	return height
+}
+
+// BeginBlock applies the scheduled upgrade plan once its height is
+// reached, with its registered handler. Without a handler, the running
+// binary cannot apply the upgrade, so it panics to halt the chain before
+// the block is processed; the node must be restarted with a binary that
+// has the handler.
+func (uk UpgradeKeeper) BeginBlock(ctx sdk.Context) {
+	plan, ok := uk.GetUpgradePlan(ctx)
+	if !ok || ctx.BlockHeight() < plan.Height {
+		return
+	}
+
+	handler, ok := uk.handlers[plan.Name]
+	if !ok {
+		msg := fmt.Sprintf("UPGRADE %q NEEDED at height %d: %s", plan.Name, plan.Height, plan.Info)
+		uk.Logger(ctx).Error(msg)
+		panic(msg)
+	}
+
+	uk.Logger(ctx).Info("applying upgrade", "name", plan.Name, "height", ctx.BlockHeight())
+	if err := handler(ctx, plan); err != nil {
+		panic(fmt.Sprintf("upgrade %q failed: %v", plan.Name, err))
+	}
+	stor := ctx.Store(uk.key)
+	stor.Set(DoneStoreKey(plan.Name), amino.MustMarshal(ctx.BlockHeight()))
+	uk.ClearUpgradePlan(ctx)
+}
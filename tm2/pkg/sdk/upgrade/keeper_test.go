@@ -0,0 +1,93 @@
+package upgrade
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	tmerrors "github.com/gnolang/gno/tm2/pkg/errors"
+	"github.com/gnolang/gno/tm2/pkg/sdk"
+)
+
+func TestScheduleUpgrade(t *testing.T) {
+	env := setupTestEnv()
+	ctx, upk := env.ctx, env.upk
+
+	_, ok := upk.GetUpgradePlan(ctx)
+	assert.False(t, ok)
+
+	// invalid plans are rejected.
+	for _, plan := range []Plan{
+		{Name: "", Height: 20},
+		{Name: "v2", Height: 0},
+		{Name: "v2", Height: 10}, // not after the current height
+	} {
+		err := upk.ScheduleUpgrade(ctx, plan)
+		assert.True(t, tmerrors.Cause(err) == InvalidPlanError{}, "%v", plan)
+	}
+
+	plan := Plan{Name: "v2", Height: 20, Info: "v2.0.0"}
+	require.NoError(t, upk.ScheduleUpgrade(ctx, plan))
+	got, ok := upk.GetUpgradePlan(ctx)
+	require.True(t, ok)
+	assert.Equal(t, plan, got)
+
+	// a new plan replaces the scheduled one.
+	plan2 := Plan{Name: "v3", Height: 30}
+	require.NoError(t, upk.ScheduleUpgrade(ctx, plan2))
+	got, _ = upk.GetUpgradePlan(ctx)
+	assert.Equal(t, plan2, got)
+
+	upk.ClearUpgradePlan(ctx)
+	_, ok = upk.GetUpgradePlan(ctx)
+	assert.False(t, ok)
+}
+
+func TestBeginBlockHaltsWithoutHandler(t *testing.T) {
+	env := setupTestEnv()
+	ctx, upk := env.ctx, env.upk
+
+	require.NoError(t, upk.ScheduleUpgrade(ctx, Plan{Name: "v2", Height: 20}))
+
+	// nothing happens before the plan height.
+	assert.NotPanics(t, func() { upk.BeginBlock(withHeight(ctx, 19)) })
+
+	assert.PanicsWithValue(t, `UPGRADE "v2" NEEDED at height 20: `, func() {
+		upk.BeginBlock(withHeight(ctx, 20))
+	})
+}
+
+func TestBeginBlockAppliesUpgrade(t *testing.T) {
+	env := setupTestEnv()
+	ctx, upk := env.ctx, env.upk
+
+	var applied Plan
+	upk.SetUpgradeHandler("v2", func(ctx sdk.Context, plan Plan) error {
+		applied = plan
+		ctx.Store(upk.key).Set([]byte("migrated"), []byte("yes"))
+		return nil
+	})
+	upk.SetUpgradeHandler("v3", func(ctx sdk.Context, plan Plan) error {
+		return errors.New("migration failed")
+	})
+
+	plan := Plan{Name: "v2", Height: 20}
+	require.NoError(t, upk.ScheduleUpgrade(ctx, plan))
+	ctx = withHeight(ctx, 20)
+	upk.BeginBlock(ctx)
+	assert.Equal(t, plan, applied)
+	assert.Equal(t, []byte("yes"), ctx.Store(upk.key).Get([]byte("migrated")))
+
+	// the plan is done, and can't be scheduled again.
+	_, ok := upk.GetUpgradePlan(ctx)
+	assert.False(t, ok)
+	assert.Equal(t, int64(20), upk.GetDoneHeight(ctx, "v2"))
+	err := upk.ScheduleUpgrade(ctx, Plan{Name: "v2", Height: 30})
+	assert.True(t, tmerrors.Cause(err) == InvalidPlanError{})
+
+	// failing migrations halt the chain.
+	require.NoError(t, upk.ScheduleUpgrade(ctx, Plan{Name: "v3", Height: 30}))
+	assert.Panics(t, func() { upk.BeginBlock(withHeight(ctx, 30)) })
+}
@@ -0,0 +1,81 @@
+package upgrade
+
+import (
+	"github.com/gnolang/gno/tm2/pkg/amino"
+	"github.com/gnolang/gno/tm2/pkg/crypto"
+	"github.com/gnolang/gno/tm2/pkg/std"
+)
+
+// MsgScheduleUpgrade - schedule an upgrade plan, replacing any scheduled
+// plan.
+type MsgScheduleUpgrade struct {
+	Authority crypto.Address `json:"authority" yaml:"authority"`
+	Plan      Plan           `json:"plan" yaml:"plan"`
+}
+
+var _ std.Msg = MsgScheduleUpgrade{}
+
+// NewMsgScheduleUpgrade - construct an upgrade scheduling msg.
+func NewMsgScheduleUpgrade(authority crypto.Address, plan Plan) MsgScheduleUpgrade {
+	return MsgScheduleUpgrade{Authority: authority, Plan: plan}
+}
+
+// Route Implements Msg.
+func (msg MsgScheduleUpgrade) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgScheduleUpgrade) Type() string { return "schedule_upgrade" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgScheduleUpgrade) ValidateBasic() error {
+	if msg.Authority.IsZero() {
+		return std.ErrInvalidAddress("missing authority address")
+	}
+	return msg.Plan.ValidateBasic()
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgScheduleUpgrade) GetSignBytes() []byte {
+	return std.MustSortJSON(amino.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgScheduleUpgrade) GetSigners() []crypto.Address {
+	return []crypto.Address{msg.Authority}
+}
+
+// MsgCancelUpgrade - cancel the scheduled upgrade plan.
+type MsgCancelUpgrade struct {
+	Authority crypto.Address `json:"authority" yaml:"authority"`
+}
+
+var _ std.Msg = MsgCancelUpgrade{}
+
+// NewMsgCancelUpgrade - construct an upgrade cancellation msg.
+func NewMsgCancelUpgrade(authority crypto.Address) MsgCancelUpgrade {
+	return MsgCancelUpgrade{Authority: authority}
+}
+
+// Route Implements Msg.
+func (msg MsgCancelUpgrade) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgCancelUpgrade) Type() string { return "cancel_upgrade" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgCancelUpgrade) ValidateBasic() error {
+	if msg.Authority.IsZero() {
+		return std.ErrInvalidAddress("missing authority address")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgCancelUpgrade) GetSignBytes() []byte {
+	return std.MustSortJSON(amino.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCancelUpgrade) GetSigners() []crypto.Address {
+	return []crypto.Address{msg.Authority}
+}
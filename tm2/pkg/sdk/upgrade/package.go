@@ -0,0 +1,18 @@
+package upgrade
+
+import (
+	"github.com/gnolang/gno/tm2/pkg/amino"
+)
+
+var Package = amino.RegisterPackage(amino.NewPackage(
+	"github.com/gnolang/gno/tm2/pkg/sdk/upgrade",
+	"upgrade",
+	amino.GetCallersDirname(),
+).WithDependencies().WithTypes(
+	Plan{}, "Plan",
+	Params{}, "Params",
+	MsgScheduleUpgrade{}, "MsgScheduleUpgrade",
+	MsgCancelUpgrade{}, "MsgCancelUpgrade",
+	InvalidPlanError{}, "InvalidPlanError",
+	NoPlanError{}, "NoPlanError",
+))
@@ -0,0 +1,56 @@
+package upgrade
+
+import (
+	"fmt"
+
+	"github.com/gnolang/gno/tm2/pkg/crypto"
+)
+
+// Plan is a software upgrade scheduled at a block height. At that height,
+// the chain halts unless the running binary has a handler for the plan
+// name, which then migrates the state before the block is processed.
+type Plan struct {
+	Name   string `json:"name" yaml:"name"`
+	Height int64  `json:"height" yaml:"height"`
+	Info   string `json:"info,omitempty" yaml:"info"` // e.g. the binary to upgrade to
+}
+
+// ValidateBasic performs basic validation on the plan.
+func (p Plan) ValidateBasic() error {
+	if p.Name == "" {
+		return ErrInvalidPlan("name cannot be empty")
+	}
+	if p.Height <= 0 {
+		return ErrInvalidPlan(fmt.Sprintf("height must be positive, got %d", p.Height))
+	}
+	return nil
+}
+
+// String implements the stringer interface.
+func (p Plan) String() string {
+	return fmt.Sprintf("Upgrade Plan: %s at height %d (%s)", p.Name, p.Height, p.Info)
+}
+
+// Params defines the parameters for the upgrade module.
+type Params struct {
+	// Authority is the address allowed to schedule and cancel upgrades
+	// with signed messages. No message can schedule upgrades if empty;
+	// on gno.land, the params realm can, through std.ScheduleUpgrade.
+	Authority string `json:"authority" yaml:"authority"`
+}
+
+// ValidateBasic performs basic validation on upgrade parameters.
+func (p Params) ValidateBasic() error {
+	if p.Authority == "" {
+		return nil
+	}
+	if _, err := crypto.AddressFromBech32(p.Authority); err != nil {
+		return fmt.Errorf("invalid authority %q: %w", p.Authority, err)
+	}
+	return nil
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return Params{}
+}
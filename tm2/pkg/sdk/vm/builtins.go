@@ -1,6 +1,7 @@
 package vm
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/gnolang/gno/tm2/pkg/crypto"
 	osm "github.com/gnolang/gno/tm2/pkg/os"
 	"github.com/gnolang/gno/tm2/pkg/sdk"
+	"github.com/gnolang/gno/tm2/pkg/sdk/upgrade"
 	"github.com/gnolang/gno/tm2/pkg/std"
 )
 
@@ -125,3 +127,80 @@ func (bnk *SDKBanker) RemoveCoin(b32addr crypto.Bech32Address, denom string, amo
 		panic(err)
 	}
 }
+
+// ----------------------------------------
+// SDKParams
+
+type SDKParams struct {
+	vmk *VMKeeper
+	ctx sdk.Context
+}
+
+func NewSDKParams(vmk *VMKeeper, ctx sdk.Context) *SDKParams {
+	return &SDKParams{
+		vmk: vmk,
+		ctx: ctx,
+	}
+}
+
+// SetParam only allows the realm configured by the vm "params_realm"
+// param to update module params, except "params_realm" itself, which
+// can only be set at genesis.
+func (prm *SDKParams) SetParam(realmPath, module, key, value string) {
+	params := prm.vmk.getParams(prm.ctx)
+	if params.ParamsRealm == "" || realmPath != params.ParamsRealm {
+		panic(fmt.Sprintf("realm %q is not allowed to set params", realmPath))
+	}
+	if module == ModuleName && key == "params_realm" {
+		panic(fmt.Sprintf("param %s.%s can't be set by a realm", module, key))
+	}
+	err := prm.vmk.prmk.SetParam(prm.ctx, module, key, value)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// ----------------------------------------
+// SDKUpgrader
+
+type SDKUpgrader struct {
+	vmk *VMKeeper
+	ctx sdk.Context
+}
+
+func NewSDKUpgrader(vmk *VMKeeper, ctx sdk.Context) *SDKUpgrader {
+	return &SDKUpgrader{
+		vmk: vmk,
+		ctx: ctx,
+	}
+}
+
+// ScheduleUpgrade only allows the realm configured by the vm
+// "params_realm" param to schedule upgrades, like SetParam.
+func (upg *SDKUpgrader) ScheduleUpgrade(realmPath, name string, height int64, info string) {
+	upg.assertAuthorized(realmPath)
+	plan := upgrade.Plan{Name: name, Height: height, Info: info}
+	if err := upg.vmk.upk.ScheduleUpgrade(upg.ctx, plan); err != nil {
+		panic(err)
+	}
+}
+
+// CancelUpgrade only allows the realm configured by the vm
+// "params_realm" param to cancel the scheduled upgrade.
+func (upg *SDKUpgrader) CancelUpgrade(realmPath string) {
+	upg.assertAuthorized(realmPath)
+	if _, ok := upg.vmk.upk.GetUpgradePlan(upg.ctx); !ok {
+		panic(upgrade.ErrNoPlan("no upgrade plan to cancel"))
+	}
+	upg.vmk.upk.ClearUpgradePlan(upg.ctx)
+}
+
+func (upg *SDKUpgrader) assertAuthorized(realmPath string) {
+	if upg.vmk.upk == nil {
+		panic("upgrades are not available")
+	}
+	params := upg.vmk.getParams(upg.ctx)
+	if params.ParamsRealm == "" || realmPath != params.ParamsRealm {
+		panic(fmt.Sprintf("realm %q is not allowed to schedule upgrades", realmPath))
+	}
+}
@@ -12,6 +12,8 @@ import (
 	"github.com/gnolang/gno/tm2/pkg/sdk"
 	authm "github.com/gnolang/gno/tm2/pkg/sdk/auth"
 	bankm "github.com/gnolang/gno/tm2/pkg/sdk/bank"
+	paramsm "github.com/gnolang/gno/tm2/pkg/sdk/params"
+	upgradem "github.com/gnolang/gno/tm2/pkg/sdk/upgrade"
 	"github.com/gnolang/gno/tm2/pkg/std"
 	"github.com/gnolang/gno/tm2/pkg/store"
 	"github.com/gnolang/gno/tm2/pkg/store/dbadapter"
@@ -23,6 +25,8 @@ type testEnv struct {
 	vmk  *VMKeeper
 	bank bankm.BankKeeper
 	acck authm.AccountKeeper
+	prmk paramsm.ParamsKeeper
+	upk  upgradem.UpgradeKeeper
 }
 
 func setupTestEnv() testEnv {
@@ -40,9 +44,15 @@ func setupTestEnv() testEnv {
 	acck := authm.NewAccountKeeper(iavlCapKey, std.ProtoBaseAccount)
 	bank := bankm.NewBankKeeper(acck)
 	stdlibsDir := filepath.Join("..", "..", "..", "..", "gnovm", "stdlibs")
-	vmk := NewVMKeeper(baseCapKey, iavlCapKey, acck, bank, stdlibsDir)
+	prmk := paramsm.NewParamsKeeper(iavlCapKey)
+	prmk.Register(ModuleName, DefaultParams())
+	prmk.Register(authm.ModuleName, authm.DefaultParams())
+	prmk.Register(upgradem.ModuleName, upgradem.DefaultParams())
+	vmk := NewVMKeeper(baseCapKey, iavlCapKey, acck, bank, prmk, stdlibsDir)
+	upk := upgradem.NewUpgradeKeeper(iavlCapKey, prmk)
+	vmk.SetUpgradeKeeper(upk)
 
 	vmk.Initialize(ms.MultiCacheWrap())
 
-	return testEnv{ctx: ctx, vmk: vmk, bank: bank, acck: acck}
+	return testEnv{ctx: ctx, vmk: vmk, bank: bank, acck: acck, prmk: prmk, upk: upk}
 }
@@ -119,9 +119,24 @@ func (vh vmHandler) queryPackage(ctx sdk.Context, req abci.RequestQuery) (res ab
 	return
 }
 
-// queryPackage fetch items from the store.
+// queryStore fetches raw values from the gno store backends.
+// The first line of the query input data is the store name
+// ("base" or "iavl"), the rest is the key. The base store can
+// only be queried at the latest height.
 func (vh vmHandler) queryStore(ctx sdk.Context, req abci.RequestQuery) (res abci.ResponseQuery) {
-	res.Data = []byte(fmt.Sprintf("TODO: fetch from store"))
+	reqData := string(req.Data)
+	reqParts := strings.SplitN(reqData, "\n", 2)
+	if len(reqParts) != 2 {
+		panic("expected two lines in query input data")
+	}
+	storeName := reqParts[0]
+	key := reqParts[1]
+	result, err := vh.vm.QueryStore(ctx, storeName, req.Height, []byte(key))
+	if err != nil {
+		res = sdk.ABCIResponseQueryFromError(err)
+		return
+	}
+	res.Data = result
 	return
 }
 
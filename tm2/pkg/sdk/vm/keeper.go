@@ -4,7 +4,10 @@ package vm
 
 import (
 	"fmt"
+	"go/parser"
+	"go/token"
 	"os"
+	"strconv"
 	"strings"
 
 	gno "github.com/gnolang/gno/gnovm/pkg/gnolang"
@@ -13,15 +16,12 @@ import (
 	"github.com/gnolang/gno/tm2/pkg/sdk"
 	"github.com/gnolang/gno/tm2/pkg/sdk/auth"
 	"github.com/gnolang/gno/tm2/pkg/sdk/bank"
+	"github.com/gnolang/gno/tm2/pkg/sdk/params"
+	"github.com/gnolang/gno/tm2/pkg/sdk/upgrade"
 	"github.com/gnolang/gno/tm2/pkg/std"
 	"github.com/gnolang/gno/tm2/pkg/store"
 )
 
-const (
-	maxAllocTx    = 500 * 1000 * 1000
-	maxAllocQuery = 1500 * 1000 * 1000 // higher limit for queries
-)
-
 // vm.VMKeeperI defines a module interface that supports Gno
 // smart contracts programming (scripting).
 type VMKeeperI interface {
@@ -37,6 +37,8 @@ type VMKeeper struct {
 	iavlKey    store.StoreKey
 	acck       auth.AccountKeeper
 	bank       bank.BankKeeper
+	prmk       params.ParamsKeeperI
+	upk        upgrade.UpgradeKeeperI // or nil.
 	stdlibsDir string
 
 	// cached, the DeliverTx persistent state.
@@ -44,26 +46,38 @@ type VMKeeper struct {
 }
 
 // NewVMKeeper returns a new VMKeeper.
-func NewVMKeeper(baseKey store.StoreKey, iavlKey store.StoreKey, acck auth.AccountKeeper, bank bank.BankKeeper, stdlibsDir string) *VMKeeper {
+func NewVMKeeper(baseKey store.StoreKey, iavlKey store.StoreKey, acck auth.AccountKeeper, bank bank.BankKeeper, prmk params.ParamsKeeperI, stdlibsDir string) *VMKeeper {
 	vmk := &VMKeeper{
 		baseKey:    baseKey,
 		iavlKey:    iavlKey,
 		acck:       acck,
 		bank:       bank,
+		prmk:       prmk,
 		stdlibsDir: stdlibsDir,
 	}
 	return vmk
 }
 
+// SetUpgradeKeeper lets the params realm schedule and cancel upgrades
+// with upk, through std.ScheduleUpgrade and std.CancelUpgrade.
+func (vm *VMKeeper) SetUpgradeKeeper(upk upgrade.UpgradeKeeperI) {
+	vm.upk = upk
+}
+
 func (vm *VMKeeper) Initialize(ms store.MultiStore) {
 	if vm.gnoStore != nil {
 		panic("should not happen")
 	}
-	alloc := gno.NewAllocator(maxAllocTx)
+	// the limit is updated from params for every transaction.
+	alloc := gno.NewAllocator(DefaultMaxAllocTx)
 	baseSDKStore := ms.GetStore(vm.baseKey)
 	iavlSDKStore := ms.GetStore(vm.iavlKey)
 	vm.gnoStore = gno.NewStore(alloc, baseSDKStore, iavlSDKStore)
 	vm.initBuiltinPackagesAndTypes(vm.gnoStore)
+	// realms added before the realm address index existed are
+	// indexed on boot, so their onReceive hooks are found.
+	vm.gnoStore.IndexRealmPaths()
+	ms.MultiWrite()
 	if vm.gnoStore.NumMemPackages() > 0 {
 		// for now, all mem packages must be re-run after reboot.
 		// TODO remove this, and generally solve for in-mem garbage collection
@@ -81,6 +95,26 @@ func (vm *VMKeeper) Initialize(ms store.MultiStore) {
 	}
 }
 
+// PreprocessAllPackages preprocesses all the packages of the store again,
+// saving their types and block nodes with ctx. Upgrade handlers call it
+// when a new gnolang version preprocesses packages incompatibly.
+func (vm *VMKeeper) PreprocessAllPackages(ctx sdk.Context) {
+	store := vm.getGnoStore(ctx)
+	// drop the types and nodes preprocessed by Initialize, or they
+	// would not be saved again.
+	store.ClearCache()
+	m2 := gno.NewMachineWithOptions(
+		gno.MachineOptions{
+			PkgPath: "",
+			Output:  os.Stdout, // XXX
+			Store:   store,
+		})
+	defer m2.Release()
+	gno.DisableDebug()
+	m2.PreprocessAllFilesAndSaveBlockNodes()
+	gno.EnableDebug()
+}
+
 func (vm *VMKeeper) getGnoStore(ctx sdk.Context) gno.Store {
 	// construct main gnoStore if nil.
 	if vm.gnoStore == nil {
@@ -123,7 +157,9 @@ func (vm *VMKeeper) AddPackage(ctx sdk.Context, msg MsgAddPackage) error {
 	pkgPath := msg.Package.Path
 	memPkg := msg.Package
 	deposit := msg.Deposit
+	params := vm.getParams(ctx)
 	store := vm.getGnoStore(ctx)
+	store.GetAllocator().SetMaxBytes(params.MaxAllocTx)
 
 	// Validate arguments.
 	if creator.IsZero() {
@@ -136,6 +172,9 @@ func (vm *VMKeeper) AddPackage(ctx sdk.Context, msg MsgAddPackage) error {
 	if err := msg.Package.Validate(); err != nil {
 		return ErrInvalidPkgPath(err.Error())
 	}
+	if err := checkImports(memPkg); err != nil {
+		return ErrInvalidPkgPath(err.Error())
+	}
 	if pv := store.GetPackage(pkgPath, false); pv != nil {
 		// TODO: return error instead of panicking?
 		panic("package already exists: " + pkgPath)
@@ -165,6 +204,8 @@ func (vm *VMKeeper) AddPackage(ctx sdk.Context, msg MsgAddPackage) error {
 		OrigSendSpent: new(std.Coins),
 		OrigPkgAddr:   pkgAddr.Bech32(),
 		Banker:        NewSDKBanker(vm, ctx),
+		Params:        NewSDKParams(vm, ctx),
+		Upgrader:      NewSDKUpgrader(vm, ctx),
 	}
 	// Parse and run the files, construct *PV.
 	m2 := gno.NewMachineWithOptions(
@@ -174,7 +215,7 @@ func (vm *VMKeeper) AddPackage(ctx sdk.Context, msg MsgAddPackage) error {
 			Store:     store,
 			Alloc:     store.GetAllocator(),
 			Context:   msgCtx,
-			MaxCycles: 10 * 1000 * 1000, // 10M cycles // XXX
+			MaxCycles: params.MaxCycles,
 		})
 	defer m2.Release()
 	m2.RunMemPackage(memPkg, true)
@@ -182,11 +223,42 @@ func (vm *VMKeeper) AddPackage(ctx sdk.Context, msg MsgAddPackage) error {
 	return nil
 }
 
+// checkImports returns an error if a file of memPkg imports an
+// internal standard library, which is only for the standard
+// libraries themselves (e.g. internal/std gives access to realm
+// hooks). Test files are skipped as they are never run on chain.
+func checkImports(memPkg *std.MemPackage) error {
+	fset := token.NewFileSet()
+	for _, mfile := range memPkg.Files {
+		if !strings.HasSuffix(mfile.Name, ".gno") ||
+			strings.HasSuffix(mfile.Name, "_test.gno") ||
+			strings.HasSuffix(mfile.Name, "_filetest.gno") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, mfile.Name, mfile.Body, parser.ImportsOnly)
+		if err != nil {
+			return err
+		}
+		for _, imp := range f.Imports {
+			path, err := strconv.Unquote(imp.Path.Value)
+			if err != nil {
+				return err
+			}
+			if path == "internal" || strings.HasPrefix(path, "internal/") {
+				return fmt.Errorf("%s: cannot import internal package %q", mfile.Name, path)
+			}
+		}
+	}
+	return nil
+}
+
 // Calls calls a public Gno function (for delivertx).
 func (vm *VMKeeper) Call(ctx sdk.Context, msg MsgCall) (res string, err error) {
 	pkgPath := msg.PkgPath // to import
 	fnc := msg.Func
+	params := vm.getParams(ctx)
 	store := vm.getGnoStore(ctx)
+	store.GetAllocator().SetMaxBytes(params.MaxAllocTx)
 	// Get the package and function type.
 	pv := store.GetPackage(pkgPath, false)
 	pl := gno.PackageNodeLocation(pkgPath)
@@ -239,6 +311,8 @@ func (vm *VMKeeper) Call(ctx sdk.Context, msg MsgCall) (res string, err error) {
 		OrigSendSpent: new(std.Coins),
 		OrigPkgAddr:   pkgAddr.Bech32(),
 		Banker:        NewSDKBanker(vm, ctx),
+		Params:        NewSDKParams(vm, ctx),
+		Upgrader:      NewSDKUpgrader(vm, ctx),
 	}
 	// Construct machine and evaluate.
 	m := gno.NewMachineWithOptions(
@@ -248,7 +322,7 @@ func (vm *VMKeeper) Call(ctx sdk.Context, msg MsgCall) (res string, err error) {
 			Store:     store,
 			Context:   msgCtx,
 			Alloc:     store.GetAllocator(),
-			MaxCycles: 10 * 1000 * 1000, // 10M cycles // XXX
+			MaxCycles: params.MaxCycles,
 		})
 	m.SetActivePackage(mpv)
 	defer func() {
@@ -335,7 +409,8 @@ func (vm *VMKeeper) QueryFuncs(ctx sdk.Context, pkgPath string) (fsigs FunctionS
 // TODO: modify query protocol to allow MsgEval.
 // TODO: then, rename to "Eval".
 func (vm *VMKeeper) QueryEval(ctx sdk.Context, pkgPath string, expr string) (res string, err error) {
-	alloc := gno.NewAllocator(maxAllocQuery)
+	params := vm.getParams(ctx)
+	alloc := gno.NewAllocator(params.MaxAllocQuery)
 	store := vm.getGnoStore(ctx)
 	pkgAddr := gno.DerivePkgAddr(pkgPath)
 	// Get Package.
@@ -360,7 +435,9 @@ func (vm *VMKeeper) QueryEval(ctx sdk.Context, pkgPath string, expr string) (res
 		// OrigSend:      send,
 		// OrigSendSpent: nil,
 		OrigPkgAddr: pkgAddr.Bech32(),
-		Banker:      NewSDKBanker(vm, ctx), // safe as long as ctx is a fork to be discarded.
+		Banker:      NewSDKBanker(vm, ctx),   // safe as long as ctx is a fork to be discarded.
+		Params:      NewSDKParams(vm, ctx),   // safe as long as ctx is a fork to be discarded.
+		Upgrader:    NewSDKUpgrader(vm, ctx), // safe as long as ctx is a fork to be discarded.
 	}
 	m := gno.NewMachineWithOptions(
 		gno.MachineOptions{
@@ -369,7 +446,7 @@ func (vm *VMKeeper) QueryEval(ctx sdk.Context, pkgPath string, expr string) (res
 			Store:     store,
 			Context:   msgCtx,
 			Alloc:     alloc,
-			MaxCycles: 10 * 1000 * 1000, // 10M cycles // XXX
+			MaxCycles: params.MaxCycles,
 		})
 	defer func() {
 		if r := recover(); r != nil {
@@ -395,7 +472,8 @@ func (vm *VMKeeper) QueryEval(ctx sdk.Context, pkgPath string, expr string) (res
 // TODO: modify query protocol to allow MsgEval.
 // TODO: then, rename to "EvalString".
 func (vm *VMKeeper) QueryEvalString(ctx sdk.Context, pkgPath string, expr string) (res string, err error) {
-	alloc := gno.NewAllocator(maxAllocQuery)
+	params := vm.getParams(ctx)
+	alloc := gno.NewAllocator(params.MaxAllocQuery)
 	store := vm.getGnoStore(ctx)
 	pkgAddr := gno.DerivePkgAddr(pkgPath)
 	// Get Package.
@@ -420,7 +498,9 @@ func (vm *VMKeeper) QueryEvalString(ctx sdk.Context, pkgPath string, expr string
 		// OrigSend:      jsend,
 		// OrigSendSpent: nil,
 		OrigPkgAddr: pkgAddr.Bech32(),
-		Banker:      NewSDKBanker(vm, ctx), // safe as long as ctx is a fork to be discarded.
+		Banker:      NewSDKBanker(vm, ctx),   // safe as long as ctx is a fork to be discarded.
+		Params:      NewSDKParams(vm, ctx),   // safe as long as ctx is a fork to be discarded.
+		Upgrader:    NewSDKUpgrader(vm, ctx), // safe as long as ctx is a fork to be discarded.
 	}
 	m := gno.NewMachineWithOptions(
 		gno.MachineOptions{
@@ -429,7 +509,7 @@ func (vm *VMKeeper) QueryEvalString(ctx sdk.Context, pkgPath string, expr string
 			Store:     store,
 			Context:   msgCtx,
 			Alloc:     alloc,
-			MaxCycles: 10 * 1000 * 1000, // 10M cycles // XXX
+			MaxCycles: params.MaxCycles,
 		})
 	defer func() {
 		if r := recover(); r != nil {
@@ -460,6 +540,9 @@ func (vm *VMKeeper) QueryFile(ctx sdk.Context, filepath string) (res string, err
 		return memFile.Body, nil
 	} else {
 		memPkg := store.GetMemPackage(dirpath)
+		if memPkg == nil {
+			return "", fmt.Errorf("package %q is not available", dirpath) // TODO: XSS protection
+		}
 		for i, memfile := range memPkg.Files {
 			if i > 0 {
 				res += "\n"
@@ -469,3 +552,24 @@ func (vm *VMKeeper) QueryFile(ctx sdk.Context, filepath string) (res string, err
 		return res, nil
 	}
 }
+
+// QueryStore returns the raw value of key in the "base" or "iavl"
+// store backing the gno store at height, or nil if it doesn't exist.
+// This allows clients to read through the gno store remotely.
+// The base store isn't versioned, so it can only be read at the
+// latest height (or 0).
+func (vm *VMKeeper) QueryStore(ctx sdk.Context, storeName string, height int64, key []byte) ([]byte, error) {
+	switch storeName {
+	case "base":
+		if height != 0 && height != ctx.BlockHeight() {
+			return nil, fmt.Errorf(
+				"base store can only be queried at the latest height %d, not %d",
+				ctx.BlockHeight(), height)
+		}
+		return ctx.Store(vm.baseKey).Get(key), nil
+	case "iavl":
+		return ctx.Store(vm.iavlKey).Get(key), nil
+	default:
+		return nil, fmt.Errorf("unknown store %q", storeName)
+	}
+}
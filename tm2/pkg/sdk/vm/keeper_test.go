@@ -9,7 +9,12 @@ import (
 
 	"github.com/jaekwon/testify/assert"
 
+	gno "github.com/gnolang/gno/gnovm/pkg/gnolang"
+	bft "github.com/gnolang/gno/tm2/pkg/bft/types"
 	"github.com/gnolang/gno/tm2/pkg/crypto"
+	"github.com/gnolang/gno/tm2/pkg/errors"
+	"github.com/gnolang/gno/tm2/pkg/sdk/auth"
+	"github.com/gnolang/gno/tm2/pkg/sdk/upgrade"
 	"github.com/gnolang/gno/tm2/pkg/std"
 )
 
@@ -297,3 +302,476 @@ func GetAdmin() string {
 	assert.NoError(t, err)
 	assert.Equal(t, res, addrString)
 }
+
+// Realms can use fmt for formatting, but not for printing.
+func TestVMKeeperFmt(t *testing.T) {
+	env := setupTestEnv()
+	ctx := env.ctx
+
+	// Give "addr1" some gnots.
+	addr := crypto.AddressFromPreimage([]byte("addr1"))
+	acc := env.acck.NewAccountWithAddress(ctx, addr)
+	env.acck.SetAccount(ctx, acc)
+	env.bank.SetCoins(ctx, addr, std.MustParseCoins("10000000ugnot"))
+
+	// Create test package.
+	files := []*std.MemFile{
+		{"init.gno", `
+package test
+
+import "fmt"
+
+type point struct {
+	X, Y int
+}
+
+type level int
+
+func (l level) String() string {
+	return fmt.Sprintf("L%d", int(l))
+}
+
+func Describe(name string) string {
+	p := &point{1, 2}
+	return fmt.Sprintf("%s: %+v %v %5.2f", name, p, []level{1, 2}, 3.14159)
+}
+`},
+	}
+	pkgPath := "gno.land/r/test"
+	msg1 := NewMsgAddPackage(addr, pkgPath, files)
+	err := env.vmk.AddPackage(ctx, msg1)
+	assert.NoError(t, err)
+
+	// Run Describe()
+	coins := std.MustParseCoins("")
+	msg2 := NewMsgCall(addr, coins, pkgPath, "Describe", []string{"pt"})
+	res, err := env.vmk.Call(ctx, msg2)
+	assert.NoError(t, err)
+	assert.Equal(t, `("pt: &{X:1 Y:2} [L1 L2]  3.14" string)`, res)
+
+	// Printing to stdout is not available.
+	files = []*std.MemFile{
+		{"init.gno", `
+package test2
+
+import "fmt"
+
+func Hello() {
+	fmt.Println("hello")
+}
+`},
+	}
+	msg3 := NewMsgAddPackage(addr, "gno.land/r/test2", files)
+	assert.Panics(t, func() {
+		env.vmk.AddPackage(ctx, msg3)
+	})
+}
+
+// Sending to a realm calls its onReceive hook, which can reject.
+func TestVMKeeperOnReceive(t *testing.T) {
+	env := setupTestEnv()
+	ctx := env.ctx
+
+	// Give "addr1" some gnots.
+	addr := crypto.AddressFromPreimage([]byte("addr1"))
+	acc := env.acck.NewAccountWithAddress(ctx, addr)
+	env.acck.SetAccount(ctx, acc)
+	env.bank.SetCoins(ctx, addr, std.MustParseCoins("10000000ugnot"))
+
+	// Create vault package, which accounts for received coins.
+	files := []*std.MemFile{
+		{"vault.gno", `
+package vault
+
+import "std"
+
+var deposits std.Coins
+var lastFrom std.Address
+
+func onReceive(from std.Address, coins std.Coins) {
+	if coins.AmountOf("ugnot") > 1000 {
+		panic("deposit too large")
+	}
+	lastFrom = from
+	deposits = deposits.Add(coins)
+}
+
+func Deposits() string {
+	return deposits.String() + " from " + lastFrom.String()
+}
+`},
+	}
+	msg1 := NewMsgAddPackage(addr, "gno.land/r/vault", files)
+	err := env.vmk.AddPackage(ctx, msg1)
+	assert.NoError(t, err)
+
+	// Create payer package, which forwards its send to the vault.
+	files = []*std.MemFile{
+		{"payer.gno", `
+package payer
+
+import "std"
+
+func Pay() {
+	vault := std.DerivePkgAddr("gno.land/r/vault")
+	banker := std.GetBanker(std.BankerTypeOrigSend)
+	banker.SendCoins(std.GetOrigPkgAddr(), vault, std.GetOrigSend())
+}
+`},
+	}
+	msg2 := NewMsgAddPackage(addr, "gno.land/r/payer", files)
+	err = env.vmk.AddPackage(ctx, msg2)
+	assert.NoError(t, err)
+
+	// Pay twice, the vault sees both deposits.
+	msg3 := NewMsgCall(addr, std.MustParseCoins("100ugnot"), "gno.land/r/payer", "Pay", nil)
+	for i := 0; i < 2; i++ {
+		_, err = env.vmk.Call(ctx, msg3)
+		assert.NoError(t, err)
+	}
+	msg4 := NewMsgCall(addr, nil, "gno.land/r/vault", "Deposits", nil)
+	res, err := env.vmk.Call(ctx, msg4)
+	assert.NoError(t, err)
+	payerAddr := gno.DerivePkgAddr("gno.land/r/payer")
+	assert.Equal(t, fmt.Sprintf(`("200ugnot from %s" string)`, payerAddr), res)
+
+	// The vault rejects large deposits.
+	msg5 := NewMsgCall(addr, std.MustParseCoins("2000ugnot"), "gno.land/r/payer", "Pay", nil)
+	_, err = env.vmk.Call(ctx, msg5)
+	assert.Error(t, err)
+	assert.True(t, strings.Contains(err.Error(), "deposit too large"))
+
+	// The hook is still called for realms added before the realm
+	// address index existed, once the keeper is initialized again.
+	vaultAddr := gno.DerivePkgAddr("gno.land/r/vault")
+	ctx.Store(env.vmk.baseKey).Delete([]byte("pkgaddr:" + vaultAddr.String()))
+	vmk2 := NewVMKeeper(env.vmk.baseKey, env.vmk.iavlKey, env.acck, env.bank, env.prmk, env.vmk.stdlibsDir)
+	vmk2.Initialize(ctx.MultiStore().MultiCacheWrap())
+	_, err = vmk2.Call(ctx, msg3)
+	assert.NoError(t, err)
+	_, err = vmk2.Call(ctx, msg5)
+	assert.Error(t, err)
+	res, err = vmk2.Call(ctx, msg4)
+	assert.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf(`("300ugnot from %s" string)`, payerAddr), res)
+
+	// Other realms can't call the hook to fake a deposit.
+	files = []*std.MemFile{
+		{"thief.gno", `
+package thief
+
+import (
+	"std"
+
+	"gno.land/r/vault"
+)
+
+func Steal() {
+	vault.onReceive(std.GetOrigCaller(), std.Coins{{"ugnot", 1}})
+}
+`},
+	}
+	msg6 := NewMsgAddPackage(addr, "gno.land/r/thief", files)
+	assert.Panics(t, func() {
+		env.vmk.AddPackage(ctx, msg6)
+	})
+	// Nor can they fetch it through internal/std.
+	files = []*std.MemFile{
+		{"thief.gno", `
+package thief2
+
+import (
+	"std"
+	istd "internal/std"
+)
+
+func Steal() {
+	fn := istd.GetOnReceive(string(std.DerivePkgAddr("gno.land/r/vault")))
+	fn.(func(std.Address, std.Coins))(std.GetOrigCaller(), std.Coins{{"ugnot", 1}})
+}
+`},
+	}
+	msg7 := NewMsgAddPackage(addr, "gno.land/r/thief2", files)
+	err = env.vmk.AddPackage(ctx, msg7)
+	assert.Error(t, err)
+	assert.Equal(t, InvalidPkgPathError{}, errors.Cause(err))
+	assert.True(t, strings.Contains(fmt.Sprintf("%#v", err), `cannot import internal package "internal/std"`))
+
+	// A rejected send is undone even if the sender recovers.
+	files = []*std.MemFile{
+		{"payer.gno", `
+package payer2
+
+import "std"
+
+func Pay() {
+	vault := std.DerivePkgAddr("gno.land/r/vault")
+	banker := std.GetBanker(std.BankerTypeRealmSend)
+	defer func() {
+		recover()
+	}()
+	banker.SendCoins(std.GetOrigPkgAddr(), vault, std.GetOrigSend())
+}
+`},
+	}
+	msg8 := NewMsgAddPackage(addr, "gno.land/r/payer2", files)
+	err = env.vmk.AddPackage(ctx, msg8)
+	assert.NoError(t, err)
+	msg9 := NewMsgCall(addr, std.MustParseCoins("2000ugnot"), "gno.land/r/payer2", "Pay", nil)
+	_, err = env.vmk.Call(ctx, msg9)
+	assert.NoError(t, err)
+	payer2Addr := gno.DerivePkgAddr("gno.land/r/payer2")
+	assert.Equal(t, std.MustParseCoins("2000ugnot"), env.bank.GetCoins(ctx, payer2Addr))
+	assert.Equal(t, std.MustParseCoins("300ugnot"), env.bank.GetCoins(ctx, vaultAddr))
+}
+
+// The raw values backing the gno store can be queried.
+func TestVMKeeperQueryStore(t *testing.T) {
+	env := setupTestEnv()
+	ctx := env.ctx
+
+	// Give "addr1" some gnots.
+	addr := crypto.AddressFromPreimage([]byte("addr1"))
+	acc := env.acck.NewAccountWithAddress(ctx, addr)
+	env.acck.SetAccount(ctx, acc)
+	env.bank.SetCoins(ctx, addr, std.MustParseCoins("10000000ugnot"))
+
+	// Create test package.
+	files := []*std.MemFile{
+		{"init.gno", `
+package test
+
+var Counter = 42
+`},
+	}
+	pkgPath := "gno.land/r/test"
+	msg1 := NewMsgAddPackage(addr, pkgPath, files)
+	err := env.vmk.AddPackage(ctx, msg1)
+	assert.NoError(t, err)
+
+	// The mempackage is in the iavl store.
+	bz, err := env.vmk.QueryStore(ctx, "iavl", 0, []byte("pkg:"+pkgPath))
+	assert.NoError(t, err)
+	assert.True(t, strings.Contains(string(bz), "var Counter = 42"))
+
+	// The package value is in the base store.
+	oid := gno.ObjectIDFromPkgPath(pkgPath)
+	bz, err = env.vmk.QueryStore(ctx, "base", 0, []byte("oid:"+oid.String()))
+	assert.NoError(t, err)
+	assert.NotNil(t, bz)
+
+	// Missing keys return nil.
+	bz, err = env.vmk.QueryStore(ctx, "base", 0, []byte("oid:missing"))
+	assert.NoError(t, err)
+	assert.Nil(t, bz)
+
+	// The base store isn't versioned: other heights are rejected.
+	_, err = env.vmk.QueryStore(ctx, "base", ctx.BlockHeight()+1, []byte("oid:"+oid.String()))
+	assert.Error(t, err)
+	_, err = env.vmk.QueryStore(ctx, "iavl", ctx.BlockHeight()+1, []byte("pkg:"+pkgPath))
+	assert.NoError(t, err)
+
+	_, err = env.vmk.QueryStore(ctx, "other", 0, []byte("pkg:"+pkgPath))
+	assert.Error(t, err)
+}
+
+// Only the params realm can update params through std.SetParam.
+func TestVMKeeperSetParam(t *testing.T) {
+	env := setupTestEnv()
+	ctx := env.ctx
+
+	// Give "addr1" some gnots.
+	addr := crypto.AddressFromPreimage([]byte("addr1"))
+	acc := env.acck.NewAccountWithAddress(ctx, addr)
+	env.acck.SetAccount(ctx, acc)
+	env.bank.SetCoins(ctx, addr, std.MustParseCoins("10000000ugnot"))
+
+	// Create the params realm, and another one with the same code.
+	body := `
+import "std"
+
+func Set(module, key, value string) {
+	std.SetParam(module, key, value)
+}`
+	err := env.vmk.AddPackage(ctx, NewMsgAddPackage(addr, DefaultParamsRealm, []*std.MemFile{
+		{Name: "params.gno", Body: "package params\n" + body},
+	}))
+	assert.NoError(t, err)
+	err = env.vmk.AddPackage(ctx, NewMsgAddPackage(addr, "gno.land/r/test", []*std.MemFile{
+		{Name: "test.gno", Body: "package test\n" + body},
+	}))
+	assert.NoError(t, err)
+
+	// The params realm updates vm params.
+	msg := NewMsgCall(addr, nil, DefaultParamsRealm, "Set", []string{"vm", "max_cycles", "20000000"})
+	_, err = env.vmk.Call(ctx, msg)
+	assert.NoError(t, err)
+	var params Params
+	env.prmk.GetParams(ctx, ModuleName, &params)
+	assert.Equal(t, int64(20000000), params.MaxCycles)
+
+	// Invalid values and unknown params are rejected.
+	msg = NewMsgCall(addr, nil, DefaultParamsRealm, "Set", []string{"vm", "max_cycles", "-1"})
+	_, err = env.vmk.Call(ctx, msg)
+	assert.Error(t, err)
+	msg = NewMsgCall(addr, nil, DefaultParamsRealm, "Set", []string{"vm", "max_cycles", "1"})
+	_, err = env.vmk.Call(ctx, msg)
+	assert.Error(t, err)
+	msg = NewMsgCall(addr, nil, DefaultParamsRealm, "Set", []string{"vm", "max_alloc_tx", "1"})
+	_, err = env.vmk.Call(ctx, msg)
+	assert.Error(t, err)
+	msg = NewMsgCall(addr, nil, DefaultParamsRealm, "Set", []string{"vm", "unknown", "1"})
+	_, err = env.vmk.Call(ctx, msg)
+	assert.Error(t, err)
+
+	// auth params are bounded, so that txs can still pay for gas.
+	for _, kv := range [][2]string{
+		{"tx_sig_limit", "0"},
+		{"tx_sig_limit", "101"},
+		{"tx_size_cost_per_byte", "-1"},
+		{"tx_size_cost_per_byte", "1000000000"},
+		{"sig_verify_cost_ed25519", "1000000000"},
+		{"sig_verify_cost_secp256k1", "1000000000"},
+	} {
+		msg = NewMsgCall(addr, nil, DefaultParamsRealm, "Set", []string{"auth", kv[0], kv[1]})
+		_, err = env.vmk.Call(ctx, msg)
+		assert.Error(t, err, kv[0]+"="+kv[1])
+	}
+	msg = NewMsgCall(addr, nil, DefaultParamsRealm, "Set", []string{"auth", "tx_size_cost_per_byte", "20"})
+	_, err = env.vmk.Call(ctx, msg)
+	assert.NoError(t, err)
+	var authParams auth.Params
+	env.prmk.GetParams(ctx, auth.ModuleName, &authParams)
+	assert.Equal(t, int64(20), authParams.TxSizeCostPerByte)
+	assert.Equal(t, auth.DefaultTxSigLimit, authParams.TxSigLimit)
+	assert.Equal(t, auth.DefaultSigVerifyCostED25519, authParams.SigVerifyCostED25519)
+
+	// The params realm can't be changed, nor cleared.
+	for _, value := range []string{"gno.land/r/test", ""} {
+		msg = NewMsgCall(addr, nil, DefaultParamsRealm, "Set", []string{"vm", "params_realm", value})
+		_, err = env.vmk.Call(ctx, msg)
+		assert.Error(t, err)
+		assert.True(t, strings.Contains(err.Error(), "can't be set by a realm"))
+	}
+
+	// Other realms are not allowed.
+	msg = NewMsgCall(addr, nil, "gno.land/r/test", "Set", []string{"vm", "max_cycles", "1"})
+	_, err = env.vmk.Call(ctx, msg)
+	assert.Error(t, err)
+	assert.True(t, strings.Contains(err.Error(), "not allowed to set params"))
+	env.prmk.GetParams(ctx, ModuleName, &params)
+	assert.Equal(t, int64(20000000), params.MaxCycles)
+	assert.Equal(t, DefaultParamsRealm, params.ParamsRealm)
+}
+
+// Only the params realm can schedule and cancel upgrades, through
+// std.ScheduleUpgrade and std.CancelUpgrade.
+func TestVMKeeperScheduleUpgrade(t *testing.T) {
+	env := setupTestEnv()
+	ctx := env.ctx.WithBlockHeader(&bft.Header{ChainID: "test-chain-id", Height: 10})
+
+	// Give "addr1" some gnots.
+	addr := crypto.AddressFromPreimage([]byte("addr1"))
+	acc := env.acck.NewAccountWithAddress(ctx, addr)
+	env.acck.SetAccount(ctx, acc)
+	env.bank.SetCoins(ctx, addr, std.MustParseCoins("10000000ugnot"))
+
+	// Create the params realm, and another one with the same code.
+	body := `
+import "std"
+
+func Schedule(name string, height int64) {
+	std.ScheduleUpgrade(name, height, "info")
+}
+
+func Cancel() {
+	std.CancelUpgrade()
+}`
+	err := env.vmk.AddPackage(ctx, NewMsgAddPackage(addr, DefaultParamsRealm, []*std.MemFile{
+		{Name: "params.gno", Body: "package params\n" + body},
+	}))
+	assert.NoError(t, err)
+	err = env.vmk.AddPackage(ctx, NewMsgAddPackage(addr, "gno.land/r/test", []*std.MemFile{
+		{Name: "test.gno", Body: "package test\n" + body},
+	}))
+	assert.NoError(t, err)
+
+	// The params realm schedules upgrades.
+	msg := NewMsgCall(addr, nil, DefaultParamsRealm, "Schedule", []string{"v2", "100"})
+	_, err = env.vmk.Call(ctx, msg)
+	assert.NoError(t, err)
+	plan, ok := env.upk.GetUpgradePlan(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, upgrade.Plan{Name: "v2", Height: 100, Info: "info"}, plan)
+
+	// Invalid plans are rejected.
+	msg = NewMsgCall(addr, nil, DefaultParamsRealm, "Schedule", []string{"v3", "10"})
+	_, err = env.vmk.Call(ctx, msg)
+	assert.Error(t, err)
+	msg = NewMsgCall(addr, nil, DefaultParamsRealm, "Schedule", []string{"", "100"})
+	_, err = env.vmk.Call(ctx, msg)
+	assert.Error(t, err)
+
+	// Other realms are not allowed.
+	msg = NewMsgCall(addr, nil, "gno.land/r/test", "Schedule", []string{"v3", "200"})
+	_, err = env.vmk.Call(ctx, msg)
+	assert.Error(t, err)
+	assert.True(t, strings.Contains(err.Error(), "not allowed to schedule upgrades"))
+	msg = NewMsgCall(addr, nil, "gno.land/r/test", "Cancel", []string{})
+	_, err = env.vmk.Call(ctx, msg)
+	assert.Error(t, err)
+	plan, ok = env.upk.GetUpgradePlan(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, "v2", plan.Name)
+
+	// The params realm cancels the upgrade, once.
+	msg = NewMsgCall(addr, nil, DefaultParamsRealm, "Cancel", []string{})
+	_, err = env.vmk.Call(ctx, msg)
+	assert.NoError(t, err)
+	_, ok = env.upk.GetUpgradePlan(ctx)
+	assert.False(t, ok)
+	_, err = env.vmk.Call(ctx, msg)
+	assert.Error(t, err)
+}
+
+// Packages can be called after being preprocessed again, e.g. by an
+// upgrade handler.
+func TestVMKeeperPreprocessAllPackages(t *testing.T) {
+	env := setupTestEnv()
+	ctx := env.ctx
+
+	// Give "addr1" some gnots.
+	addr := crypto.AddressFromPreimage([]byte("addr1"))
+	acc := env.acck.NewAccountWithAddress(ctx, addr)
+	env.acck.SetAccount(ctx, acc)
+	env.bank.SetCoins(ctx, addr, std.MustParseCoins("10000000ugnot"))
+
+	// Create test package.
+	files := []*std.MemFile{
+		{"init.gno", `
+package test
+
+var counter int
+
+func Inc() int {
+	counter++
+	return counter
+}`},
+	}
+	pkgPath := "gno.land/r/test"
+	msg1 := NewMsgAddPackage(addr, pkgPath, files)
+	err := env.vmk.AddPackage(ctx, msg1)
+	assert.NoError(t, err)
+
+	msg2 := NewMsgCall(addr, nil, pkgPath, "Inc", nil)
+	res, err := env.vmk.Call(ctx, msg2)
+	assert.NoError(t, err)
+	assert.Equal(t, `(1 int)`, res)
+
+	env.vmk.PreprocessAllPackages(ctx)
+
+	// the realm state is kept.
+	res, err = env.vmk.Call(ctx, msg2)
+	assert.NoError(t, err)
+	assert.Equal(t, `(2 int)`, res)
+}
@@ -0,0 +1,85 @@
+package vm
+
+import (
+	"fmt"
+	"strings"
+
+	gno "github.com/gnolang/gno/gnovm/pkg/gnolang"
+	"github.com/gnolang/gno/tm2/pkg/amino"
+	"github.com/gnolang/gno/tm2/pkg/sdk"
+)
+
+// Default parameter values
+const (
+	DefaultMaxAllocTx    int64 = 500 * 1000 * 1000
+	DefaultMaxAllocQuery int64 = 1500 * 1000 * 1000 // higher limit for queries
+	DefaultMaxCycles     int64 = 10 * 1000 * 1000   // 10M cycles
+	DefaultParamsRealm         = "gno.land/r/system/params"
+)
+
+// Lower bounds of the parameter values, below which the VM can't run
+// the transactions needed to update them again.
+const (
+	MinMaxAllocTx    int64 = 10 * 1000 * 1000
+	MinMaxAllocQuery int64 = 10 * 1000 * 1000
+	MinMaxCycles     int64 = 1000 * 1000
+)
+
+// Params defines the parameters for the vm module.
+type Params struct {
+	MaxAllocTx    int64  `json:"max_alloc_tx" yaml:"max_alloc_tx"`
+	MaxAllocQuery int64  `json:"max_alloc_query" yaml:"max_alloc_query"`
+	MaxCycles     int64  `json:"max_cycles" yaml:"max_cycles"`
+	ParamsRealm   string `json:"params_realm" yaml:"params_realm"` // realm allowed to call std.SetParam
+}
+
+// Equals returns a boolean determining if two Params types are identical.
+func (p Params) Equals(p2 Params) bool {
+	return amino.DeepEqual(p, p2)
+}
+
+// ValidateBasic performs basic validation on vm parameters.
+func (p Params) ValidateBasic() error {
+	if p.MaxAllocTx < MinMaxAllocTx {
+		return fmt.Errorf("invalid max alloc tx: %d, minimum is %d", p.MaxAllocTx, MinMaxAllocTx)
+	}
+	if p.MaxAllocQuery < MinMaxAllocQuery {
+		return fmt.Errorf("invalid max alloc query: %d, minimum is %d", p.MaxAllocQuery, MinMaxAllocQuery)
+	}
+	if p.MaxCycles < MinMaxCycles {
+		return fmt.Errorf("invalid max cycles: %d, minimum is %d", p.MaxCycles, MinMaxCycles)
+	}
+	if !gno.IsRealmPath(p.ParamsRealm) {
+		return fmt.Errorf("invalid params realm: %q", p.ParamsRealm)
+	}
+	return nil
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return Params{
+		MaxAllocTx:    DefaultMaxAllocTx,
+		MaxAllocQuery: DefaultMaxAllocQuery,
+		MaxCycles:     DefaultMaxCycles,
+		ParamsRealm:   DefaultParamsRealm,
+	}
+}
+
+// String implements the stringer interface.
+func (p Params) String() string {
+	var sb strings.Builder
+	sb.WriteString("Params: \n")
+	sb.WriteString(fmt.Sprintf("MaxAllocTx: %d\n", p.MaxAllocTx))
+	sb.WriteString(fmt.Sprintf("MaxAllocQuery: %d\n", p.MaxAllocQuery))
+	sb.WriteString(fmt.Sprintf("MaxCycles: %d\n", p.MaxCycles))
+	sb.WriteString(fmt.Sprintf("ParamsRealm: %s\n", p.ParamsRealm))
+	return sb.String()
+}
+
+// getParams returns the current vm params, or the defaults
+// if none were set.
+func (vm *VMKeeper) getParams(ctx sdk.Context) Params {
+	params := DefaultParams()
+	vm.prmk.GetParams(ctx, ModuleName, &params)
+	return params
+}
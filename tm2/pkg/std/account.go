@@ -2,6 +2,7 @@ package std
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/gnolang/gno/tm2/pkg/crypto"
 	"github.com/gnolang/gno/tm2/pkg/errors"
@@ -33,6 +34,34 @@ type Account interface {
 	String() string
 }
 
+// VestingAccount is an Account with coins locked until they vest.
+// The bank only allows spending the coins which are not locked.
+type VestingAccount interface {
+	Account
+
+	// LockedCoins returns the coins which are not vested at blockTime.
+	LockedCoins(blockTime time.Time) Coins
+}
+
+// SpendableCoins returns the coins of acc which are not locked at
+// blockTime, if acc is a VestingAccount.
+func SpendableCoins(acc Account, blockTime time.Time) Coins {
+	coins := acc.GetCoins()
+	vacc, ok := acc.(VestingAccount)
+	if !ok {
+		return coins
+	}
+	locked := vacc.LockedCoins(blockTime)
+	spendable := Coins{}
+	for _, coin := range coins {
+		amt := coin.Amount - locked.AmountOf(coin.Denom)
+		if amt > 0 {
+			spendable = append(spendable, NewCoin(coin.Denom, amt))
+		}
+	}
+	return spendable
+}
+
 //----------------------------------------
 // BaseAccount
 
@@ -0,0 +1,80 @@
+package std
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnolang/gno/tm2/pkg/amino"
+	"github.com/gnolang/gno/tm2/pkg/crypto"
+)
+
+// testVestingAccount locks coins until unlockTime.
+type testVestingAccount struct {
+	BaseAccount
+	locked     Coins
+	unlockTime time.Time
+}
+
+func (acc *testVestingAccount) LockedCoins(blockTime time.Time) Coins {
+	if blockTime.Before(acc.unlockTime) {
+		return acc.locked
+	}
+	return nil
+}
+
+func TestSpendableCoins(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1000, 0)
+	coins := NewCoins(NewCoin(testDenom1, 100), NewCoin(testDenom2, 10))
+
+	acc := &BaseAccount{Coins: coins}
+	require.True(t, coins.IsEqual(SpendableCoins(acc, now)))
+
+	vacc := &testVestingAccount{
+		BaseAccount: BaseAccount{Coins: coins},
+		locked:      NewCoins(NewCoin(testDenom1, 40), NewCoin(testDenom2, 20)),
+		unlockTime:  now.Add(time.Hour),
+	}
+	// more locked than owned leaves nothing spendable for that denom.
+	require.True(t, NewCoins(NewCoin(testDenom1, 60)).IsEqual(SpendableCoins(vacc, now)))
+	require.True(t, coins.IsEqual(SpendableCoins(vacc, now.Add(time.Hour))))
+}
+
+// myAccount embeds BaseAccount, and so gets its generated methods promoted.
+type myAccount struct {
+	BaseAccount
+	Extra int64
+}
+
+func TestAccountBinary2(t *testing.T) {
+	t.Parallel()
+
+	cdc := amino.NewCodec()
+	cdc2 := amino.NewCodec().WithBinary2()
+	base := BaseAccount{
+		Address:       crypto.AddressFromPreimage([]byte("account")),
+		Coins:         NewCoins(NewCoin(testDenom1, 100), NewCoin(testDenom2, 10)),
+		AccountNumber: 1,
+		Sequence:      2,
+	}
+
+	for _, acc := range []interface{}{
+		&base,
+		&BaseAccount{},
+		&myAccount{BaseAccount: base, Extra: 42},
+	} {
+		bz, err := cdc.Marshal(acc)
+		require.NoError(t, err)
+		bz2, err := cdc2.Marshal(acc)
+		require.NoError(t, err)
+		require.Equal(t, bz, bz2, "%T", acc)
+
+		acc2 := reflect.New(reflect.TypeOf(acc).Elem()).Interface()
+		require.NoError(t, cdc2.Unmarshal(bz2, acc2))
+		require.Equal(t, acc, acc2)
+	}
+}
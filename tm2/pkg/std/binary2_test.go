@@ -0,0 +1,101 @@
+package std_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnolang/gno/tm2/pkg/amino"
+	"github.com/gnolang/gno/tm2/pkg/crypto"
+	"github.com/gnolang/gno/tm2/pkg/crypto/ed25519"
+	"github.com/gnolang/gno/tm2/pkg/std"
+)
+
+type testMsg struct {
+	Caller crypto.Address
+	Args   []string
+}
+
+func (msg testMsg) Route() string                { return "test" }
+func (msg testMsg) Type() string                 { return "test" }
+func (msg testMsg) ValidateBasic() error         { return nil }
+func (msg testMsg) GetSignBytes() []byte         { return nil }
+func (msg testMsg) GetSigners() []crypto.Address { return []crypto.Address{msg.Caller} }
+
+var testPackage = amino.RegisterPackage(amino.NewPackage(
+	reflect.TypeOf(testMsg{}).PkgPath(),
+	"std_test",
+	amino.GetCallersDirname(),
+).WithDependencies(
+	std.Package,
+	ed25519.Package,
+).WithTypes(
+	testMsg{}, "testMsg",
+))
+
+func makeTestTx(tb testing.TB) std.Tx {
+	tb.Helper()
+
+	priv := ed25519.GenPrivKey()
+	caller := priv.PubKey().Address()
+	payer := crypto.AddressFromPreimage([]byte("payer"))
+	msg := testMsg{Caller: caller, Args: []string{"foo", "bar"}}
+	tx := std.NewTx([]std.Msg{msg, msg}, std.NewFee(100000, std.NewCoin("ugnot", 1000)), nil, "memo")
+	tx.FeePayer = &payer
+	tx.TimeoutHeight = 100
+	sig, err := priv.Sign(tx.GetSignBytes("dev", 0, 0))
+	require.NoError(tb, err)
+	tx.Signatures = []std.Signature{{PubKey: priv.PubKey(), Signature: sig}}
+	return tx
+}
+
+func newTestCodec() *amino.Codec {
+	cdc := amino.NewCodec()
+	cdc.RegisterPackage(testPackage)
+	return cdc
+}
+
+// The generated binary2 methods encode txs as the reflection codec does.
+func TestTxBinary2(t *testing.T) {
+	t.Parallel()
+
+	cdc := newTestCodec()
+	cdc2 := newTestCodec().WithBinary2()
+	for _, tx := range []std.Tx{makeTestTx(t), {}} {
+		bz, err := cdc.Marshal(tx)
+		require.NoError(t, err)
+		bz2, err := cdc2.Marshal(tx)
+		require.NoError(t, err)
+		require.Equal(t, bz, bz2)
+
+		var tx1, tx2 std.Tx
+		require.NoError(t, cdc.Unmarshal(bz, &tx1))
+		require.NoError(t, cdc2.Unmarshal(bz2, &tx2))
+		require.Equal(t, tx1, tx2)
+	}
+}
+
+func BenchmarkTxBinary(b *testing.B) {
+	tx := makeTestTx(b)
+	for _, c := range []struct {
+		name string
+		cdc  *amino.Codec
+	}{
+		{"reflect", newTestCodec()},
+		{"binary2", newTestCodec().WithBinary2()},
+	} {
+		bz := c.cdc.MustMarshal(tx)
+		b.Run(c.name+":encode", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				c.cdc.MustMarshal(tx)
+			}
+		})
+		b.Run(c.name+":decode", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				var tx2 std.Tx
+				c.cdc.MustUnmarshal(bz, &tx2)
+			}
+		})
+	}
+}
@@ -17,17 +17,21 @@ import (
 // AccountNumber . Sequence is a replay-prevention field for each transaction
 // given a nonce.
 type SignDoc struct {
-	ChainID       string    `json:"chain_id" yaml:"chain_id"`
-	AccountNumber uint64    `json:"account_number" yaml:"account_number"`
-	Time          time.Time `json:"time" yaml:"time"`
-	Sequence      uint64    `json:"sequence" yaml:"sequence"`
-	Fee           Fee       `json:"fee" yaml:"fee"`
-	Msgs          []Msg     `json:"msgs" yaml:"msgs"`
-	Memo          string    `json:"memo" yaml:"memo"`
+	ChainID       string          `json:"chain_id" yaml:"chain_id"`
+	AccountNumber uint64          `json:"account_number" yaml:"account_number"`
+	Time          time.Time       `json:"time" yaml:"time"`
+	Sequence      uint64          `json:"sequence" yaml:"sequence"`
+	Fee           Fee             `json:"fee" yaml:"fee"`
+	Msgs          []Msg           `json:"msgs" yaml:"msgs"`
+	Memo          string          `json:"memo" yaml:"memo"`
+	FeePayer      *crypto.Address `json:"fee_payer,omitempty" yaml:"fee_payer"`
+	TimeoutHeight int64           `json:"timeout_height,omitempty" yaml:"timeout_height"`
+	TimeoutTime   int64           `json:"timeout_time,omitempty" yaml:"timeout_time"`
 }
 
 // SignBytes returns the bytes to sign for a transaction.
-func SignBytes(chainID string, accountNumber uint64, sequence uint64, fee Fee, msgs []Msg, memo string) []byte {
+// The fee payer and timeouts are omitted from the bytes when nil or zero.
+func SignBytes(chainID string, accountNumber uint64, sequence uint64, fee Fee, msgs []Msg, memo string, feePayer *crypto.Address, timeoutHeight, timeoutTime int64) []byte {
 	bz, err := amino.MarshalJSON(SignDoc{
 		ChainID:       chainID,
 		AccountNumber: accountNumber,
@@ -35,6 +39,9 @@ func SignBytes(chainID string, accountNumber uint64, sequence uint64, fee Fee, m
 		Fee:           fee,
 		Msgs:          msgs,
 		Memo:          memo,
+		FeePayer:      feePayer,
+		TimeoutHeight: timeoutHeight,
+		TimeoutTime:   timeoutTime,
 	})
 	if err != nil {
 		panic(err)
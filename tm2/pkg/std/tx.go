@@ -2,6 +2,7 @@ package std
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/gnolang/gno/tm2/pkg/amino"
 	"github.com/gnolang/gno/tm2/pkg/crypto"
@@ -11,12 +12,18 @@ import (
 var maxGasWanted = int64((1 << 60) - 1) // something smaller than math.MaxInt64
 
 // Tx is a standard way to wrap a Msg with Fee and Signatures.
-// NOTE: the first signature is the fee payer (Signatures must not be nil).
+// NOTE: the first signature is the fee payer (Signatures must not be nil),
+// unless FeePayer is set, in which case the fee payer also signs the tx.
+// A tx with a TimeoutHeight or TimeoutTime (in unix seconds) is only valid
+// in blocks up to that height or time.
 type Tx struct {
-	Msgs       []Msg       `json:"msg" yaml:"msg"`
-	Fee        Fee         `json:"fee" yaml:"fee"`
-	Signatures []Signature `json:"signatures" yaml:"signatures"`
-	Memo       string      `json:"memo" yaml:"memo"`
+	Msgs          []Msg           `json:"msg" yaml:"msg"`
+	Fee           Fee             `json:"fee" yaml:"fee"`
+	Signatures    []Signature     `json:"signatures" yaml:"signatures"`
+	Memo          string          `json:"memo" yaml:"memo"`
+	FeePayer      *crypto.Address `json:"fee_payer,omitempty" yaml:"fee_payer"`           // optional
+	TimeoutHeight int64           `json:"timeout_height,omitempty" yaml:"timeout_height"` // optional
+	TimeoutTime   int64           `json:"timeout_time,omitempty" yaml:"timeout_time"`     // optional
 }
 
 func NewTx(msgs []Msg, fee Fee, sigs []Signature, memo string) Tx {
@@ -70,7 +77,8 @@ func CountSubKeys(pub crypto.PubKey) int {
 // GetSigners returns the addresses that must sign the transaction.
 // Addresses are returned in a deterministic order.
 // They are accumulated from the GetSigners method for each Msg
-// in the order they appear in tx.GetMsgs().
+// in the order they appear in tx.GetMsgs(), followed by the
+// FeePayer if set.
 // Duplicate addresses will be omitted.
 func (tx Tx) GetSigners() []crypto.Address {
 	seen := map[string]bool{}
@@ -83,9 +91,33 @@ func (tx Tx) GetSigners() []crypto.Address {
 			}
 		}
 	}
+	if tx.FeePayer != nil && !seen[tx.FeePayer.String()] {
+		signers = append(signers, *tx.FeePayer)
+	}
 	return signers
 }
 
+// GetFeePayer returns the address paying the fees of the transaction,
+// which is the FeePayer if set, and otherwise the first signer.
+func (tx Tx) GetFeePayer() crypto.Address {
+	if tx.FeePayer != nil {
+		return *tx.FeePayer
+	}
+	return tx.GetSigners()[0]
+}
+
+// IsExpired returns true if the tx is not valid anymore in a block at
+// height and time, past its TimeoutHeight or TimeoutTime.
+func (tx Tx) IsExpired(height int64, t time.Time) bool {
+	if tx.TimeoutHeight > 0 && height > tx.TimeoutHeight {
+		return true
+	}
+	if tx.TimeoutTime > 0 && t.Unix() > tx.TimeoutTime {
+		return true
+	}
+	return false
+}
+
 // GetMemo returns the memo
 func (tx Tx) GetMemo() string { return tx.Memo }
 
@@ -100,7 +132,7 @@ func (tx Tx) GetMemo() string { return tx.Memo }
 func (tx Tx) GetSignatures() []Signature { return tx.Signatures }
 
 func (tx Tx) GetSignBytes(chainID string, accountNumber uint64, sequence uint64) []byte {
-	return SignBytes(chainID, accountNumber, sequence, tx.Fee, tx.Msgs, tx.Memo)
+	return SignBytes(chainID, accountNumber, sequence, tx.Fee, tx.Msgs, tx.Memo, tx.FeePayer, tx.TimeoutHeight, tx.TimeoutTime)
 }
 
 //__________________________________________________________
@@ -0,0 +1,80 @@
+package std
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnolang/gno/tm2/pkg/crypto"
+)
+
+type testSignersMsg struct {
+	signers []crypto.Address
+}
+
+func (msg testSignersMsg) Route() string                { return "test" }
+func (msg testSignersMsg) Type() string                 { return "test" }
+func (msg testSignersMsg) ValidateBasic() error         { return nil }
+func (msg testSignersMsg) GetSignBytes() []byte         { return nil }
+func (msg testSignersMsg) GetSigners() []crypto.Address { return msg.signers }
+
+func TestTxFeePayer(t *testing.T) {
+	addr1 := crypto.AddressFromPreimage([]byte("addr1"))
+	addr2 := crypto.AddressFromPreimage([]byte("addr2"))
+	payer := crypto.AddressFromPreimage([]byte("payer"))
+
+	tx := Tx{Msgs: []Msg{testSignersMsg{[]crypto.Address{addr1, addr2}}}}
+	assert.Equal(t, []crypto.Address{addr1, addr2}, tx.GetSigners())
+	assert.Equal(t, addr1, tx.GetFeePayer())
+
+	// the fee payer signs last.
+	tx.FeePayer = &payer
+	assert.Equal(t, []crypto.Address{addr1, addr2, payer}, tx.GetSigners())
+	assert.Equal(t, payer, tx.GetFeePayer())
+
+	// unless it is already a signer.
+	tx.FeePayer = &addr2
+	assert.Equal(t, []crypto.Address{addr1, addr2}, tx.GetSigners())
+	assert.Equal(t, addr2, tx.GetFeePayer())
+}
+
+func TestSignBytesFeePayer(t *testing.T) {
+	payer := crypto.AddressFromPreimage([]byte("payer"))
+	fee := NewFee(1000, NewCoin("ugnot", 10))
+
+	// omitted when nil, so that the sign bytes of other txs are unchanged.
+	bz := SignBytes("dev", 1, 2, fee, []Msg{}, "memo", nil, 0, 0)
+	require.False(t, strings.Contains(string(bz), "fee_payer"))
+
+	bz = SignBytes("dev", 1, 2, fee, []Msg{}, "memo", &payer, 0, 0)
+	require.True(t, strings.Contains(string(bz), `"fee_payer":"`+payer.String()+`"`))
+}
+
+func TestTxIsExpired(t *testing.T) {
+	now := time.Unix(1000, 0)
+
+	tx := Tx{}
+	require.False(t, tx.IsExpired(100, now))
+
+	tx.TimeoutHeight = 10
+	require.False(t, tx.IsExpired(10, now))
+	require.True(t, tx.IsExpired(11, now))
+
+	tx = Tx{TimeoutTime: 1000}
+	require.False(t, tx.IsExpired(100, now))
+	require.True(t, tx.IsExpired(100, now.Add(time.Second)))
+}
+
+func TestSignBytesTimeout(t *testing.T) {
+	fee := NewFee(1000, NewCoin("ugnot", 10))
+
+	bz := SignBytes("dev", 1, 2, fee, []Msg{}, "memo", nil, 0, 0)
+	require.False(t, strings.Contains(string(bz), "timeout"))
+
+	bz = SignBytes("dev", 1, 2, fee, []Msg{}, "memo", nil, 10, 1000)
+	require.True(t, strings.Contains(string(bz), `"timeout_height":"10"`))
+	require.True(t, strings.Contains(string(bz), `"timeout_time":"1000"`))
+}